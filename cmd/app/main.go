@@ -2,6 +2,7 @@ package main
 
 import (
 	"log"
+	"os"
 
 	"github.com/finstape/pr-reviews/config"
 	"github.com/finstape/pr-reviews/internal/app"
@@ -14,7 +15,9 @@ func main() {
 		log.Fatalf("Config error: %s", err)
 	}
 
-	// Run
-	app.Run(cfg)
+	// Run the service, or a maintenance subcommand
+	if err := app.NewCommand(cfg).Execute(); err != nil {
+		os.Exit(1)
+	}
 }
 
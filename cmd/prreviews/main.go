@@ -0,0 +1,22 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/finstape/pr-reviews/internal/cli"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	err := cli.NewRootCommand().ExecuteContext(ctx)
+
+	stop()
+
+	if err != nil {
+		os.Exit(1)
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/caarlos0/env/v11"
 )
@@ -9,12 +10,26 @@ import (
 type (
 	// Config -.
 	Config struct {
-		App     App
-		HTTP    HTTP
-		Log     Log
-		PG      PG
-		Metrics Metrics
-		Swagger Swagger
+		App        App
+		HTTP       HTTP
+		GRPC       GRPC
+		Log        Log
+		PG         PG
+		Metrics    Metrics
+		Auth       Auth
+		OIDC       OIDC
+		Swagger    Swagger
+		Review     Review
+		Escalation Escalation
+		Outbox     Outbox
+		Webhook    Webhook
+		GitHub     GitHub
+		GitLab     GitLab
+		Chat       Chat
+		Digest     Digest
+		SMTP       SMTP
+		Stream     Stream
+		GraphQL    GraphQL
 	}
 
 	// App -.
@@ -29,6 +44,11 @@ type (
 		UsePreforkMode bool   `env:"HTTP_USE_PREFORK_MODE" envDefault:"false"`
 	}
 
+	// GRPC -.
+	GRPC struct {
+		Port string `env:"GRPC_PORT" envDefault:"8081"`
+	}
+
 	// Log -.
 	Log struct {
 		Level string `env:"LOG_LEVEL,required"`
@@ -45,10 +65,110 @@ type (
 		Enabled bool `env:"METRICS_ENABLED" envDefault:"true"`
 	}
 
+	// Auth -.
+	Auth struct {
+		Enabled     bool     `env:"AUTH_ENABLED" envDefault:"false"`
+		PublicPaths []string `env:"AUTH_PUBLIC_PATHS" envSeparator:"," envDefault:"/healthz,/metrics"`
+	}
+
+	// OIDC -.
+	OIDC struct {
+		JWKSURL         string        `env:"OIDC_JWKS_URL"`
+		Issuer          string        `env:"OIDC_ISSUER"`
+		Audience        string        `env:"OIDC_AUDIENCE"`
+		UserClaim       string        `env:"OIDC_USER_CLAIM" envDefault:"sub"`
+		RefreshInterval time.Duration `env:"OIDC_JWKS_REFRESH_INTERVAL" envDefault:"1h"`
+		Timeout         time.Duration `env:"OIDC_TIMEOUT" envDefault:"5s"`
+	}
+
 	// Swagger -.
 	Swagger struct {
 		Enabled bool `env:"SWAGGER_ENABLED" envDefault:"false"`
 	}
+
+	// Review -.
+	Review struct {
+		DefaultSLA time.Duration `env:"REVIEW_DEFAULT_SLA" envDefault:"24h"`
+	}
+
+	// Escalation -.
+	Escalation struct {
+		Enabled          bool          `env:"ESCALATION_ENABLED" envDefault:"true"`
+		Interval         time.Duration `env:"ESCALATION_INTERVAL" envDefault:"5m"`
+		DefaultThreshold time.Duration `env:"ESCALATION_DEFAULT_THRESHOLD" envDefault:"48h"`
+		BatchSize        int           `env:"ESCALATION_BATCH_SIZE" envDefault:"100"`
+	}
+
+	// Outbox -.
+	Outbox struct {
+		RelayInterval time.Duration `env:"OUTBOX_RELAY_INTERVAL" envDefault:"1s"`
+		BatchSize     int           `env:"OUTBOX_BATCH_SIZE" envDefault:"100"`
+	}
+
+	// Webhook -.
+	Webhook struct {
+		Enabled          bool          `env:"WEBHOOK_ENABLED" envDefault:"true"`
+		DeliveryInterval time.Duration `env:"WEBHOOK_DELIVERY_INTERVAL" envDefault:"5s"`
+		Timeout          time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"5s"`
+		MaxAttempts      int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"8"`
+		BaseBackoff      time.Duration `env:"WEBHOOK_BASE_BACKOFF" envDefault:"30s"`
+		MaxBackoff       time.Duration `env:"WEBHOOK_MAX_BACKOFF" envDefault:"1h"`
+		BatchSize        int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"50"`
+	}
+
+	// GitHub -.
+	GitHub struct {
+		WebhookSecret string        `env:"GITHUB_WEBHOOK_SECRET"`
+		APIURL        string        `env:"GITHUB_API_URL" envDefault:"https://api.github.com"`
+		Token         string        `env:"GITHUB_TOKEN"`
+		APITimeout    time.Duration `env:"GITHUB_API_TIMEOUT" envDefault:"10s"`
+	}
+
+	// GitLab -.
+	GitLab struct {
+		WebhookToken string        `env:"GITLAB_WEBHOOK_TOKEN"`
+		APIURL       string        `env:"GITLAB_API_URL" envDefault:"https://gitlab.com/api/v4"`
+		Token        string        `env:"GITLAB_TOKEN"`
+		APITimeout   time.Duration `env:"GITLAB_API_TIMEOUT" envDefault:"10s"`
+	}
+
+	// Chat -.
+	Chat struct {
+		Enabled         bool          `env:"CHAT_ENABLED" envDefault:"true"`
+		Timeout         time.Duration `env:"CHAT_TIMEOUT" envDefault:"5s"`
+		OverdueInterval time.Duration `env:"CHAT_OVERDUE_INTERVAL" envDefault:"5m"`
+		BatchSize       int           `env:"CHAT_BATCH_SIZE" envDefault:"100"`
+	}
+
+	// Digest -.
+	Digest struct {
+		Enabled       bool          `env:"DIGEST_ENABLED" envDefault:"false"`
+		SendHour      int           `env:"DIGEST_SEND_HOUR" envDefault:"8"`
+		CheckInterval time.Duration `env:"DIGEST_CHECK_INTERVAL" envDefault:"5m"`
+		BatchSize     int           `env:"DIGEST_BATCH_SIZE" envDefault:"50"`
+	}
+
+	// SMTP -.
+	SMTP struct {
+		Host     string        `env:"SMTP_HOST" envDefault:"localhost"`
+		Port     int           `env:"SMTP_PORT" envDefault:"25"`
+		Username string        `env:"SMTP_USERNAME"`
+		Password string        `env:"SMTP_PASSWORD"`
+		From     string        `env:"SMTP_FROM" envDefault:"PR Reviews <noreply@localhost>"`
+		Timeout  time.Duration `env:"SMTP_TIMEOUT" envDefault:"10s"`
+	}
+
+	// Stream -.
+	Stream struct {
+		MaxDuration       time.Duration `env:"STREAM_MAX_DURATION" envDefault:"1h"`
+		BufferSize        int           `env:"STREAM_BUFFER_SIZE" envDefault:"64"`
+		ReconnectInterval time.Duration `env:"STREAM_RECONNECT_INTERVAL" envDefault:"5s"`
+	}
+
+	// GraphQL -.
+	GraphQL struct {
+		MaxDepth int `env:"GRAPHQL_MAX_DEPTH" envDefault:"8"`
+	}
 )
 
 // NewConfig returns app config.
@@ -3,17 +3,20 @@
 package integration_test
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/internal/repo/persistent"
+	"github.com/finstape/pr-reviews/internal/repo/webapi"
+	"github.com/finstape/pr-reviews/internal/usecase/auth"
+	"github.com/finstape/pr-reviews/internal/usecase/webhook"
 	"github.com/finstape/pr-reviews/pkg/postgres"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -114,6 +117,16 @@ func TestIntegration_Repository_CreateAndMergePR(t *testing.T) {
 	assert.Equal(t, prID, retrievedPR.PullRequestID)
 	assert.Equal(t, entity.PullRequestStatusOpen, retrievedPR.Status)
 
+	// Test GetUsers for assigned reviewers
+	reviewers, err := userRepo.GetUsers(ctx, retrievedPR.AssignedReviewers)
+	require.NoError(t, err)
+	require.Len(t, reviewers, 1)
+	assert.Equal(t, "merge-u2", reviewers[0].UserID)
+
+	// Test GetPR for unknown PR
+	_, err = prRepo.GetPR(ctx, "pr-merge-repo-test-missing")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
 	// Test UpdatePRStatus (merge)
 	mergedAt := entity.Time(time.Now())
 	err = prRepo.UpdatePRStatus(ctx, prID, entity.PullRequestStatusMerged, &mergedAt)
@@ -169,7 +182,7 @@ func TestIntegration_Repository_ReassignReviewer(t *testing.T) {
 	require.NoError(t, err)
 
 	// Test ReassignReviewer
-	err = prRepo.ReassignReviewer(ctx, prID, "reassign-u2", "reassign-u3")
+	err = prRepo.ReassignReviewer(ctx, prID, "reassign-u2", "reassign-u3", entity.ReassignmentManual)
 	require.NoError(t, err)
 
 	// Verify reassignment
@@ -183,10 +196,9 @@ func TestIntegration_Repository_ReassignReviewer(t *testing.T) {
 	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'reassign-repo-test-team'")
 }
 
-func TestIntegration_Repository_GetUserReviews(t *testing.T) {
+func TestIntegration_Repository_ReviewQueue(t *testing.T) {
 	ctx := context.Background()
 	teamRepo := persistent.NewTeamRepo(testDB)
-	userRepo := persistent.NewUserRepo(testDB)
 	prRepo := persistent.NewPullRequestRepo(testDB)
 
 	// Setup: create team
@@ -199,44 +211,36 @@ func TestIntegration_Repository_GetUserReviews(t *testing.T) {
 	}
 
 	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id IN ('pr-reviews-repo-test', 'pr-reviews-repo-merged')")
 	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'reviews-repo-test-team'")
 
 	err := teamRepo.CreateTeam(ctx, team)
 	require.NoError(t, err)
 
-	prID := "pr-reviews-repo-test"
-	now := time.Now()
-	pr := entity.PullRequest{
-		PullRequestID:    prID,
-		PullRequestName:  "Reviews Repository Test PR",
-		AuthorID:         "reviews-u1",
-		Status:           entity.PullRequestStatusOpen,
-		AssignedReviewers: []string{"reviews-u2"},
-		CreatedAt:        &now,
+	for _, prID := range []string{"pr-reviews-repo-merged", "pr-reviews-repo-test"} {
+		err = prRepo.CreatePR(ctx, entity.PullRequest{
+			PullRequestID:   prID,
+			PullRequestName: "Reviews Repository Test PR",
+			AuthorID:        "reviews-u1",
+			Status:          entity.PullRequestStatusOpen,
+		}, []string{"reviews-u2"})
+		require.NoError(t, err)
 	}
 
-	// Clean up PR
-	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = $1", prID)
-
-	err = prRepo.CreatePR(ctx, pr, []string{"reviews-u2"})
-	require.NoError(t, err)
+	mergedAt := entity.Time(time.Now())
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-reviews-repo-merged", entity.PullRequestStatusMerged, &mergedAt))
 
-	// Test GetUserReviews
-	reviews, err := userRepo.GetUserReviews(ctx, "reviews-u2")
+	// The queue holds only the reviewer's open PRs
+	filter := entity.PullRequestFilter{Status: entity.PullRequestStatusOpen, ReviewerID: "reviews-u2"}
+	reviews, err := prRepo.ListPRs(ctx, filter, entity.SortOrderDesc, nil, entity.MaxPageLimit)
 	require.NoError(t, err)
-	assert.GreaterOrEqual(t, len(reviews), 1)
-
-	found := false
-	for _, review := range reviews {
-		if review.PullRequestID == prID {
-			found = true
-			break
-		}
-	}
-	assert.True(t, found, "PR should be in user reviews")
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "pr-reviews-repo-test", reviews[0].PullRequestID)
+	assert.Equal(t, []string{"reviews-u2"}, reviews[0].AssignedReviewers)
 
 	// Clean up
-	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = $1", prID)
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id IN ('pr-reviews-repo-test', 'pr-reviews-repo-merged')")
 	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'reviews-repo-test-team'")
 }
 
@@ -280,3 +284,1414 @@ func TestIntegration_Repository_SetIsActive(t *testing.T) {
 	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'active-test-team'")
 }
 
+func TestIntegration_Repository_ListPRs(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "list-repo-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "list-u1", Username: "List User 1", IsActive: true},
+			{UserID: "list-u2", Username: "List User 2", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-list-repo-test-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'list-repo-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	base := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	for i := 0; i < 3; i++ {
+		createdAt := base.Add(time.Duration(i) * time.Minute)
+		pr := entity.PullRequest{
+			PullRequestID:   fmt.Sprintf("pr-list-repo-test-%d", i),
+			PullRequestName: fmt.Sprintf("List 100%% Test PR %d", i),
+			AuthorID:        "list-u1",
+			Status:          entity.PullRequestStatusOpen,
+			CreatedAt:       &createdAt,
+		}
+		err = prRepo.CreatePR(ctx, pr, []string{"list-u2"})
+		require.NoError(t, err)
+	}
+
+	filter := entity.PullRequestFilter{
+		TeamName:     "list-repo-test-team",
+		ReviewerID:   "list-u2",
+		NameContains: "100%",
+	}
+
+	// First page
+	prs, err := prRepo.ListPRs(ctx, filter, entity.SortOrderDesc, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, prs, 2)
+	assert.Equal(t, "pr-list-repo-test-2", prs[0].PullRequestID)
+	assert.Equal(t, "pr-list-repo-test-1", prs[1].PullRequestID)
+	assert.Equal(t, []string{"list-u2"}, prs[0].AssignedReviewers)
+
+	// Second page starts after the last returned PR
+	cursor := &entity.Cursor{Time: *prs[1].CreatedAt, ID: prs[1].PullRequestID}
+	prs, err = prRepo.ListPRs(ctx, filter, entity.SortOrderDesc, cursor, 2)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+	assert.Equal(t, "pr-list-repo-test-0", prs[0].PullRequestID)
+
+	// Status filter excludes everything
+	filter.Status = entity.PullRequestStatusMerged
+	prs, err = prRepo.ListPRs(ctx, filter, entity.SortOrderDesc, nil, 10)
+	require.NoError(t, err)
+	assert.Empty(t, prs)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-list-repo-test-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'list-repo-test-team'")
+}
+
+func TestIntegration_Repository_GetReviewerAssignments(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "authored-repo-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "authored-u1", Username: "Authored User 1", IsActive: true},
+			{UserID: "authored-u2", Username: "Authored User 2", IsActive: false},
+		},
+	}
+
+	prID := "pr-authored-repo-test"
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = $1", prID)
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'authored-repo-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	now := time.Now()
+	pr := entity.PullRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Authored Repository Test PR",
+		AuthorID:        "authored-u1",
+		Status:          entity.PullRequestStatusOpen,
+		CreatedAt:       &now,
+	}
+	err = prRepo.CreatePR(ctx, pr, []string{"authored-u2"})
+	require.NoError(t, err)
+
+	// Test ListPRs by author
+	prs, err := prRepo.ListPRs(ctx, entity.PullRequestFilter{AuthorID: "authored-u1"}, entity.SortOrderDesc, nil, 10)
+	require.NoError(t, err)
+	require.Len(t, prs, 1)
+
+	// Test GetReviewerAssignments
+	assignments, err := prRepo.GetReviewerAssignments(ctx, []string{prID})
+	require.NoError(t, err)
+	require.Len(t, assignments[prID], 1)
+	assert.Equal(t, "authored-u2", assignments[prID][0].UserID)
+	assert.Equal(t, "Authored User 2", assignments[prID][0].Username)
+	assert.False(t, assignments[prID][0].IsActive)
+	assert.NotNil(t, assignments[prID][0].AssignedAt)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = $1", prID)
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'authored-repo-test-team'")
+}
+
+func TestIntegration_Repository_BatchedLookups(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	teams := []entity.Team{
+		{
+			TeamName: "batch-test-team-a",
+			Members: []entity.TeamMember{
+				{UserID: "batch-a1", Username: "Batch A1", IsActive: true},
+				{UserID: "batch-a2", Username: "Batch A2", IsActive: true},
+			},
+		},
+		{
+			TeamName: "batch-test-team-b",
+			Members: []entity.TeamMember{
+				{UserID: "batch-b1", Username: "Batch B1", IsActive: true},
+			},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-batch-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name LIKE 'batch-test-team-%'")
+
+	for _, team := range teams {
+		require.NoError(t, teamRepo.CreateTeam(ctx, team))
+	}
+
+	// Test GetUsersByTeams
+	users, err := userRepo.GetUsersByTeams(ctx, []string{"batch-test-team-a", "batch-test-team-b", "batch-test-team-missing"})
+	require.NoError(t, err)
+	require.Len(t, users, 3)
+	assert.Equal(t, "batch-a1", users[0].UserID)
+	assert.Equal(t, "batch-test-team-b", users[2].TeamName)
+
+	older := time.Now().Add(-time.Hour)
+	newer := time.Now()
+	require.NoError(t, prRepo.CreatePR(ctx, entity.PullRequest{
+		PullRequestID: "pr-batch-1", PullRequestName: "Batch 1", AuthorID: "batch-b1", Status: entity.PullRequestStatusOpen, CreatedAt: &older,
+	}, []string{"batch-a1", "batch-a2"}))
+	require.NoError(t, prRepo.CreatePR(ctx, entity.PullRequest{
+		PullRequestID: "pr-batch-2", PullRequestName: "Batch 2", AuthorID: "batch-b1", Status: entity.PullRequestStatusOpen, CreatedAt: &newer,
+	}, []string{"batch-a1"}))
+	mergedAt := entity.Time(time.Now())
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-batch-2", entity.PullRequestStatusMerged, &mergedAt))
+
+	// Test GetPRsByReviewers for any status
+	prs, err := prRepo.GetPRsByReviewers(ctx, []string{"batch-a1", "batch-a2", "batch-b1"}, "")
+	require.NoError(t, err)
+	require.Len(t, prs["batch-a1"], 2)
+	assert.Equal(t, "pr-batch-2", prs["batch-a1"][0].PullRequestID)
+	assert.Equal(t, []string{"batch-a1", "batch-a2"}, prs["batch-a1"][1].AssignedReviewers)
+	require.Len(t, prs["batch-a2"], 1)
+	assert.NotContains(t, prs, "batch-b1")
+
+	// Test GetPRsByReviewers filtered by status
+	prs, err = prRepo.GetPRsByReviewers(ctx, []string{"batch-a1"}, entity.PullRequestStatusOpen)
+	require.NoError(t, err)
+	require.Len(t, prs["batch-a1"], 1)
+	assert.Equal(t, "pr-batch-1", prs["batch-a1"][0].PullRequestID)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-batch-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name LIKE 'batch-test-team-%'")
+}
+
+func TestIntegration_Repository_ListTeamsAndUsers(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "list-users-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "list-users-u1", Username: "List Users 1", IsActive: true},
+			{UserID: "list-users-u2", Username: "List Users 2", IsActive: false},
+			{UserID: "list-users-u3", Username: "List Users 3", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'list-users-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	// Test ListTeams
+	teams, err := teamRepo.ListTeams(ctx)
+	require.NoError(t, err)
+
+	found := false
+	for _, summary := range teams {
+		if summary.TeamName == "list-users-test-team" {
+			found = true
+			assert.Equal(t, 3, summary.MemberCount)
+			assert.Equal(t, 2, summary.ActiveCount)
+		}
+	}
+	assert.True(t, found, "team should be listed")
+
+	// Test ListUsers with pagination
+	isActive := true
+	filter := entity.UserFilter{TeamName: "list-users-test-team", IsActive: &isActive}
+
+	users, err := userRepo.ListUsers(ctx, filter, nil, 1)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "list-users-u1", users[0].UserID)
+
+	users, err = userRepo.ListUsers(ctx, filter, &entity.Cursor{ID: users[0].UserID}, 10)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "list-users-u3", users[0].UserID)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'list-users-test-team'")
+}
+
+func TestIntegration_Repository_Stats(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+	statsRepo := persistent.NewStatsRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "stats-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "stats-u1", Username: "Stats User 1", IsActive: true},
+			{UserID: "stats-u2", Username: "Stats User 2", IsActive: true},
+			{UserID: "stats-u3", Username: "Stats User 3", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-stats-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'stats-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	now := time.Now()
+	for _, id := range []string{"pr-stats-1", "pr-stats-2"} {
+		err = prRepo.CreatePR(ctx, entity.PullRequest{
+			PullRequestID:   id,
+			PullRequestName: "Stats Test PR",
+			AuthorID:        "stats-u1",
+			Status:          entity.PullRequestStatusOpen,
+			CreatedAt:       &now,
+		}, []string{"stats-u2"})
+		require.NoError(t, err)
+	}
+
+	// Reassign one review away from u2 and merge the other one
+	err = prRepo.ReassignReviewer(ctx, "pr-stats-1", "stats-u2", "stats-u3", entity.ReassignmentManual)
+	require.NoError(t, err)
+
+	mergedAt := entity.Time(time.Now())
+	err = prRepo.UpdatePRStatus(ctx, "pr-stats-2", entity.PullRequestStatusMerged, &mergedAt)
+	require.NoError(t, err)
+
+	window := entity.TimeWindow{From: now.Add(-time.Hour), To: now.Add(time.Hour)}
+
+	reviewers, err := statsRepo.GetReviewerStats(ctx, window, "stats-test-team")
+	require.NoError(t, err)
+	require.Len(t, reviewers, 3)
+
+	byUser := make(map[string]entity.ReviewerStats, len(reviewers))
+	for _, s := range reviewers {
+		byUser[s.UserID] = s
+	}
+
+	assert.Equal(t, 2, byUser["stats-u2"].Assignments)
+	assert.Equal(t, 0, byUser["stats-u2"].OpenReviews)
+	assert.Equal(t, 1, byUser["stats-u2"].ReassignmentsAway)
+	assert.NotNil(t, byUser["stats-u2"].MedianTimeToMergeSeconds)
+	assert.Equal(t, 1, byUser["stats-u3"].Assignments)
+	assert.Equal(t, 1, byUser["stats-u3"].OpenReviews)
+	assert.Nil(t, byUser["stats-u3"].MedianTimeToMergeSeconds)
+
+	teams, err := statsRepo.GetTeamStats(ctx, window)
+	require.NoError(t, err)
+
+	found := false
+	for _, s := range teams {
+		if s.TeamName == "stats-test-team" {
+			found = true
+			assert.Equal(t, 3, s.Assignments)
+			assert.Equal(t, 1, s.OpenReviews)
+			assert.Equal(t, 1, s.ReassignmentsAway)
+		}
+	}
+	assert.True(t, found, "team should be listed")
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id LIKE 'pr-stats-%'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'stats-test-team'")
+}
+
+func TestIntegration_Repository_OverdueReviews(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "overdue-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "overdue-u1", Username: "Overdue User 1", IsActive: true},
+			{UserID: "overdue-u2", Username: "Overdue User 2", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-overdue-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'overdue-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	now := time.Now()
+	err = prRepo.CreatePR(ctx, entity.PullRequest{
+		PullRequestID:   "pr-overdue-test",
+		PullRequestName: "Overdue Test PR",
+		AuthorID:        "overdue-u1",
+		Status:          entity.PullRequestStatusOpen,
+		CreatedAt:       &now,
+	}, []string{"overdue-u2"})
+	require.NoError(t, err)
+
+	// Backdate the assignment past the default SLA
+	_, err = testDB.Pool.Exec(ctx, "UPDATE pr_reviewers SET created_at = LOCALTIMESTAMP - INTERVAL '2 hours' WHERE pull_request_id = 'pr-overdue-test'")
+	require.NoError(t, err)
+
+	reviews, err := prRepo.GetOverdueReviews(ctx, time.Hour, "overdue-test-team")
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	assert.Equal(t, "overdue-u2", reviews[0].ReviewerID)
+	assert.Equal(t, int64(3600), reviews[0].SLASeconds)
+	assert.GreaterOrEqual(t, reviews[0].OverdueSeconds, int64(3500))
+
+	// A team SLA longer than the assignment age takes precedence over the default
+	sla := 3 * 3600
+	err = teamRepo.SetReviewSLA(ctx, "overdue-test-team", &sla)
+	require.NoError(t, err)
+
+	reviews, err = prRepo.GetOverdueReviews(ctx, time.Hour, "overdue-test-team")
+	require.NoError(t, err)
+	assert.Empty(t, reviews)
+
+	counts, err := prRepo.CountOverdueReviews(ctx, time.Hour)
+	require.NoError(t, err)
+
+	found := false
+	for _, c := range counts {
+		if c.TeamName == "overdue-test-team" {
+			found = true
+			assert.Equal(t, 0, c.Count)
+		}
+	}
+	assert.True(t, found, "team should be counted")
+
+	// Test SetReviewSLA for unknown team
+	err = teamRepo.SetReviewSLA(ctx, "overdue-missing-team", nil)
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-overdue-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'overdue-test-team'")
+}
+
+func TestIntegration_Repository_StaleReviewsAndLock(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+	lockRepo := persistent.NewLockRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "stale-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "stale-u1", Username: "Stale User 1", IsActive: true},
+			{UserID: "stale-u2", Username: "Stale User 2", IsActive: true},
+			{UserID: "stale-u3", Username: "Stale User 3", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-stale-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'stale-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	now := time.Now()
+	err = prRepo.CreatePR(ctx, entity.PullRequest{
+		PullRequestID:   "pr-stale-test",
+		PullRequestName: "Stale Test PR",
+		AuthorID:        "stale-u1",
+		Status:          entity.PullRequestStatusOpen,
+		CreatedAt:       &now,
+	}, []string{"stale-u2"})
+	require.NoError(t, err)
+
+	_, err = testDB.Pool.Exec(ctx, "UPDATE pr_reviewers SET created_at = LOCALTIMESTAMP - INTERVAL '3 hours' WHERE pull_request_id = 'pr-stale-test'")
+	require.NoError(t, err)
+
+	// The team threshold overrides the much longer default
+	threshold := 3600
+	err = teamRepo.SetEscalationThreshold(ctx, "stale-test-team", &threshold)
+	require.NoError(t, err)
+
+	stale, err := prRepo.GetStaleReviews(ctx, 1000*time.Hour, 1000)
+	require.NoError(t, err)
+
+	found := false
+	for _, review := range stale {
+		if review.PullRequestID == "pr-stale-test" {
+			found = true
+			assert.Equal(t, "stale-u2", review.ReviewerID)
+			assert.Equal(t, int64(threshold), review.SLASeconds)
+		}
+	}
+	assert.True(t, found, "stale assignment should be returned")
+
+	// Automatic reassignments are recorded with their reason
+	err = prRepo.ReassignReviewer(ctx, "pr-stale-test", "stale-u2", "stale-u3", entity.ReassignmentAutomatic)
+	require.NoError(t, err)
+
+	var reason string
+	err = testDB.Pool.QueryRow(ctx, "SELECT reason FROM pr_reassignments WHERE pull_request_id = 'pr-stale-test'").Scan(&reason)
+	require.NoError(t, err)
+	assert.Equal(t, string(entity.ReassignmentAutomatic), reason)
+
+	// Only one holder of the advisory lock at a time
+	const lockKey int64 = 424242
+	acquired, err := lockRepo.WithTryLock(ctx, lockKey, func(ctx context.Context) error {
+		nested, err := lockRepo.WithTryLock(ctx, lockKey, func(context.Context) error {
+			t.Fatal("lock must not be acquired twice")
+			return nil
+		})
+		require.NoError(t, err)
+		assert.False(t, nested)
+
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, acquired)
+
+	acquired, err = lockRepo.WithTryLock(ctx, lockKey, func(context.Context) error { return nil })
+	require.NoError(t, err)
+	assert.True(t, acquired, "lock should be released after use")
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-stale-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'stale-test-team'")
+}
+
+func TestIntegration_Webhooks_DeliveryAndRetry(t *testing.T) {
+	ctx := context.Background()
+	webhookRepo := persistent.NewWebhookRepo(testDB)
+
+	// Local stand-ins for subscriber endpoints
+	received := make(chan *http.Request, 1)
+	okServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer okServer.Close()
+
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM webhooks")
+
+	_, err := webhookRepo.CreateWebhook(ctx, entity.Webhook{
+		WebhookID: "wh-it-ok", URL: okServer.URL, Secret: "secret", EventTypes: []entity.EventType{entity.EventPRMerged}, IsActive: true,
+	})
+	require.NoError(t, err)
+
+	_, err = webhookRepo.CreateWebhook(ctx, entity.Webhook{
+		WebhookID: "wh-it-failing", URL: failingServer.URL, Secret: "secret", EventTypes: []entity.EventType{}, IsActive: true,
+	})
+	require.NoError(t, err)
+
+	_, err = webhookRepo.CreateWebhook(ctx, entity.Webhook{
+		WebhookID: "wh-it-other", URL: okServer.URL, Secret: "secret", EventTypes: []entity.EventType{entity.EventPRCreated}, IsActive: true,
+	})
+	require.NoError(t, err)
+
+	webhooks, err := webhookRepo.ListWebhooks(ctx)
+	require.NoError(t, err)
+	assert.Len(t, webhooks, 3)
+	for _, w := range webhooks {
+		assert.Empty(t, w.Secret, "secrets must not be listed")
+	}
+
+	uc := webhook.New(webhookRepo, webapi.NewWebhookSender(time.Second), webhook.RetryPolicy{
+		MaxAttempts: 2,
+		BaseBackoff: time.Hour,
+		MaxBackoff:  time.Hour,
+	}, 10)
+
+	err = uc.Publish(ctx, entity.Event{EventID: "evt-it-1", Type: entity.EventPRMerged, Data: entity.PullRequestEventData{}})
+	require.NoError(t, err)
+
+	// Publishing the same event twice does not duplicate deliveries
+	err = uc.Publish(ctx, entity.Event{EventID: "evt-it-1", Type: entity.EventPRMerged, Data: entity.PullRequestEventData{}})
+	require.NoError(t, err)
+
+	result, err := uc.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryResult{Succeeded: 1, Retrying: 1}, result)
+
+	req := <-received
+	assert.Equal(t, string(entity.EventPRMerged), req.Header.Get(webapi.HeaderEvent))
+	assert.NotEmpty(t, req.Header.Get(webapi.HeaderSignature))
+
+	delivered, err := webhookRepo.ListDeliveries(ctx, "wh-it-ok", "", 10)
+	require.NoError(t, err)
+	require.Len(t, delivered, 1)
+	assert.Equal(t, entity.DeliveryStatusSucceeded, delivered[0].Status)
+	assert.NotNil(t, delivered[0].DeliveredAt)
+
+	failed, err := webhookRepo.ListDeliveries(ctx, "wh-it-failing", "", 10)
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, entity.DeliveryStatusPending, failed[0].Status)
+	assert.Equal(t, 1, failed[0].Attempts)
+	require.NotNil(t, failed[0].LastStatusCode)
+	assert.Equal(t, http.StatusInternalServerError, *failed[0].LastStatusCode)
+
+	// The retry is backed off, so nothing is due right now
+	result, err = uc.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryResult{}, result)
+
+	// Once due again, the last allowed attempt marks the delivery as failed
+	_, err = testDB.Pool.Exec(ctx, "UPDATE webhook_deliveries SET next_attempt_at = LOCALTIMESTAMP WHERE webhook_id = 'wh-it-failing'")
+	require.NoError(t, err)
+
+	result, err = uc.DeliverPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, entity.DeliveryResult{Failed: 1}, result)
+
+	other, err := webhookRepo.ListDeliveries(ctx, "wh-it-other", "", 10)
+	require.NoError(t, err)
+	assert.Empty(t, other, "webhooks only receive subscribed event types")
+
+	// Deleting a webhook removes its deliveries
+	require.NoError(t, webhookRepo.DeleteWebhook(ctx, "wh-it-failing"))
+	assert.ErrorIs(t, webhookRepo.DeleteWebhook(ctx, "wh-it-failing"), entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM webhooks")
+}
+
+func TestIntegration_Repository_OutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+	outboxRepo := persistent.NewOutboxRepo(testDB)
+
+	// Setup: create team
+	team := entity.Team{
+		TeamName: "outbox-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "outbox-u1", Username: "Outbox User 1", IsActive: true},
+			{UserID: "outbox-u2", Username: "Outbox User 2", IsActive: true},
+			{UserID: "outbox-u3", Username: "Outbox User 3", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-outbox-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'outbox-test-team'")
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	pr := entity.PullRequest{
+		PullRequestID:   "pr-outbox-test",
+		PullRequestName: "Outbox Test PR",
+		AuthorID:        "outbox-u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+	err = prRepo.CreatePR(ctx, pr, []string{"outbox-u2"})
+	require.NoError(t, err)
+
+	// A rolled back change leaves no event behind
+	err = prRepo.CreatePR(ctx, pr, []string{"outbox-u3"})
+	require.Error(t, err)
+
+	err = prRepo.ReassignReviewer(ctx, "pr-outbox-test", "outbox-u2", "outbox-u3", entity.ReassignmentManual)
+	require.NoError(t, err)
+
+	err = prRepo.RecordReview(ctx, "pr-outbox-test", "outbox-u3", entity.ReviewOutcomeApproved)
+	require.NoError(t, err)
+
+	// A review by someone not assigned records nothing
+	err = prRepo.RecordReview(ctx, "pr-outbox-test", "outbox-u2", entity.ReviewOutcomeApproved)
+	assert.ErrorIs(t, err, entity.ErrNotAssigned)
+
+	mergedAt := entity.Time(time.Now())
+	err = prRepo.UpdatePRStatus(ctx, "pr-outbox-test", entity.PullRequestStatusMerged, &mergedAt)
+	require.NoError(t, err)
+
+	// Updating an already merged PR is not a transition
+	err = prRepo.UpdatePRStatus(ctx, "pr-outbox-test", entity.PullRequestStatusMerged, &mergedAt)
+	require.NoError(t, err)
+
+	err = prRepo.UpdatePRStatus(ctx, "pr-outbox-missing", entity.PullRequestStatusMerged, &mergedAt)
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	events, err := outboxRepo.GetUnpublishedEvents(ctx, 100)
+	require.NoError(t, err)
+
+	types := make([]entity.EventType, 0, len(events))
+	for _, e := range events {
+		types = append(types, e.Type)
+	}
+	assert.Equal(t, []entity.EventType{
+		entity.EventPRCreated,
+		entity.EventReviewerAssigned,
+		entity.EventReviewerReassigned,
+		entity.EventReviewSubmitted,
+		entity.EventPRMerged,
+	}, types)
+
+	require.Len(t, events, 5)
+	assert.JSONEq(t, `{"pull_request_id":"pr-outbox-test","reviewer_id":"outbox-u2"}`, string(events[1].Payload))
+	assert.JSONEq(t, `{"pull_request_id":"pr-outbox-test","old_reviewer_id":"outbox-u2","new_reviewer_id":"outbox-u3","reason":"MANUAL"}`, string(events[2].Payload))
+	assert.JSONEq(t, `{"pull_request_id":"pr-outbox-test","reviewer_id":"outbox-u3","outcome":"APPROVED"}`, string(events[3].Payload))
+
+	var merged entity.PullRequestEventData
+	require.NoError(t, json.Unmarshal(events[4].Payload, &merged))
+	assert.Equal(t, entity.PullRequestStatusMerged, merged.PullRequest.Status)
+	assert.Equal(t, []string{"outbox-u3"}, merged.PullRequest.AssignedReviewers)
+	assert.NotNil(t, merged.PullRequest.MergedAt)
+
+	// Failed attempts are recorded and the event stays unpublished; published events are skipped
+	require.NoError(t, outboxRepo.MarkFailed(ctx, events[0].OutboxID, "sink unavailable"))
+	require.NoError(t, outboxRepo.MarkPublished(ctx, events[1].OutboxID))
+
+	events, err = outboxRepo.GetUnpublishedEvents(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, events, 4)
+	assert.Equal(t, 1, events[0].Attempts)
+	assert.Equal(t, entity.EventReviewerReassigned, events[1].Type)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-outbox-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'outbox-test-team'")
+}
+
+func TestIntegration_Repository_IdentitiesAndReviews(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+	identityRepo := persistent.NewIdentityRepo(testDB)
+
+	team := entity.Team{
+		TeamName: "identity-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "identity-u1", Username: "Identity User 1", IsActive: true},
+			{UserID: "identity-u2", Username: "Identity User 2", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-identity-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'identity-test-team'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM user_identities WHERE user_id LIKE 'identity-%'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	// Remapping a user replaces their previous login
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "old-login", UserID: "identity-u2"}))
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "identity-bob", UserID: "identity-u2"}))
+
+	userID, err := identityRepo.GetUserIDByLogin(ctx, entity.IdentityProviderGitHub, "identity-bob")
+	require.NoError(t, err)
+	assert.Equal(t, "identity-u2", userID)
+
+	_, err = identityRepo.GetUserIDByLogin(ctx, entity.IdentityProviderGitHub, "old-login")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	login, err := identityRepo.GetLoginByUserID(ctx, entity.IdentityProviderGitHub, "identity-u2")
+	require.NoError(t, err)
+	assert.Equal(t, "identity-bob", login)
+
+	_, err = identityRepo.GetLoginByUserID(ctx, entity.IdentityProviderGitLab, "identity-u2")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	identities, err := identityRepo.ListIdentities(ctx, entity.IdentityProviderGitHub)
+	require.NoError(t, err)
+	logins := make([]string, 0, len(identities))
+	for _, identity := range identities {
+		logins = append(logins, identity.Login)
+	}
+	assert.Contains(t, logins, "identity-bob")
+	assert.NotContains(t, logins, "old-login")
+
+	// Recorded reviews no longer count as overdue
+	pr := entity.PullRequest{
+		PullRequestID:   "pr-identity-test",
+		PullRequestName: "Identity Test PR",
+		AuthorID:        "identity-u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+	require.NoError(t, prRepo.CreatePR(ctx, pr, []string{"identity-u2"}))
+
+	overdue, err := prRepo.GetOverdueReviews(ctx, 0, "identity-test-team")
+	require.NoError(t, err)
+	assert.Len(t, overdue, 1)
+
+	err = prRepo.RecordReview(ctx, "pr-identity-test", "identity-u1", entity.ReviewOutcomeApproved)
+	assert.ErrorIs(t, err, entity.ErrNotAssigned)
+
+	require.NoError(t, prRepo.RecordReview(ctx, "pr-identity-test", "identity-u2", entity.ReviewOutcomeApproved))
+
+	assignments, err := prRepo.GetReviewerAssignments(ctx, []string{"pr-identity-test"})
+	require.NoError(t, err)
+	require.Len(t, assignments["pr-identity-test"], 1)
+	require.NotNil(t, assignments["pr-identity-test"][0].ReviewOutcome)
+	assert.Equal(t, entity.ReviewOutcomeApproved, *assignments["pr-identity-test"][0].ReviewOutcome)
+	assert.NotNil(t, assignments["pr-identity-test"][0].ReviewedAt)
+
+	overdue, err = prRepo.GetOverdueReviews(ctx, 0, "identity-test-team")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+
+	require.NoError(t, identityRepo.DeleteIdentity(ctx, entity.IdentityProviderGitHub, "identity-bob"))
+	assert.ErrorIs(t, identityRepo.DeleteIdentity(ctx, entity.IdentityProviderGitHub, "identity-bob"), entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-identity-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'identity-test-team'")
+}
+
+func TestIntegration_Repository_ClosePR(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	team := entity.Team{
+		TeamName: "close-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "close-u1", Username: "Close User 1", IsActive: true},
+			{UserID: "close-u2", Username: "Close User 2", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-close-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'close-test-team'")
+
+	require.NoError(t, teamRepo.CreateTeam(ctx, team))
+
+	pr := entity.PullRequest{
+		PullRequestID:   "pr-close-test",
+		PullRequestName: "Close Test PR",
+		AuthorID:        "close-u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+	require.NoError(t, prRepo.CreatePR(ctx, pr, []string{"close-u2"}))
+
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-close-test", entity.PullRequestStatusClosed, nil))
+
+	closed, err := prRepo.GetPR(ctx, "pr-close-test")
+	require.NoError(t, err)
+	assert.Equal(t, entity.PullRequestStatusClosed, closed.Status)
+	assert.Nil(t, closed.MergedAt)
+
+	// Statuses without an audit action are rejected
+	assert.Error(t, prRepo.UpdatePRStatus(ctx, "pr-close-test", entity.PullRequestStatus("DRAFT"), nil))
+
+	// Closed PRs leave the overdue list
+	overdue, err := prRepo.GetOverdueReviews(ctx, 0, "close-test-team")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+
+	// Reopening keeps the reviewers, clears closed_at and is audited and published
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-close-test", entity.PullRequestStatusOpen, nil))
+
+	record, err := prRepo.GetPRRecord(ctx, "pr-close-test")
+	require.NoError(t, err)
+	assert.Equal(t, entity.PullRequestStatusOpen, record.PullRequest.Status)
+	assert.Equal(t, []string{"close-u2"}, record.PullRequest.AssignedReviewers)
+	assert.Nil(t, record.ClosedAt)
+
+	entries, err := persistent.NewAuditRepo(testDB).ListEntries(ctx, entity.AuditFilter{EntityID: "pr-close-test"}, nil, 10)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+	assert.Equal(t, entity.AuditPRReopened, entries[0].Action)
+
+	events, err := persistent.NewOutboxRepo(testDB).GetUnpublishedEvents(ctx, 100)
+	require.NoError(t, err)
+
+	var reopened *entity.PullRequestEventData
+	for _, e := range events {
+		var data entity.PullRequestEventData
+		if e.Type == entity.EventPRReopened && json.Unmarshal(e.Payload, &data) == nil && data.PullRequest.PullRequestID == "pr-close-test" {
+			reopened = &data
+		}
+	}
+	require.NotNil(t, reopened)
+	assert.Equal(t, entity.PullRequestStatusOpen, reopened.PullRequest.Status)
+
+	overdue, err = prRepo.GetOverdueReviews(ctx, 0, "close-test-team")
+	require.NoError(t, err)
+	assert.NotEmpty(t, overdue)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-close-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'close-test-team'")
+}
+
+func TestIntegration_Repository_ChatNotifications(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	team := entity.Team{
+		TeamName: "chat-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "chat-u1", Username: "Chat User 1", IsActive: true},
+			{UserID: "chat-u2", Username: "Chat User 2", IsActive: true},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-chat-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'chat-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	url, err := teamRepo.GetChatWebhookURL(ctx, "chat-test-team")
+	require.NoError(t, err)
+	assert.Empty(t, url)
+
+	_, err = teamRepo.GetChatWebhookURL(ctx, "chat-missing-team")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	now := time.Now()
+	err = prRepo.CreatePR(ctx, entity.PullRequest{
+		PullRequestID:   "pr-chat-test",
+		PullRequestName: "Chat Test PR",
+		AuthorID:        "chat-u1",
+		Status:          entity.PullRequestStatusOpen,
+		CreatedAt:       &now,
+	}, []string{"chat-u2"})
+	require.NoError(t, err)
+
+	_, err = testDB.Pool.Exec(ctx, "UPDATE pr_reviewers SET created_at = LOCALTIMESTAMP - INTERVAL '2 hours' WHERE pull_request_id = 'pr-chat-test'")
+	require.NoError(t, err)
+
+	sla := 3600
+	err = teamRepo.SetReviewSLA(ctx, "chat-test-team", &sla)
+	require.NoError(t, err)
+
+	containsChatReview := func(reviews []entity.OverdueReview) bool {
+		for _, review := range reviews {
+			if review.PullRequestID == "pr-chat-test" && review.ReviewerID == "chat-u2" {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// Teams without a channel are not announced
+	reviews, err := prRepo.GetUnnotifiedOverdueReviews(ctx, 1000*time.Hour, 1000)
+	require.NoError(t, err)
+	assert.False(t, containsChatReview(reviews))
+
+	channel := "https://chat.example.com/hooks/chat-test-team"
+	err = teamRepo.SetChatWebhookURL(ctx, "chat-test-team", &channel)
+	require.NoError(t, err)
+
+	url, err = teamRepo.GetChatWebhookURL(ctx, "chat-test-team")
+	require.NoError(t, err)
+	assert.Equal(t, channel, url)
+
+	teams, err := teamRepo.ListTeams(ctx)
+	require.NoError(t, err)
+	for _, summary := range teams {
+		if summary.TeamName == "chat-test-team" {
+			assert.True(t, summary.ChatWebhookConfigured)
+		}
+	}
+
+	reviews, err = prRepo.GetUnnotifiedOverdueReviews(ctx, 1000*time.Hour, 1000)
+	require.NoError(t, err)
+	assert.True(t, containsChatReview(reviews))
+
+	// Each overdue review is announced once
+	err = prRepo.MarkOverdueNotified(ctx, "pr-chat-test", "chat-u2")
+	require.NoError(t, err)
+
+	reviews, err = prRepo.GetUnnotifiedOverdueReviews(ctx, 1000*time.Hour, 1000)
+	require.NoError(t, err)
+	assert.False(t, containsChatReview(reviews))
+
+	err = prRepo.MarkOverdueNotified(ctx, "pr-chat-test", "chat-u1")
+	assert.ErrorIs(t, err, entity.ErrNotAssigned)
+
+	err = teamRepo.SetChatWebhookURL(ctx, "chat-missing-team", &channel)
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-chat-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'chat-test-team'")
+}
+
+func TestIntegration_Repository_EmailDigest(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+
+	team := entity.Team{
+		TeamName: "digest-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "digest-u1", Username: "Digest User 1", IsActive: true},
+			{UserID: "digest-u2", Username: "Digest User 2", IsActive: false},
+		},
+	}
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM users WHERE team_name = 'digest-test-team'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'digest-test-team'")
+
+	err := teamRepo.CreateTeam(ctx, team)
+	require.NoError(t, err)
+
+	findRecipient := func(recipients []entity.DigestRecipient, userID string) (entity.DigestRecipient, bool) {
+		for _, recipient := range recipients {
+			if recipient.UserID == userID {
+				return recipient, true
+			}
+		}
+
+		return entity.DigestRecipient{}, false
+	}
+
+	today := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	// Users without an email address get no digest
+	recipients, err := userRepo.GetDigestRecipients(ctx, today, 1000)
+	require.NoError(t, err)
+	_, ok := findRecipient(recipients, "digest-u1")
+	assert.False(t, ok)
+
+	for _, userID := range []string{"digest-u1", "digest-u2"} {
+		err = userRepo.SetDigestSettings(ctx, entity.DigestSettings{UserID: userID, Email: userID + "@example.com", DigestEnabled: true})
+		require.NoError(t, err)
+	}
+
+	// Inactive users get no digest
+	recipients, err = userRepo.GetDigestRecipients(ctx, today, 1000)
+	require.NoError(t, err)
+	recipient, ok := findRecipient(recipients, "digest-u1")
+	require.True(t, ok)
+	assert.Equal(t, entity.DigestRecipient{UserID: "digest-u1", Username: "Digest User 1", Email: "digest-u1@example.com"}, recipient)
+	_, ok = findRecipient(recipients, "digest-u2")
+	assert.False(t, ok)
+
+	// One digest per day
+	err = userRepo.MarkDigestSent(ctx, "digest-u1", today)
+	require.NoError(t, err)
+
+	recipients, err = userRepo.GetDigestRecipients(ctx, today, 1000)
+	require.NoError(t, err)
+	_, ok = findRecipient(recipients, "digest-u1")
+	assert.False(t, ok)
+
+	recipients, err = userRepo.GetDigestRecipients(ctx, today.AddDate(0, 0, 1), 1000)
+	require.NoError(t, err)
+	_, ok = findRecipient(recipients, "digest-u1")
+	assert.True(t, ok)
+
+	// Opted out users get no digest
+	err = userRepo.SetDigestSettings(ctx, entity.DigestSettings{UserID: "digest-u1", Email: "digest-u1@example.com", DigestEnabled: false})
+	require.NoError(t, err)
+
+	recipients, err = userRepo.GetDigestRecipients(ctx, today.AddDate(0, 0, 1), 1000)
+	require.NoError(t, err)
+	_, ok = findRecipient(recipients, "digest-u1")
+	assert.False(t, ok)
+
+	err = userRepo.SetDigestSettings(ctx, entity.DigestSettings{UserID: "digest-missing", DigestEnabled: true})
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM users WHERE team_name = 'digest-test-team'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'digest-test-team'")
+}
+
+func TestIntegration_Repository_EventBus(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	eventBusRepo := persistent.NewEventBusRepo(testDB)
+
+	received := make(chan entity.StreamEvent, 1)
+	listening := make(chan error, 1)
+
+	listenCtx, stopListening := context.WithCancel(ctx)
+	go func() {
+		listening <- eventBusRepo.Listen(listenCtx, func(event entity.StreamEvent) {
+			select {
+			case received <- event:
+			default:
+			}
+		})
+	}()
+
+	event := entity.StreamEvent{
+		Event: entity.Event{
+			EventID:    "evt-bus-test",
+			Type:       entity.EventReviewSubmitted,
+			OccurredAt: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+			Data:       map[string]interface{}{"pull_request_id": "pr-bus-test", "reviewer_id": "bus-u2", "outcome": "APPROVED"},
+		},
+		UserIDs:   []string{"bus-u1", "bus-u2"},
+		TeamNames: []string{"bus-team"},
+	}
+
+	// Notifications sent before LISTEN took effect are lost, so keep notifying until one arrives
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	var got entity.StreamEvent
+wait:
+	for {
+		require.NoError(t, eventBusRepo.Notify(ctx, event))
+
+		select {
+		case got = <-received:
+			break wait
+		case <-ticker.C:
+		case <-ctx.Done():
+			t.Fatal("no event received")
+		}
+	}
+
+	assert.Equal(t, event, got)
+
+	// Stopping the listener is not an error
+	stopListening()
+	assert.NoError(t, <-listening)
+}
+
+func TestIntegration_Repository_AuditLog(t *testing.T) {
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+	auditRepo := persistent.NewAuditRepo(testDB)
+
+	// The log is append-only, so entries of this run are told apart by the request ID
+	requestID := fmt.Sprintf("audit-test-%d", time.Now().UnixNano())
+	ctx := entity.WithAuditMeta(context.Background(), entity.AuditMeta{Actor: "audit-u1", RequestID: requestID})
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-audit-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'audit-test-team'")
+
+	require.NoError(t, teamRepo.CreateTeam(ctx, entity.Team{
+		TeamName: "audit-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "audit-u1", Username: "Audit User 1", IsActive: true},
+			{UserID: "audit-u2", Username: "Audit User 2", IsActive: true},
+			{UserID: "audit-u3", Username: "Audit User 3", IsActive: true},
+		},
+	}))
+
+	pr := entity.PullRequest{
+		PullRequestID:   "pr-audit-test",
+		PullRequestName: "Audit Test PR",
+		AuthorID:        "audit-u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+	require.NoError(t, prRepo.CreatePR(ctx, pr, []string{"audit-u2"}))
+	require.NoError(t, prRepo.RecordReview(ctx, "pr-audit-test", "audit-u2", entity.ReviewOutcomeCommented))
+	require.NoError(t, prRepo.ReassignReviewer(ctx, "pr-audit-test", "audit-u2", "audit-u3", entity.ReassignmentManual))
+
+	mergedAt := entity.Time(time.Now())
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-audit-test", entity.PullRequestStatusMerged, &mergedAt))
+	// Merging again is not a change
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-audit-test", entity.PullRequestStatusMerged, &mergedAt))
+
+	require.NoError(t, userRepo.SetIsActive(ctx, "audit-u2", false))
+	require.NoError(t, userRepo.SetIsActive(ctx, "audit-u2", false))
+
+	entries, err := auditRepo.ListEntries(ctx, entity.AuditFilter{RequestID: requestID}, nil, 100)
+	require.NoError(t, err)
+
+	actions := make([]entity.AuditAction, 0, len(entries))
+	for _, entry := range entries {
+		assert.Equal(t, "audit-u1", entry.Actor)
+		actions = append(actions, entry.Action)
+	}
+
+	// Newest first
+	assert.Equal(t, []entity.AuditAction{
+		entity.AuditUserActivityChanged,
+		entity.AuditPRMerged,
+		entity.AuditReviewerReassigned,
+		entity.AuditReviewRecorded,
+		entity.AuditPRCreated,
+		entity.AuditTeamCreated,
+	}, actions)
+
+	// The replaced reviewer's assignment, including the review, survives in the before snapshot
+	var replaced entity.ReviewerSnapshot
+	require.NoError(t, json.Unmarshal(entries[2].Before, &replaced))
+	assert.Equal(t, "audit-u2", replaced.ReviewerID)
+	require.NotNil(t, replaced.ReviewOutcome)
+	assert.Equal(t, entity.ReviewOutcomeCommented, *replaced.ReviewOutcome)
+
+	var reviewed entity.ReviewerSnapshot
+	require.NoError(t, json.Unmarshal(entries[3].After, &reviewed))
+	require.NotNil(t, reviewed.ReviewOutcome)
+	assert.Equal(t, entity.ReviewOutcomeCommented, *reviewed.ReviewOutcome)
+	assert.NotNil(t, reviewed.ReviewedAt)
+	assert.Nil(t, entries[4].Before)
+
+	// Filters and pagination
+	page, err := auditRepo.ListEntries(ctx, entity.AuditFilter{
+		RequestID:  requestID,
+		EntityType: entity.AuditEntityPullRequest,
+		EntityID:   "pr-audit-test",
+	}, nil, 2)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+
+	cursor := entity.AuditCursor(page[1])
+	rest, err := auditRepo.ListEntries(ctx, entity.AuditFilter{RequestID: requestID, EntityID: "pr-audit-test"}, &cursor, 10)
+	require.NoError(t, err)
+	require.Len(t, rest, 2)
+	assert.Equal(t, entity.AuditPRCreated, rest[1].Action)
+
+	// Entries can be neither changed nor removed
+	_, err = testDB.Pool.Exec(ctx, "UPDATE audit_log SET actor = 'someone-else' WHERE request_id = $1", requestID)
+	assert.Error(t, err)
+	_, err = testDB.Pool.Exec(ctx, "DELETE FROM audit_log WHERE request_id = $1", requestID)
+	assert.Error(t, err)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-audit-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'audit-test-team'")
+}
+
+func TestIntegration_Repository_AuditLog_Settings(t *testing.T) {
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+	apiKeyRepo := persistent.NewAPIKeyRepo(testDB)
+	identityRepo := persistent.NewIdentityRepo(testDB)
+	webhookRepo := persistent.NewWebhookRepo(testDB)
+	auditRepo := persistent.NewAuditRepo(testDB)
+
+	requestID := fmt.Sprintf("audit-settings-test-%d", time.Now().UnixNano())
+	ctx := entity.WithAuditMeta(context.Background(), entity.AuditMeta{Actor: "audit-s1", RequestID: requestID})
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'audit-settings-team'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM user_identities WHERE user_id = 'audit-s1'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM webhooks WHERE webhook_id = 'wh-audit-test'")
+
+	require.NoError(t, teamRepo.CreateTeam(ctx, entity.Team{
+		TeamName: "audit-settings-team",
+		Members:  []entity.TeamMember{{UserID: "audit-s1", Username: "Audit Settings User", IsActive: true}},
+	}))
+
+	sla := 3600
+	require.NoError(t, teamRepo.SetReviewSLA(ctx, "audit-settings-team", &sla))
+	// Setting the same value again is not a change
+	require.NoError(t, teamRepo.SetReviewSLA(ctx, "audit-settings-team", &sla))
+	hookURL := "https://chat.example.com/hooks/secret-token"
+	require.NoError(t, teamRepo.SetChatWebhookURL(ctx, "audit-settings-team", &hookURL))
+	require.NoError(t, userRepo.SetDigestSettings(ctx, entity.DigestSettings{UserID: "audit-s1", Email: "s1@example.com", DigestEnabled: true}))
+
+	key, err := apiKeyRepo.CreateAPIKey(ctx, entity.APIKey{
+		KeyID:       fmt.Sprintf("key-audit-%d", time.Now().UnixNano()),
+		Name:        "audit test",
+		Token:       "prk_audit_secret",
+		TokenHash:   "hash",
+		TokenPrefix: "prk_audi",
+		Scopes:      []entity.APIKeyScope{entity.APIKeyScopeRead},
+	})
+	require.NoError(t, err)
+	require.NoError(t, apiKeyRepo.RevokeAPIKey(ctx, key.KeyID))
+	require.NoError(t, apiKeyRepo.RevokeAPIKey(ctx, key.KeyID))
+
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "audit-old", UserID: "audit-s1"}))
+	// Remapping the user replaces the previous login
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "audit-new", UserID: "audit-s1"}))
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "audit-new", UserID: "audit-s1"}))
+	require.NoError(t, identityRepo.DeleteIdentity(ctx, entity.IdentityProviderGitHub, "audit-new"))
+
+	_, err = webhookRepo.CreateWebhook(ctx, entity.Webhook{WebhookID: "wh-audit-test", URL: "https://example.com/hook", Secret: "wh-secret", IsActive: true})
+	require.NoError(t, err)
+	require.NoError(t, webhookRepo.DeleteWebhook(ctx, "wh-audit-test"))
+
+	entries, err := auditRepo.ListEntries(ctx, entity.AuditFilter{RequestID: requestID}, nil, 100)
+	require.NoError(t, err)
+
+	actions := make([]entity.AuditAction, 0, len(entries))
+	entityIDs := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		actions = append(actions, entry.Action)
+		entityIDs = append(entityIDs, entry.EntityID)
+	}
+
+	// Newest first
+	assert.Equal(t, []entity.AuditAction{
+		entity.AuditWebhookDeleted,
+		entity.AuditWebhookCreated,
+		entity.AuditIdentityDeleted,
+		entity.AuditIdentitySet,
+		entity.AuditIdentityDeleted,
+		entity.AuditIdentitySet,
+		entity.AuditAPIKeyRevoked,
+		entity.AuditAPIKeyCreated,
+		entity.AuditUserDigestSettingsChanged,
+		entity.AuditTeamChatWebhookChanged,
+		entity.AuditTeamReviewSLAChanged,
+		entity.AuditTeamCreated,
+	}, actions)
+	assert.Equal(t, []string{
+		"wh-audit-test", "wh-audit-test",
+		"github:audit-new", "github:audit-new", "github:audit-old", "github:audit-old",
+		key.KeyID, key.KeyID,
+		"audit-s1", "audit-settings-team", "audit-settings-team", "audit-settings-team",
+	}, entityIDs)
+
+	// Secrets stay out of the snapshots
+	for _, entry := range entries {
+		for _, secret := range []string{"prk_audit_secret", "wh-secret", hookURL} {
+			assert.NotContains(t, string(entry.Before), secret)
+			assert.NotContains(t, string(entry.After), secret)
+		}
+	}
+
+	var settings entity.TeamSettingsSnapshot
+	require.NoError(t, json.Unmarshal(entries[9].After, &settings))
+	assert.True(t, settings.ChatWebhookConfigured)
+	require.NotNil(t, settings.ReviewSLASeconds)
+	assert.Equal(t, sla, *settings.ReviewSLASeconds)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'audit-settings-team'")
+}
+
+func TestIntegration_Repository_ReviewerHistory(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	prRepo := persistent.NewPullRequestRepo(testDB)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-history-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'history-test-team'")
+
+	require.NoError(t, teamRepo.CreateTeam(ctx, entity.Team{
+		TeamName: "history-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "history-u1", Username: "History User 1", IsActive: true},
+			{UserID: "history-u2", Username: "History User 2", IsActive: true},
+			{UserID: "history-u3", Username: "History User 3", IsActive: true},
+		},
+	}))
+
+	pr := entity.PullRequest{
+		PullRequestID:   "pr-history-test",
+		PullRequestName: "History Test PR",
+		AuthorID:        "history-u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+	require.NoError(t, prRepo.CreatePR(ctx, pr, []string{"history-u2"}))
+	require.NoError(t, prRepo.RecordReview(ctx, "pr-history-test", "history-u2", entity.ReviewOutcomeChangesRequested))
+	require.NoError(t, prRepo.ReassignReviewer(ctx, "pr-history-test", "history-u2", "history-u3", entity.ReassignmentManual))
+	// A replaced reviewer can be picked again
+	require.NoError(t, prRepo.ReassignReviewer(ctx, "pr-history-test", "history-u3", "history-u2", entity.ReassignmentAutomatic))
+
+	// Only the current assignment counts as assigned
+	current, err := prRepo.GetPR(ctx, "pr-history-test")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"history-u2"}, current.AssignedReviewers)
+
+	err = prRepo.ReassignReviewer(ctx, "pr-history-test", "history-u3", "history-u1", entity.ReassignmentManual)
+	assert.ErrorIs(t, err, entity.ErrNotAssigned)
+
+	require.NoError(t, prRepo.UpdatePRStatus(ctx, "pr-history-test", entity.PullRequestStatusClosed, nil))
+
+	record, err := prRepo.GetPRRecord(ctx, "pr-history-test")
+	require.NoError(t, err)
+	assert.Equal(t, entity.PullRequestStatusClosed, record.PullRequest.Status)
+	assert.NotNil(t, record.ClosedAt)
+	assert.Equal(t, []string{"history-u2"}, record.PullRequest.AssignedReviewers)
+	require.Len(t, record.Assignments, 3)
+
+	// The replaced assignment keeps its review and tells who took over and why
+	first := record.Assignments[0]
+	assert.Equal(t, "history-u2", first.ReviewerID)
+	assert.NotNil(t, first.UnassignedAt)
+	assert.Equal(t, "history-u3", first.ReplacedBy)
+	assert.Equal(t, entity.ReassignmentManual, first.Reason)
+	require.NotNil(t, first.ReviewOutcome)
+	assert.Equal(t, entity.ReviewOutcomeChangesRequested, *first.ReviewOutcome)
+
+	assert.Equal(t, "history-u3", record.Assignments[1].ReviewerID)
+	assert.Equal(t, "history-u2", record.Assignments[1].ReplacedBy)
+	assert.Equal(t, entity.ReassignmentAutomatic, record.Assignments[1].Reason)
+
+	last := record.Assignments[2]
+	assert.Equal(t, "history-u2", last.ReviewerID)
+	assert.Nil(t, last.UnassignedAt)
+	assert.Empty(t, last.ReplacedBy)
+	assert.Nil(t, last.ReviewOutcome)
+
+	_, err = prRepo.GetPRRecord(ctx, "pr-history-missing")
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "UPDATE outbox_events SET published_at = LOCALTIMESTAMP WHERE published_at IS NULL")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM pull_requests WHERE pull_request_id = 'pr-history-test'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'history-test-team'")
+}
+
+type staticTokenVerifier entity.IdentityClaims
+
+func (v staticTokenVerifier) Verify(_ context.Context, _ string) (entity.IdentityClaims, error) {
+	return entity.IdentityClaims(v), nil
+}
+
+func TestIntegration_Auth_EmailClaimIgnoresDigestAddresses(t *testing.T) {
+	ctx := context.Background()
+	teamRepo := persistent.NewTeamRepo(testDB)
+	userRepo := persistent.NewUserRepo(testDB)
+	identityRepo := persistent.NewIdentityRepo(testDB)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM user_identities WHERE provider = 'oidc' AND login = 'auth-admin@example.com'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'auth-test-team'")
+
+	require.NoError(t, teamRepo.CreateTeam(ctx, entity.Team{
+		TeamName: "auth-test-team",
+		Members: []entity.TeamMember{
+			{UserID: "auth-u1", Username: "Auth Admin", IsActive: true},
+			{UserID: "auth-u2", Username: "Auth Member", IsActive: true},
+		},
+	}))
+	require.NoError(t, userRepo.SetUserRole(ctx, "auth-u1", entity.RoleAdmin))
+
+	// Another user puts the admin's sign-in address into their own digest settings
+	require.NoError(t, userRepo.SetDigestSettings(ctx, entity.DigestSettings{
+		UserID: "auth-u2", Email: "Auth-Admin@example.com", DigestEnabled: true,
+	}))
+
+	uc := auth.New(nil, staticTokenVerifier{Subject: "00u-admin", Email: "Auth-Admin@example.com"}, identityRepo, userRepo, entity.UserClaimEmail)
+
+	// Without a mapping the email resolves to nobody
+	_, err := uc.Authenticate(ctx, "jwt")
+	assert.ErrorIs(t, err, entity.ErrUnauthorized)
+
+	require.NoError(t, identityRepo.SetIdentity(ctx, entity.UserIdentity{
+		Provider: entity.IdentityProviderOIDC, Login: "auth-admin@example.com", UserID: "auth-u1",
+	}))
+
+	principal, err := uc.Authenticate(ctx, "jwt")
+	require.NoError(t, err)
+	assert.Equal(t, "auth-u1", principal.UserID)
+	assert.Equal(t, entity.RoleAdmin, principal.Role)
+
+	// Clean up
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM user_identities WHERE provider = 'oidc' AND login = 'auth-admin@example.com'")
+	_, _ = testDB.Pool.Exec(ctx, "DELETE FROM teams WHERE team_name = 'auth-test-team'")
+}
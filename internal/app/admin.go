@@ -0,0 +1,491 @@
+package app
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/finstape/pr-reviews/config"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo/persistent"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/internal/usecase/apikey"
+	"github.com/finstape/pr-reviews/internal/usecase/maintenance"
+	"github.com/finstape/pr-reviews/internal/usecase/pullrequest"
+	"github.com/finstape/pr-reviews/internal/usecase/team"
+	"github.com/finstape/pr-reviews/internal/usecase/user"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/spf13/cobra"
+)
+
+// _demoSnapshot is loaded by "app seed" when no file is given.
+//
+//go:embed seed.json
+var _demoSnapshot []byte
+
+// NewCommand builds the server command: without a subcommand it runs the service, subcommands perform maintenance.
+func NewCommand(cfg *config.Config) *cobra.Command {
+	root := &cobra.Command{
+		Use:          "app",
+		Short:        "PR review service",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		CompletionOptions: cobra.CompletionOptions{
+			DisableDefaultCmd: true,
+		},
+		Run: func(_ *cobra.Command, _ []string) {
+			Run(cfg)
+		},
+	}
+
+	root.AddCommand(
+		newMigrateCommand(cfg),
+		newSeedCommand(cfg),
+		newReassignUserCommand(cfg),
+		newExportCommand(cfg),
+		newAPIKeyCommand(cfg),
+	)
+
+	return root
+}
+
+func newMigrateCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply, roll back or inspect database migrations",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "up [N]",
+			Short: "Apply all pending migrations, or the next N",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return runMigration(cmd, cfg, args, func(m *migrate.Migrate, n int) error {
+					if n == 0 {
+						return m.Up()
+					}
+
+					return m.Steps(n)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "down [N]",
+			Short: "Roll back the last N migrations (default 1)",
+			Args:  cobra.MaximumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				if len(args) == 0 {
+					args = []string{"1"}
+				}
+
+				return runMigration(cmd, cfg, args, func(m *migrate.Migrate, n int) error {
+					return m.Steps(-n)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "status",
+			Short: "List migrations and the current database version",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				m, err := newMigrator(cfg.PG.URL)
+				if err != nil {
+					return err
+				}
+				defer m.Close()
+
+				statuses, current, dirty, err := migrationStatus(m)
+				if err != nil {
+					return err
+				}
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+
+				for _, s := range statuses {
+					status := "pending"
+					if s.Applied {
+						status = "applied"
+					}
+
+					if s.Version == current && dirty {
+						status = "dirty"
+					}
+
+					fmt.Fprintf(w, "%d\t%s\t%s\n", s.Version, s.Name, status)
+				}
+
+				return w.Flush()
+			},
+		},
+		&cobra.Command{
+			Use:   "force VERSION",
+			Short: "Set the database version without running migrations, clearing the dirty flag after a failed migration",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				version, err := strconv.Atoi(args[0])
+				if err != nil {
+					return fmt.Errorf("invalid version %q", args[0])
+				}
+
+				m, err := newMigrator(cfg.PG.URL)
+				if err != nil {
+					return err
+				}
+				defer m.Close()
+
+				if err := m.Force(version); err != nil {
+					return fmt.Errorf("force: %w", err)
+				}
+
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "Migrate: version forced to %d\n", version)
+
+				return err
+			},
+		},
+	)
+
+	return cmd
+}
+
+// runMigration runs a migration step with an optional step count argument and reports the resulting version.
+func runMigration(cmd *cobra.Command, cfg *config.Config, args []string, step func(m *migrate.Migrate, n int) error) error {
+	n := 0
+	if len(args) == 1 {
+		var err error
+		if n, err = strconv.Atoi(args[0]); err != nil || n <= 0 {
+			return fmt.Errorf("invalid number of migrations %q", args[0])
+		}
+	}
+
+	m, err := newMigrator(cfg.PG.URL)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	err = step(m, n)
+	if errors.Is(err, migrate.ErrNoChange) {
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), "Migrate: no change")
+
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("migrate: %w", err)
+	}
+
+	version, _, err := m.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		_, err = fmt.Fprintln(cmd.OutOrStdout(), "Migrate: all migrations rolled back")
+
+		return err
+	}
+
+	if err != nil {
+		return fmt.Errorf("read version: %w", err)
+	}
+
+	_, err = fmt.Fprintf(cmd.OutOrStdout(), "Migrate: database at version %d\n", version)
+
+	return err
+}
+
+func newSeedCommand(cfg *config.Config) *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Create teams and pull requests from a JSON snapshot, skipping existing ones",
+		Long: `Create teams and pull requests from a JSON snapshot, skipping existing ones.
+
+Without --file a small demo data set is loaded. The file has the format written by
+"app export"; pull requests get freshly assigned reviewers.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data := _demoSnapshot
+			if file != "" {
+				var err error
+				if data, err = readFile(cmd, file); err != nil {
+					return err
+				}
+			}
+
+			var snapshot entity.Snapshot
+			if err := json.Unmarshal(data, &snapshot); err != nil {
+				return fmt.Errorf("parse snapshot: %w", err)
+			}
+
+			return withMaintenance(cfg, func(uc usecase.Maintenance) error {
+				result, err := uc.Seed(cmd.Context(), snapshot)
+
+				fmt.Fprintf(cmd.OutOrStdout(), "Seed: teams created %d, skipped %d; pull requests created %d, skipped %d\n",
+					result.TeamsCreated, result.TeamsSkipped, result.PullRequestsCreated, result.PullRequestsSkipped)
+
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "snapshot JSON file, - for stdin")
+
+	return cmd
+}
+
+func newReassignUserCommand(cfg *config.Config) *cobra.Command {
+	var deactivate bool
+
+	cmd := &cobra.Command{
+		Use:   "reassign-user USER_ID",
+		Short: "Hand every open review of a user over to other members of their team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return withMaintenance(cfg, func(uc usecase.Maintenance) error {
+				results, err := uc.ReassignUser(cmd.Context(), args[0], deactivate)
+
+				w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+				fmt.Fprintln(w, "PR ID\tNEW REVIEWER\tSKIPPED")
+
+				for _, r := range results {
+					newReviewer, skipped := r.NewReviewerID, r.Skipped
+					if newReviewer == "" {
+						newReviewer = "-"
+					}
+
+					if skipped == "" {
+						skipped = "-"
+					}
+
+					fmt.Fprintf(w, "%s\t%s\t%s\n", r.PullRequestID, newReviewer, skipped)
+				}
+
+				return errors.Join(w.Flush(), err)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&deactivate, "deactivate", false, "deactivate the user first so that they get no new reviews")
+
+	return cmd
+}
+
+func newExportCommand(cfg *config.Config) *cobra.Command {
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Write all teams, users and pull requests as JSON",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return withMaintenance(cfg, func(uc usecase.Maintenance) error {
+				snapshot, err := uc.Export(cmd.Context())
+				if err != nil {
+					return err
+				}
+
+				data, err := json.MarshalIndent(snapshot, "", "  ")
+				if err != nil {
+					return fmt.Errorf("encode snapshot: %w", err)
+				}
+
+				data = append(data, '\n')
+
+				if output == "" || output == "-" {
+					_, err = cmd.OutOrStdout().Write(data)
+
+					return err
+				}
+
+				return os.WriteFile(output, data, 0o600)
+			})
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "", "file to write instead of stdout")
+
+	return cmd
+}
+
+func newAPIKeyCommand(cfg *config.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "Mint, list and revoke API keys, e.g. the first admin key",
+	}
+
+	cmd.AddCommand(
+		newAPIKeyCreateCommand(cfg),
+		&cobra.Command{
+			Use:   "list",
+			Short: "List API keys",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				return withAPIKeys(cfg, func(uc usecase.APIKey) error {
+					keys, err := uc.ListAPIKeys(cmd.Context())
+					if err != nil {
+						return err
+					}
+
+					return writeAPIKeys(cmd.OutOrStdout(), keys, false)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "revoke KEY_ID",
+			Short: "Revoke an API key",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return withAPIKeys(cfg, func(uc usecase.APIKey) error {
+					if err := uc.RevokeAPIKey(cmd.Context(), args[0]); err != nil {
+						return err
+					}
+
+					_, err := fmt.Fprintf(cmd.OutOrStdout(), "API key %s revoked\n", args[0])
+
+					return err
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func newAPIKeyCreateCommand(cfg *config.Config) *cobra.Command {
+	var (
+		name      string
+		scopes    []string
+		expiresIn time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Mint an API key; the token is shown only once",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			keyScopes := make([]entity.APIKeyScope, 0, len(scopes))
+			for _, scope := range scopes {
+				switch s := entity.APIKeyScope(scope); s {
+				case entity.APIKeyScopeRead, entity.APIKeyScopeWrite, entity.APIKeyScopeAdmin:
+					keyScopes = append(keyScopes, s)
+				default:
+					return fmt.Errorf("unknown scope %q, want read, write or admin", scope)
+				}
+			}
+
+			var expiresAt *time.Time
+			if expiresIn > 0 {
+				t := time.Now().Add(expiresIn)
+				expiresAt = &t
+			}
+
+			return withAPIKeys(cfg, func(uc usecase.APIKey) error {
+				key, err := uc.CreateAPIKey(cmd.Context(), name, keyScopes, expiresAt)
+				if err != nil {
+					return err
+				}
+
+				return writeAPIKeys(cmd.OutOrStdout(), []entity.APIKey{key}, true)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&name, "name", "", "what the key is for")
+	cmd.Flags().StringSliceVar(&scopes, "scope", []string{string(entity.APIKeyScopeAdmin)}, "read, write or admin, repeatable")
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "lifetime of the key, e.g. 720h (default no expiry)")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func writeAPIKeys(out io.Writer, keys []entity.APIKey, withToken bool) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	header := "KEY ID\tNAME\tPREFIX\tSCOPES\tEXPIRES\tREVOKED"
+	if withToken {
+		header += "\tTOKEN"
+	}
+
+	fmt.Fprintln(w, header)
+
+	for _, k := range keys {
+		scopes := make([]string, 0, len(k.Scopes))
+		for _, scope := range k.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+
+		line := fmt.Sprintf("%s\t%s\t%s\t%s\t%s\t%s", k.KeyID, k.Name, k.TokenPrefix, strings.Join(scopes, ","),
+			formatOptionalTime(k.ExpiresAt), formatOptionalTime(k.RevokedAt))
+		if withToken {
+			line += "\t" + k.Token
+		}
+
+		fmt.Fprintln(w, line)
+	}
+
+	return w.Flush()
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// withDatabase connects to the database for the duration of fn.
+func withDatabase(cfg *config.Config, fn func(pg *postgres.Postgres) error) error {
+	pg, err := postgres.New(cfg.PG.URL, postgres.MaxPoolSize(cfg.PG.PoolMax))
+	if err != nil {
+		return fmt.Errorf("postgres.New: %w", err)
+	}
+	defer pg.Close()
+
+	return fn(pg)
+}
+
+// withMaintenance connects to the database and runs fn with the maintenance use case.
+func withMaintenance(cfg *config.Config, fn func(uc usecase.Maintenance) error) error {
+	return withDatabase(cfg, func(pg *postgres.Postgres) error {
+		teamRepo := persistent.NewTeamRepo(pg)
+		userRepo := persistent.NewUserRepo(pg)
+		prRepo := persistent.NewPullRequestRepo(pg)
+
+		return fn(maintenance.New(
+			team.New(teamRepo),
+			user.New(userRepo, prRepo),
+			pullrequest.New(prRepo, userRepo, teamRepo),
+		))
+	})
+}
+
+// withAPIKeys connects to the database and runs fn with the API key use case.
+func withAPIKeys(cfg *config.Config, fn func(uc usecase.APIKey) error) error {
+	return withDatabase(cfg, func(pg *postgres.Postgres) error {
+		return fn(apikey.New(persistent.NewAPIKeyRepo(pg)))
+	})
+}
+
+// readFile reads a file, or stdin for "-".
+func readFile(cmd *cobra.Command, path string) ([]byte, error) {
+	if path != "-" {
+		return os.ReadFile(path)
+	}
+
+	var buf bytes.Buffer
+	if _, err := io.Copy(&buf, cmd.InOrStdin()); err != nil {
+		return nil, fmt.Errorf("read stdin: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -2,26 +2,64 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/finstape/pr-reviews/config"
+	"github.com/finstape/pr-reviews/internal/controller/grpc"
+	"github.com/finstape/pr-reviews/internal/controller/grpc/middleware"
 	"github.com/finstape/pr-reviews/internal/controller/http"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/repo/mailer"
 	"github.com/finstape/pr-reviews/internal/repo/persistent"
+	"github.com/finstape/pr-reviews/internal/repo/webapi"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/internal/usecase/apikey"
+	"github.com/finstape/pr-reviews/internal/usecase/audit"
+	"github.com/finstape/pr-reviews/internal/usecase/auth"
+	"github.com/finstape/pr-reviews/internal/usecase/chat"
+	"github.com/finstape/pr-reviews/internal/usecase/codehost"
+	"github.com/finstape/pr-reviews/internal/usecase/digest"
+	"github.com/finstape/pr-reviews/internal/usecase/escalation"
+	"github.com/finstape/pr-reviews/internal/usecase/integration"
+	"github.com/finstape/pr-reviews/internal/usecase/lookup"
+	"github.com/finstape/pr-reviews/internal/usecase/outbox"
 	"github.com/finstape/pr-reviews/internal/usecase/pullrequest"
+	"github.com/finstape/pr-reviews/internal/usecase/sla"
+	"github.com/finstape/pr-reviews/internal/usecase/stats"
+	"github.com/finstape/pr-reviews/internal/usecase/stream"
 	"github.com/finstape/pr-reviews/internal/usecase/team"
 	"github.com/finstape/pr-reviews/internal/usecase/user"
+	"github.com/finstape/pr-reviews/internal/usecase/webhook"
+	"github.com/finstape/pr-reviews/pkg/grpcserver"
 	"github.com/finstape/pr-reviews/pkg/httpserver"
 	"github.com/finstape/pr-reviews/pkg/logger"
 	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/finstape/pr-reviews/pkg/scheduler"
 )
 
 // Run creates objects via constructors.
 func Run(cfg *config.Config) {
 	l := logger.New(cfg.Log.Level)
 
+	// Migrations
+	if _migrateOnStart {
+		changed, err := migrateUp(cfg.PG.URL)
+		if err != nil {
+			l.Fatal(fmt.Errorf("app - Run - migrateUp: %w", err))
+		}
+
+		if changed {
+			l.Info("Migrate: up success")
+		} else {
+			l.Info("Migrate: no change")
+		}
+	}
+
 	// Repository
 	pg, err := postgres.New(cfg.PG.URL, postgres.MaxPoolSize(cfg.PG.PoolMax))
 	if err != nil {
@@ -33,18 +71,168 @@ func Run(cfg *config.Config) {
 	teamRepo := persistent.NewTeamRepo(pg)
 	userRepo := persistent.NewUserRepo(pg)
 	prRepo := persistent.NewPullRequestRepo(pg)
+	statsRepo := persistent.NewStatsRepo(pg)
+	lockRepo := persistent.NewLockRepo(pg)
+	webhookRepo := persistent.NewWebhookRepo(pg)
+	outboxRepo := persistent.NewOutboxRepo(pg)
+	identityRepo := persistent.NewIdentityRepo(pg)
+	eventBusRepo := persistent.NewEventBusRepo(pg)
+	apiKeyRepo := persistent.NewAPIKeyRepo(pg)
+	auditRepo := persistent.NewAuditRepo(pg)
+	webhookSender := webapi.NewWebhookSender(cfg.Webhook.Timeout)
+	chatSender := webapi.NewChatSender(cfg.Chat.Timeout)
+	mailSender := mailer.NewSMTPSender(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.SMTP.From, cfg.SMTP.Timeout)
+
+	// OIDC identity provider; without a key set only API keys are accepted
+	var tokenVerifier repo.TokenVerifier
+	if cfg.OIDC.JWKSURL != "" {
+		userClaim := entity.UserClaim(cfg.OIDC.UserClaim)
+		if userClaim != entity.UserClaimSubject && userClaim != entity.UserClaimEmail {
+			l.Fatal(fmt.Errorf("app - Run - OIDC_USER_CLAIM must be %q or %q, got %q", entity.UserClaimSubject, entity.UserClaimEmail, userClaim))
+		}
+
+		tokenVerifier = webapi.NewJWTVerifier(cfg.OIDC.JWKSURL, cfg.OIDC.Issuer, cfg.OIDC.Audience, cfg.OIDC.RefreshInterval, cfg.OIDC.Timeout)
+	}
+
+	// Code hosts that reviewer assignments are written back to
+	codeHostClients := map[entity.IdentityProvider]repo.CodeHostClient{}
+	if cfg.GitHub.Token != "" {
+		codeHostClients[entity.IdentityProviderGitHub] = webapi.NewGitHubClient(cfg.GitHub.APIURL, cfg.GitHub.Token, cfg.GitHub.APITimeout)
+	}
+	if cfg.GitLab.Token != "" {
+		codeHostClients[entity.IdentityProviderGitLab] = webapi.NewGitLabClient(cfg.GitLab.APIURL, cfg.GitLab.Token, cfg.GitLab.APITimeout)
+	}
 
 	// Use cases
 	teamUseCase := team.New(teamRepo)
-	userUseCase := user.New(userRepo)
+	userUseCase := user.New(userRepo, prRepo)
+	webhookUseCase := webhook.New(webhookRepo, webhookSender, webhook.RetryPolicy{
+		MaxAttempts: cfg.Webhook.MaxAttempts,
+		BaseBackoff: cfg.Webhook.BaseBackoff,
+		MaxBackoff:  cfg.Webhook.MaxBackoff,
+	}, cfg.Webhook.BatchSize)
 	pullRequestUseCase := pullrequest.New(prRepo, userRepo, teamRepo)
+	statsUseCase := stats.New(statsRepo)
+	slaUseCase := sla.New(teamRepo, prRepo, cfg.Review.DefaultSLA)
+	codeHostUseCase := codehost.New(identityRepo, codeHostClients)
+	chatUseCase := chat.New(teamRepo, userRepo, prRepo, lockRepo, chatSender, cfg.Review.DefaultSLA, cfg.Chat.BatchSize)
+	digestUseCase := digest.New(userRepo, prRepo, lockRepo, mailSender, cfg.Digest.SendHour, cfg.Digest.BatchSize)
+	streamUseCase := stream.New(userRepo, prRepo, eventBusRepo, cfg.Stream.BufferSize)
+	lookupUseCase := lookup.New(userRepo, prRepo)
+	apiKeyUseCase := apikey.New(apiKeyRepo)
+	authUseCase := auth.New(apiKeyUseCase, tokenVerifier, identityRepo, userRepo, entity.UserClaim(cfg.OIDC.UserClaim))
+	auditUseCase := audit.New(auditRepo)
+
+	// Event sinks; only webhooks hold the outbox back on failure
+	sinks := []usecase.EventPublisher{
+		webhookUseCase,
+		outbox.BestEffort(codeHostUseCase, func(event entity.Event, err error) {
+			l.Error(fmt.Errorf("app - code host write-back - %s %s: %w", event.Type, event.EventID, err))
+		}),
+		outbox.BestEffort(streamUseCase, func(event entity.Event, err error) {
+			l.Error(fmt.Errorf("app - event stream - %s %s: %w", event.Type, event.EventID, err))
+		}),
+	}
+	if cfg.Chat.Enabled {
+		sinks = append(sinks, outbox.BestEffort(chatUseCase, func(event entity.Event, err error) {
+			l.Error(fmt.Errorf("app - chat notification - %s %s: %w", event.Type, event.EventID, err))
+		}))
+	}
+
+	outboxUseCase := outbox.New(outboxRepo, lockRepo, cfg.Outbox.BatchSize, sinks...)
+	escalationUseCase := escalation.New(prRepo, lockRepo, pullRequestUseCase, cfg.Escalation.DefaultThreshold, cfg.Escalation.BatchSize)
+	integrationUseCase := integration.New(identityRepo, userRepo, pullRequestUseCase, cfg.GitHub.WebhookSecret, cfg.GitLab.WebhookToken)
 
 	// HTTP Server
-	httpServer := httpserver.New(l, httpserver.Port(cfg.HTTP.Port), httpserver.Prefork(cfg.HTTP.UsePreforkMode))
-	http.NewRouter(httpServer.App, cfg, teamUseCase, userUseCase, pullRequestUseCase, l)
+	httpServer := httpserver.New(l,
+		httpserver.Port(cfg.HTTP.Port),
+		httpserver.Prefork(cfg.HTTP.UsePreforkMode),
+		httpserver.LongLived("/events/stream", cfg.Stream.MaxDuration),
+	)
+	http.NewRouter(httpServer.App, cfg, teamUseCase, userUseCase, pullRequestUseCase, statsUseCase, slaUseCase, webhookUseCase, integrationUseCase, streamUseCase, lookupUseCase, apiKeyUseCase, authUseCase, auditUseCase, l)
+
+	// gRPC Server
+	grpcOptions := []grpcserver.Option{
+		grpcserver.Port(cfg.GRPC.Port),
+		grpcserver.UnaryInterceptors(middleware.LoggerInterceptor(l), middleware.RecoveryInterceptor(l)),
+	}
+	if cfg.Auth.Enabled {
+		// Same tokens, scopes and roles as the HTTP API
+		grpcOptions = append(grpcOptions, grpcserver.UnaryInterceptors(middleware.AuthInterceptor(authUseCase, grpc.NewAuthConfig(), l)))
+	}
+
+	grpcServer := grpcserver.New(l, grpcOptions...)
+	grpc.NewRouter(grpcServer.App, teamUseCase, userUseCase, pullRequestUseCase, l)
+
+	// Background jobs
+	escalationScheduler := scheduler.New(l, func(ctx context.Context) error {
+		result, err := escalationUseCase.EscalateStaleReviews(ctx)
+		if result.Reassigned > 0 || result.Skipped > 0 {
+			l.Info("app - escalation - stale: %d, reassigned: %d, skipped: %d", result.Stale, result.Reassigned, result.Skipped)
+		}
+
+		return err
+	}, scheduler.Name("escalation"), scheduler.Interval(cfg.Escalation.Interval))
+
+	outboxScheduler := scheduler.New(l, func(ctx context.Context) error {
+		_, err := outboxUseCase.RelayEvents(ctx)
+
+		return err
+	}, scheduler.Name("outbox"), scheduler.Interval(cfg.Outbox.RelayInterval))
+
+	webhookScheduler := scheduler.New(l, func(ctx context.Context) error {
+		result, err := webhookUseCase.DeliverPending(ctx)
+		if result.Retrying > 0 || result.Failed > 0 {
+			l.Info("app - webhooks - succeeded: %d, retrying: %d, failed: %d", result.Succeeded, result.Retrying, result.Failed)
+		}
+
+		return err
+	}, scheduler.Name("webhooks"), scheduler.Interval(cfg.Webhook.DeliveryInterval))
+
+	chatScheduler := scheduler.New(l, func(ctx context.Context) error {
+		result, err := chatUseCase.NotifyOverdueReviews(ctx)
+		if result.Notified > 0 || result.Failed > 0 {
+			l.Info("app - chat - overdue: %d, notified: %d, failed: %d", result.Overdue, result.Notified, result.Failed)
+		}
+
+		return err
+	}, scheduler.Name("chat"), scheduler.Interval(cfg.Chat.OverdueInterval))
+
+	digestScheduler := scheduler.New(l, func(ctx context.Context) error {
+		result, err := digestUseCase.SendDigests(ctx)
+		if result.Sent > 0 || result.Failed > 0 {
+			l.Info("app - digest - due: %d, sent: %d, skipped: %d, failed: %d", result.Due, result.Sent, result.Skipped, result.Failed)
+		}
+
+		return err
+	}, scheduler.Name("digest"), scheduler.Interval(cfg.Digest.CheckInterval), scheduler.Timeout(cfg.Digest.CheckInterval))
+
+	// Restarts the event stream listener after its connection fails
+	streamScheduler := scheduler.New(l, streamUseCase.Listen,
+		scheduler.Name("stream"), scheduler.Interval(cfg.Stream.ReconnectInterval), scheduler.Timeout(0))
 
 	// Start servers
 	httpServer.Start()
+	grpcServer.Start()
+
+	if cfg.Escalation.Enabled {
+		escalationScheduler.Start()
+	}
+
+	outboxScheduler.Start()
+	streamScheduler.Start()
+
+	if cfg.Webhook.Enabled {
+		webhookScheduler.Start()
+	}
+
+	if cfg.Chat.Enabled {
+		chatScheduler.Start()
+	}
+
+	if cfg.Digest.Enabled {
+		digestScheduler.Start()
+	}
 
 	// Waiting signal
 	interrupt := make(chan os.Signal, 1)
@@ -55,12 +243,37 @@ func Run(cfg *config.Config) {
 		l.Info("app - Run - signal: %s", s.String())
 	case err = <-httpServer.Notify():
 		l.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", err))
+	case err = <-grpcServer.Notify():
+		l.Error(fmt.Errorf("app - Run - grpcServer.Notify: %w", err))
 	}
 
 	// Shutdown
+	if cfg.Escalation.Enabled {
+		escalationScheduler.Shutdown()
+	}
+
+	outboxScheduler.Shutdown()
+	streamScheduler.Shutdown()
+
+	if cfg.Webhook.Enabled {
+		webhookScheduler.Shutdown()
+	}
+
+	if cfg.Chat.Enabled {
+		chatScheduler.Shutdown()
+	}
+
+	if cfg.Digest.Enabled {
+		digestScheduler.Shutdown()
+	}
+
 	err = httpServer.Shutdown()
 	if err != nil {
 		l.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
-}
 
+	err = grpcServer.Shutdown()
+	if err != nil {
+		l.Error(fmt.Errorf("app - Run - grpcServer.Shutdown: %w", err))
+	}
+}
@@ -0,0 +1,125 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+	// migrate tools
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+)
+
+const (
+	_migrationsURL    = "file://migrations"
+	_migrateAttempts  = 20
+	_migrateRetryWait = time.Second
+)
+
+// _migrateOnStart makes Run apply pending migrations before serving; it is set by the migrate build tag.
+var _migrateOnStart bool
+
+// MigrationStatus describes one migration file and whether it is applied.
+type MigrationStatus struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// newMigrator connects to the database, retrying while it starts up.
+func newMigrator(databaseURL string) (*migrate.Migrate, error) {
+	databaseURL, err := withSSLModeDefault(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var m *migrate.Migrate
+
+	for attempts := _migrateAttempts; attempts > 0; attempts-- {
+		m, err = migrate.New(_migrationsURL, databaseURL)
+		if err == nil {
+			return m, nil
+		}
+
+		log.Printf("Migrate: postgres is trying to connect, attempts left: %d", attempts)
+		time.Sleep(_migrateRetryWait)
+	}
+
+	return nil, fmt.Errorf("postgres connect error: %w", err)
+}
+
+// withSSLModeDefault disables TLS unless the URL chooses an sslmode itself.
+func withSSLModeDefault(databaseURL string) (string, error) {
+	u, err := url.Parse(databaseURL)
+	if err != nil {
+		return "", fmt.Errorf("parse database URL: %w", err)
+	}
+
+	q := u.Query()
+	if q.Get("sslmode") == "" {
+		q.Set("sslmode", "disable")
+		u.RawQuery = q.Encode()
+	}
+
+	return u.String(), nil
+}
+
+// migrateUp applies all pending migrations; it reports whether anything changed.
+func migrateUp(databaseURL string) (bool, error) {
+	m, err := newMigrator(databaseURL)
+	if err != nil {
+		return false, err
+	}
+	defer m.Close()
+
+	err = m.Up()
+	if errors.Is(err, migrate.ErrNoChange) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("up error: %w", err)
+	}
+
+	return true, nil
+}
+
+// migrationStatus lists the migration files with the applied ones marked, and the current database version.
+func migrationStatus(m *migrate.Migrate) ([]MigrationStatus, uint, bool, error) {
+	current, dirty, err := m.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, 0, false, fmt.Errorf("read version: %w", err)
+	}
+
+	src, err := source.Open(_migrationsURL)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("open migrations: %w", err)
+	}
+	defer src.Close()
+
+	var statuses []MigrationStatus
+
+	version, err := src.First()
+	for err == nil {
+		name := ""
+		if r, identifier, readErr := src.ReadUp(version); readErr == nil {
+			name = identifier
+			r.Close()
+		}
+
+		statuses = append(statuses, MigrationStatus{Version: version, Name: name, Applied: version <= current})
+
+		version, err = src.Next(version)
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, 0, false, fmt.Errorf("read migrations: %w", err)
+	}
+
+	return statuses, current, dirty, nil
+}
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+func newAPIKeyCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apikey",
+		Short: "Manage API keys (needs an admin key)",
+	}
+
+	cmd.AddCommand(
+		newAPIKeyCreateCommand(a),
+		&cobra.Command{
+			Use:   "list",
+			Short: "List API keys",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				var resp struct {
+					APIKeys []entity.APIKey `json:"api_keys"`
+				}
+
+				return a.get(cmd, "/apikeys/list", nil, &resp, func() *table {
+					return apiKeysTable(resp.APIKeys)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "revoke KEY_ID",
+			Short: "Revoke an API key",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp struct {
+					KeyID string `json:"key_id"`
+				}
+
+				return a.post(cmd, "/apikeys/revoke", request.RevokeAPIKeyRequest{KeyID: args[0]}, &resp, func() *table {
+					t := newTable("REVOKED KEY ID")
+					t.add(resp.KeyID)
+
+					return t
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func newAPIKeyCreateCommand(a *App) *cobra.Command {
+	var (
+		scopes    []string
+		expiresIn time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Mint an API key; the token is shown only once",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp struct {
+				APIKey entity.APIKey `json:"api_key"`
+			}
+
+			req := request.CreateAPIKeyRequest{Name: args[0], Scopes: scopes}
+			if expiresIn > 0 {
+				req.ExpiresAt = time.Now().Add(expiresIn).UTC().Format(time.RFC3339)
+			}
+
+			return a.post(cmd, "/apikeys/create", req, &resp, func() *table {
+				t := apiKeysTable([]entity.APIKey{resp.APIKey})
+				t.header = append(t.header, "TOKEN")
+				t.rows[0] = append(t.rows[0], resp.APIKey.Token)
+
+				return t
+			})
+		},
+	}
+
+	scopeNames := []string{string(entity.APIKeyScopeRead), string(entity.APIKeyScopeWrite), string(entity.APIKeyScopeAdmin)}
+
+	cmd.Flags().StringSliceVar(&scopes, "scope", []string{string(entity.APIKeyScopeRead)}, "read, write or admin, repeatable")
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "lifetime of the key, e.g. 720h (default no expiry)")
+	_ = cmd.RegisterFlagCompletionFunc("scope", cobra.FixedCompletions(scopeNames, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func apiKeysTable(keys []entity.APIKey) *table {
+	t := newTable("KEY ID", "NAME", "PREFIX", "SCOPES", "EXPIRES", "REVOKED", "CREATED")
+	for _, k := range keys {
+		scopes := make([]string, 0, len(k.Scopes))
+		for _, scope := range k.Scopes {
+			scopes = append(scopes, string(scope))
+		}
+
+		t.add(k.KeyID, k.Name, k.TokenPrefix, formatList(scopes), formatTime(k.ExpiresAt), formatTime(k.RevokedAt), formatTime(k.CreatedAt))
+	}
+
+	return t
+}
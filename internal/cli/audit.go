@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+func newAuditCommand(a *App) *cobra.Command {
+	var (
+		filter request.ListAuditEntriesRequest
+		page   pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Search the audit log of changes, newest first (needs an admin)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "actor", filter.Actor)
+			setQuery(q, "action", filter.Action)
+			setQuery(q, "entity_type", filter.EntityType)
+			setQuery(q, "entity_id", filter.EntityID)
+			setQuery(q, "request_id", filter.RequestID)
+			setQuery(q, "from", filter.From)
+			setQuery(q, "to", filter.To)
+			page.apply(q)
+
+			var resp entity.AuditPage
+
+			err := a.get(cmd, "/audit", q, &resp, func() *table {
+				t := newTable("ID", "TIME", "ACTION", "ENTITY", "ACTOR", "REQUEST ID")
+				for _, e := range resp.Entries {
+					t.add(strconv.FormatInt(e.AuditID, 10), formatTime(&e.CreatedAt), string(e.Action),
+						string(e.EntityType)+" "+e.EntityID, e.Actor, e.RequestID)
+				}
+
+				return t
+			})
+			if err == nil {
+				a.printNextCursor(resp.NextCursor)
+			}
+
+			return err
+		},
+	}
+
+	actions := make([]string, 0, len(entity.AuditActions()))
+	for _, action := range entity.AuditActions() {
+		actions = append(actions, string(action))
+	}
+
+	entityTypes := []string{
+		string(entity.AuditEntityTeam), string(entity.AuditEntityUser), string(entity.AuditEntityPullRequest),
+		string(entity.AuditEntityAPIKey), string(entity.AuditEntityIdentity), string(entity.AuditEntityWebhook),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&filter.Actor, "actor", "", "user ID, or apikey:KEY_ID for changes made with an API key")
+	flags.StringVar(&filter.Action, "action", "", "kind of change, e.g. pull_request.merged")
+	flags.StringVar(&filter.EntityType, "entity-type", "", "team, user, pull_request, api_key, identity or webhook")
+	flags.StringVar(&filter.EntityID, "entity", "", "team name, user ID, pull request ID, API key ID, provider:login or webhook ID")
+	flags.StringVar(&filter.RequestID, "request-id", "", "X-Request-ID of the request that made the change")
+	flags.StringVar(&filter.From, "from", "", "made at or after, RFC 3339")
+	flags.StringVar(&filter.To, "to", "", "made before, RFC 3339")
+	page.register(cmd, false)
+
+	_ = cmd.RegisterFlagCompletionFunc("action", cobra.FixedCompletions(actions, cobra.ShellCompDirectiveNoFileComp))
+	_ = cmd.RegisterFlagCompletionFunc("entity-type", cobra.FixedCompletions(entityTypes, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
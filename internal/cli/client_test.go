@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SendsTokenAndBody(t *testing.T) {
+	var (
+		gotAuth, gotContentType, gotBody string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		gotContentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL+"/", "secret", time.Second)
+
+	body, err := client.Post(context.Background(), "/pullRequest/merge", map[string]string{"pull_request_id": "pr-1"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ok":true}`, string(body))
+	assert.Equal(t, "Bearer secret", gotAuth)
+	assert.Equal(t, "application/json", gotContentType)
+	assert.JSONEq(t, `{"pull_request_id":"pr-1"}`, gotBody)
+}
+
+func TestClient_NoTokenNoHeader(t *testing.T) {
+	var hasAuth bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, hasAuth = r.Header["Authorization"]
+		assert.Equal(t, "u1", r.URL.Query().Get("user_id"))
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "", time.Second).Get(context.Background(), "/users/getReview", url.Values{"user_id": {"u1"}})
+	require.NoError(t, err)
+	assert.False(t, hasAuth)
+}
+
+func TestClient_APIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"error":{"code":"PR_MERGED","message":"cannot reassign on merged PR"}}`))
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "", time.Second).Post(context.Background(), "/pullRequest/reassign", struct{}{})
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	assert.Equal(t, "PR_MERGED", apiErr.Code)
+	assert.Equal(t, "PR_MERGED: cannot reassign on merged PR (HTTP 409)", err.Error())
+}
+
+func TestClient_NonJSONError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	_, err := NewClient(server.URL, "", time.Second).Get(context.Background(), "/team/list", nil)
+	assert.EqualError(t, err, "HTTP 502: Bad Gateway")
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables overriding the config file
+const (
+	_envConfig  = "PRREVIEWS_CONFIG"
+	_envURL     = "PRREVIEWS_URL"
+	_envToken   = "PRREVIEWS_TOKEN"
+	_envOutput  = "PRREVIEWS_OUTPUT"
+	_envTimeout = "PRREVIEWS_TIMEOUT"
+)
+
+// Output formats
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+)
+
+const (
+	_defaultBaseURL = "http://localhost:8080"
+	_defaultTimeout = 10 * time.Second
+)
+
+var (
+	errUnknownConfigKey = errors.New("unknown config key")
+	errUnknownOutput    = errors.New("unknown output format")
+)
+
+// Config is the CLI configuration stored in the config file.
+type Config struct {
+	BaseURL string `yaml:"base_url,omitempty"`
+	// Token is sent as a bearer token with every request
+	Token   string `yaml:"token,omitempty"`
+	Output  string `yaml:"output,omitempty"`
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// DefaultConfigPath returns the config file location: $PRREVIEWS_CONFIG or prreviews/config.yaml in the user config directory.
+func DefaultConfigPath() string {
+	if path := os.Getenv(_envConfig); path != "" {
+		return path
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "prreviews", "config.yaml")
+}
+
+// LoadConfig reads the config file; a missing file yields an empty config unless it was requested explicitly.
+func LoadConfig(path string, explicit bool) (Config, error) {
+	var cfg Config
+	if path == "" {
+		return cfg, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && !explicit {
+			return cfg, nil
+		}
+
+		return cfg, fmt.Errorf("read config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse config %s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+// SaveConfig writes the config file, readable only by its owner as it may hold credentials.
+func SaveConfig(path string, cfg Config) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("encode config: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("create config directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf("write config: %w", err)
+	}
+
+	return nil
+}
+
+// Set updates a config value by its file key.
+func (c *Config) Set(key, value string) error {
+	switch key {
+	case "base_url":
+		c.BaseURL = value
+	case "token":
+		c.Token = value
+	case "output":
+		if err := validateOutput(value); err != nil {
+			return err
+		}
+
+		c.Output = value
+	case "timeout":
+		if _, err := time.ParseDuration(value); err != nil {
+			return fmt.Errorf("invalid timeout %q: %w", value, err)
+		}
+
+		c.Timeout = value
+	default:
+		return fmt.Errorf("%w %q, expected one of: base_url, token, output, timeout", errUnknownConfigKey, key)
+	}
+
+	return nil
+}
+
+// settings are the effective connection and output settings after merging defaults, the config file, environment and flags.
+type settings struct {
+	baseURL string
+	token   string
+	output  string
+	timeout time.Duration
+}
+
+// resolveSettings applies, in increasing priority, defaults, the config file, environment variables and flags set on the command line.
+func resolveSettings(cfg Config, flags settings) (settings, error) {
+	s := settings{
+		baseURL: _defaultBaseURL,
+		output:  OutputTable,
+		timeout: _defaultTimeout,
+	}
+
+	timeout := cfg.Timeout
+	pick(&s.baseURL, cfg.BaseURL, os.Getenv(_envURL), flags.baseURL)
+	pick(&s.token, cfg.Token, os.Getenv(_envToken), flags.token)
+	pick(&s.output, cfg.Output, os.Getenv(_envOutput), flags.output)
+	pick(&timeout, os.Getenv(_envTimeout))
+
+	if timeout != "" {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			return s, fmt.Errorf("invalid timeout %q: %w", timeout, err)
+		}
+
+		s.timeout = d
+	}
+
+	if flags.timeout > 0 {
+		s.timeout = flags.timeout
+	}
+
+	if err := validateOutput(s.output); err != nil {
+		return s, err
+	}
+
+	return s, nil
+}
+
+// pick assigns the last non-empty value to dst.
+func pick(dst *string, values ...string) {
+	for _, v := range values {
+		if v != "" {
+			*dst = v
+		}
+	}
+}
+
+func validateOutput(output string) error {
+	if output != OutputTable && output != OutputJSON {
+		return fmt.Errorf("%w %q, expected %s or %s", errUnknownOutput, output, OutputTable, OutputJSON)
+	}
+
+	return nil
+}
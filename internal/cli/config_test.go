@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func clearEnv(t *testing.T) {
+	t.Helper()
+
+	for _, key := range []string{_envURL, _envToken, _envOutput, _envTimeout} {
+		t.Setenv(key, "")
+	}
+}
+
+func TestResolveSettings_Defaults(t *testing.T) {
+	clearEnv(t)
+
+	s, err := resolveSettings(Config{}, settings{})
+	require.NoError(t, err)
+	assert.Equal(t, settings{baseURL: _defaultBaseURL, output: OutputTable, timeout: _defaultTimeout}, s)
+}
+
+func TestResolveSettings_Precedence(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(_envToken, "env-token")
+	t.Setenv(_envTimeout, "30s")
+
+	cfg := Config{BaseURL: "http://file", Token: "file-token", Output: OutputJSON, Timeout: "5s"}
+
+	s, err := resolveSettings(cfg, settings{baseURL: "http://flag"})
+	require.NoError(t, err)
+	assert.Equal(t, "http://flag", s.baseURL)
+	assert.Equal(t, "env-token", s.token)
+	assert.Equal(t, OutputJSON, s.output)
+	assert.Equal(t, 30*time.Second, s.timeout)
+
+	s, err = resolveSettings(cfg, settings{timeout: time.Minute})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, s.timeout)
+}
+
+func TestResolveSettings_InvalidOutput(t *testing.T) {
+	clearEnv(t)
+
+	_, err := resolveSettings(Config{}, settings{output: "yaml"})
+	assert.ErrorIs(t, err, errUnknownOutput)
+}
+
+func TestLoadConfig_Missing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+
+	cfg, err := LoadConfig(path, false)
+	require.NoError(t, err)
+	assert.Equal(t, Config{}, cfg)
+
+	_, err = LoadConfig(path, true)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestSaveConfig_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prreviews", "config.yaml")
+
+	var cfg Config
+	require.NoError(t, cfg.Set("base_url", "https://pr-reviews.example.com"))
+	require.NoError(t, cfg.Set("token", "secret"))
+	require.NoError(t, cfg.Set("timeout", "15s"))
+	require.NoError(t, SaveConfig(path, cfg))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	loaded, err := LoadConfig(path, true)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, loaded)
+}
+
+func TestConfigSet_Invalid(t *testing.T) {
+	var cfg Config
+
+	assert.ErrorIs(t, cfg.Set("password", "x"), errUnknownConfigKey)
+	assert.ErrorIs(t, cfg.Set("output", "xml"), errUnknownOutput)
+	assert.Error(t, cfg.Set("timeout", "soon"))
+}
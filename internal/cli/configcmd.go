@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+func newConfigCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Show or change the config file",
+		Long: `Show or change the config file.
+
+The file is YAML with the keys base_url, token, output and timeout:
+
+  base_url: https://pr-reviews.example.com
+  token: <api token>
+  output: table
+  timeout: 10s`,
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "view",
+			Short: "Show the config file path and the effective settings",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				if _, err := a.connect(); err != nil {
+					return err
+				}
+
+				token := "-"
+				if a.settings.token != "" {
+					token = "<set>"
+				}
+
+				t := newTable("KEY", "VALUE")
+				t.add("config", a.path())
+				t.add("base_url", a.settings.baseURL)
+				t.add("token", token)
+				t.add("output", a.settings.output)
+				t.add("timeout", a.settings.timeout.String())
+
+				return t.write(a.out)
+			},
+		},
+		&cobra.Command{
+			Use:       "set KEY VALUE",
+			Short:     "Set a value in the config file",
+			Example:   "  prreviews config set base_url https://pr-reviews.example.com",
+			Args:      cobra.ExactArgs(2),
+			ValidArgs: []string{"base_url", "token", "output", "timeout"},
+			RunE: func(_ *cobra.Command, args []string) error {
+				path := a.path()
+				if path == "" {
+					return errors.New("no config file location, pass --config")
+				}
+
+				cfg, err := LoadConfig(path, false)
+				if err != nil {
+					return err
+				}
+
+				if err := cfg.Set(args[0], args[1]); err != nil {
+					return err
+				}
+
+				if err := SaveConfig(path, cfg); err != nil {
+					return err
+				}
+
+				_, err = fmt.Fprintf(a.out, "Set %s in %s\n", args[0], path)
+
+				return err
+			},
+		},
+	)
+
+	return cmd
+}
+
+// path returns the config file in use.
+func (a *App) path() string {
+	if a.configPath != "" {
+		return a.configPath
+	}
+
+	return DefaultConfigPath()
+}
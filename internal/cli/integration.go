@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"net/url"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+var providerCompletions = cobra.FixedCompletions(
+	[]string{string(entity.IdentityProviderGitHub), string(entity.IdentityProviderGitLab), string(entity.IdentityProviderOIDC)},
+	cobra.ShellCompDirectiveNoFileComp,
+)
+
+func newIdentityCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "identity",
+		Short: "Map code host and OIDC logins to users",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "set github|gitlab|oidc LOGIN USER_ID",
+			Short: "Map a code host or OIDC login to a user",
+			Args:  cobra.ExactArgs(3),
+			ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				if len(args) == 0 {
+					return providerCompletions(cmd, args, toComplete)
+				}
+
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp struct {
+					Identity entity.UserIdentity `json:"identity"`
+				}
+
+				req := request.SetIdentityRequest{Provider: args[0], Login: args[1], UserID: args[2]}
+
+				return a.post(cmd, "/integrations/identities/set", req, &resp, func() *table {
+					return identitiesTable([]entity.UserIdentity{resp.Identity})
+				})
+			},
+		},
+		newIdentityListCommand(a),
+		&cobra.Command{
+			Use:   "delete github|gitlab|oidc LOGIN",
+			Short: "Remove a login mapping",
+			Args:  cobra.ExactArgs(2),
+			ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				if len(args) == 0 {
+					return providerCompletions(cmd, args, toComplete)
+				}
+
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp struct {
+					Provider string `json:"provider"`
+					Login    string `json:"login"`
+				}
+
+				req := request.DeleteIdentityRequest{Provider: args[0], Login: args[1]}
+
+				return a.post(cmd, "/integrations/identities/delete", req, &resp, func() *table {
+					t := newTable("DELETED PROVIDER", "LOGIN")
+					t.add(resp.Provider, resp.Login)
+
+					return t
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func newIdentityListCommand(a *App) *cobra.Command {
+	var provider string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List login mappings",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "provider", provider)
+
+			var resp struct {
+				Identities []entity.UserIdentity `json:"identities"`
+			}
+
+			return a.get(cmd, "/integrations/identities/list", q, &resp, func() *table {
+				return identitiesTable(resp.Identities)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&provider, "provider", "", "github, gitlab or oidc")
+	_ = cmd.RegisterFlagCompletionFunc("provider", providerCompletions)
+
+	return cmd
+}
+
+func identitiesTable(identities []entity.UserIdentity) *table {
+	t := newTable("PROVIDER", "LOGIN", "USER ID", "CREATED")
+	for _, i := range identities {
+		t.add(string(i.Provider), i.Login, i.UserID, formatTime(i.CreatedAt))
+	}
+
+	return t
+}
@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// table collects rows rendered as aligned columns.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func newTable(header ...string) *table {
+	return &table{header: header}
+}
+
+func (t *table) add(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+func (t *table) write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// render writes an API response: as indented JSON in json mode, otherwise decoded into v and printed by the table function.
+func (a *App) render(body []byte, v any, tableOf func() *table) error {
+	if a.settings.output == OutputJSON {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, body, "", "  "); err != nil {
+			return fmt.Errorf("decode response: %w", err)
+		}
+
+		buf.WriteByte('\n')
+
+		_, err := buf.WriteTo(a.out)
+
+		return err
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return tableOf().write(a.out)
+}
+
+// printNextCursor tells how to fetch the next page; it goes to stderr so piped tables stay clean.
+func (a *App) printNextCursor(cursor string) {
+	if cursor != "" && a.settings.output == OutputTable {
+		fmt.Fprintf(a.errOut, "More results: repeat with --cursor %s\n", cursor)
+	}
+}
+
+func formatBool(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+
+	return t.Local().Format(time.DateTime)
+}
+
+func formatSeconds(seconds int64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func formatOptionalSeconds(seconds *int) string {
+	if seconds == nil {
+		return "default"
+	}
+
+	return formatSeconds(int64(*seconds))
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+
+	return strings.Join(items, ", ")
+}
+
+func formatInt(n int) string {
+	return strconv.Itoa(n)
+}
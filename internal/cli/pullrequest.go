@@ -0,0 +1,325 @@
+package cli
+
+import (
+	"net/url"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+type pullRequestResponse struct {
+	PR entity.PullRequest `json:"pr"`
+}
+
+func newPullRequestCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pr",
+		Aliases: []string{"pull-request"},
+		Short:   "Manage pull requests and their reviewers",
+	}
+
+	cmd.AddCommand(
+		newPRCreateCommand(a),
+		newPRGetCommand(a),
+		newPRHistoryCommand(a),
+		newPRListCommand(a),
+		newPROverdueCommand(a),
+		&cobra.Command{
+			Use:   "merge PR_ID",
+			Short: "Mark a pull request as merged",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp pullRequestResponse
+
+				return a.post(cmd, "/pullRequest/merge", request.MergePRRequest{PullRequestID: args[0]}, &resp, func() *table {
+					return pullRequestsTable([]entity.PullRequest{resp.PR})
+				})
+			},
+		},
+		newPRReassignCommand(a),
+		newPRReviewCommand(a),
+	)
+
+	return cmd
+}
+
+func newPRCreateCommand(a *App) *cobra.Command {
+	var req request.CreatePRRequest
+
+	cmd := &cobra.Command{
+		Use:   "create PR_ID --name NAME [--author USER_ID]",
+		Short: "Create a pull request and assign reviewers from the author's team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.PullRequestID = args[0]
+
+			var resp pullRequestResponse
+
+			return a.post(cmd, "/pullRequest/create", req, &resp, func() *table {
+				return pullRequestsTable([]entity.PullRequest{resp.PR})
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&req.PullRequestName, "name", "", "pull request title")
+	cmd.Flags().StringVar(&req.AuthorID, "author", "", "author user ID; defaults to the signed-in user")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+func newPRGetCommand(a *App) *cobra.Command {
+	var expand string
+
+	cmd := &cobra.Command{
+		Use:   "get PR_ID",
+		Short: "Show a pull request with its reviewers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := url.Values{"pull_request_id": {args[0]}}
+			setQuery(q, "expand", expand)
+
+			var resp struct {
+				PR entity.PullRequestDetail `json:"pr"`
+			}
+
+			return a.get(cmd, "/pullRequest/get", q, &resp, func() *table {
+				t := pullRequestsTable([]entity.PullRequest{resp.PR.PullRequest})
+				if u := resp.PR.Author; u != nil {
+					t.rows[0][2] = formatUser(*u)
+				}
+
+				if len(resp.PR.Reviewers) > 0 {
+					reviewers := make([]string, 0, len(resp.PR.Reviewers))
+					for _, u := range resp.PR.Reviewers {
+						reviewers = append(reviewers, formatUser(u))
+					}
+
+					t.rows[0][4] = formatList(reviewers)
+				}
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&expand, "expand", "", "embed related users: author, reviewers (comma-separated)")
+	_ = cmd.RegisterFlagCompletionFunc("expand", cobra.FixedCompletions([]string{"author", "reviewers", "author,reviewers"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newPRHistoryCommand(a *App) *cobra.Command {
+	return &cobra.Command{
+		Use:   "history PR_ID",
+		Short: "Show the timeline of a pull request: reviewers assigned and replaced, reviews, merge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp entity.PullRequestHistory
+
+			return a.get(cmd, "/pullRequest/history", url.Values{"pull_request_id": {args[0]}}, &resp, func() *table {
+				t := newTable("TIME", "EVENT", "USER", "DETAILS")
+				for _, e := range resp.Events {
+					user := e.UserID
+					if user == "" {
+						user = "-"
+					}
+
+					t.add(formatTime(&e.OccurredAt), string(e.Type), user, formatHistoryDetails(e))
+				}
+
+				return t
+			})
+		},
+	}
+}
+
+func newPRListCommand(a *App) *cobra.Command {
+	var (
+		filter request.ListPRsRequest
+		page   pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Search pull requests",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "status", filter.Status)
+			setQuery(q, "author_id", filter.AuthorID)
+			setQuery(q, "reviewer_id", filter.ReviewerID)
+			setQuery(q, "team_name", filter.TeamName)
+			setQuery(q, "name", filter.Name)
+			setQuery(q, "created_from", filter.CreatedFrom)
+			setQuery(q, "created_to", filter.CreatedTo)
+			setQuery(q, "merged_from", filter.MergedFrom)
+			setQuery(q, "merged_to", filter.MergedTo)
+			page.apply(q)
+
+			var resp entity.PullRequestPage
+
+			err := a.get(cmd, "/pullRequest/list", q, &resp, func() *table {
+				return pullRequestsTable(resp.PullRequests)
+			})
+			if err == nil {
+				a.printNextCursor(resp.NextCursor)
+			}
+
+			return err
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&filter.Status, "status", "", "OPEN, MERGED or CLOSED")
+	flags.StringVar(&filter.AuthorID, "author", "", "author user ID")
+	flags.StringVar(&filter.ReviewerID, "reviewer", "", "assigned reviewer user ID")
+	flags.StringVar(&filter.TeamName, "team", "", "author's team")
+	flags.StringVar(&filter.Name, "name", "", "substring of the pull request name")
+	flags.StringVar(&filter.CreatedFrom, "created-from", "", "created at or after, RFC 3339")
+	flags.StringVar(&filter.CreatedTo, "created-to", "", "created before, RFC 3339")
+	flags.StringVar(&filter.MergedFrom, "merged-from", "", "merged at or after, RFC 3339")
+	flags.StringVar(&filter.MergedTo, "merged-to", "", "merged before, RFC 3339")
+	page.register(cmd, true)
+
+	_ = cmd.RegisterFlagCompletionFunc("status", statusCompletions(false))
+	_ = cmd.RegisterFlagCompletionFunc("team", a.completeTeamFlag)
+
+	return cmd
+}
+
+func newPROverdueCommand(a *App) *cobra.Command {
+	var team string
+
+	cmd := &cobra.Command{
+		Use:   "overdue",
+		Short: "List review assignments past their team's SLA",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "team_name", team)
+
+			var resp struct {
+				Overdue []entity.OverdueReview `json:"overdue"`
+			}
+
+			return a.get(cmd, "/pullRequest/overdue", q, &resp, func() *table {
+				t := newTable("PR ID", "NAME", "REVIEWER", "TEAM", "ASSIGNED", "SLA", "OVERDUE BY")
+				for _, r := range resp.Overdue {
+					t.add(r.PullRequestID, r.PullRequestName, r.ReviewerID, r.TeamName,
+						formatTime(&r.AssignedAt), formatSeconds(r.SLASeconds), formatSeconds(r.OverdueSeconds))
+				}
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&team, "team", "", "only reviews of the team")
+	_ = cmd.RegisterFlagCompletionFunc("team", a.completeTeamFlag)
+
+	return cmd
+}
+
+func newPRReassignCommand(a *App) *cobra.Command {
+	var oldUserID string
+
+	cmd := &cobra.Command{
+		Use:   "reassign PR_ID [--reviewer USER_ID]",
+		Short: "Replace a reviewer with another active member of their team",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp struct {
+				PR         entity.PullRequest `json:"pr"`
+				ReplacedBy string             `json:"replaced_by"`
+			}
+
+			req := request.ReassignReviewerRequest{PullRequestID: args[0], OldUserID: oldUserID}
+
+			return a.post(cmd, "/pullRequest/reassign", req, &resp, func() *table {
+				t := newTable("PR ID", "OLD REVIEWER", "NEW REVIEWER", "REVIEWERS")
+				oldReviewer := oldUserID
+				if oldReviewer == "" {
+					oldReviewer = "(you)"
+				}
+
+				t.add(resp.PR.PullRequestID, oldReviewer, resp.ReplacedBy, formatList(resp.PR.AssignedReviewers))
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&oldUserID, "reviewer", "", "user ID of the reviewer to replace; defaults to the signed-in user")
+
+	return cmd
+}
+
+func newPRReviewCommand(a *App) *cobra.Command {
+	var req request.RecordReviewRequest
+
+	cmd := &cobra.Command{
+		Use:   "review PR_ID --outcome APPROVED|CHANGES_REQUESTED|COMMENTED [--reviewer USER_ID]",
+		Short: "Record the outcome of a review",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req.PullRequestID = args[0]
+
+			var resp struct {
+				PullRequestID string               `json:"pull_request_id"`
+				ReviewerID    string               `json:"reviewer_id"`
+				ReviewOutcome entity.ReviewOutcome `json:"review_outcome"`
+			}
+
+			return a.post(cmd, "/pullRequest/review", req, &resp, func() *table {
+				t := newTable("PR ID", "REVIEWER", "OUTCOME")
+				t.add(resp.PullRequestID, resp.ReviewerID, string(resp.ReviewOutcome))
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&req.Outcome, "outcome", "", "APPROVED, CHANGES_REQUESTED or COMMENTED")
+	cmd.Flags().StringVar(&req.ReviewerID, "reviewer", "", "reviewer user ID; defaults to the signed-in user")
+	_ = cmd.MarkFlagRequired("outcome")
+	_ = cmd.RegisterFlagCompletionFunc("outcome", cobra.FixedCompletions(
+		[]string{string(entity.ReviewOutcomeApproved), string(entity.ReviewOutcomeChangesRequested), string(entity.ReviewOutcomeCommented)},
+		cobra.ShellCompDirectiveNoFileComp,
+	))
+
+	return cmd
+}
+
+func pullRequestsTable(prs []entity.PullRequest) *table {
+	t := newTable("PR ID", "NAME", "AUTHOR", "STATUS", "REVIEWERS", "CREATED", "MERGED")
+	for _, pr := range prs {
+		t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, string(pr.Status),
+			formatList(pr.AssignedReviewers), formatTime(pr.CreatedAt), formatTime(pr.MergedAt))
+	}
+
+	return t
+}
+
+// formatHistoryDetails describes the review outcome or the replacement of a timeline event.
+func formatHistoryDetails(e entity.HistoryEvent) string {
+	switch {
+	case e.ReviewOutcome != nil:
+		return string(*e.ReviewOutcome)
+	case e.ReplacedBy != "":
+		return "replaced by " + e.ReplacedBy + " (" + string(e.Reason) + ")"
+	default:
+		return "-"
+	}
+}
+
+// formatUser shows an embedded user as "ID (username)", marking inactive users.
+func formatUser(u entity.User) string {
+	s := u.UserID + " (" + u.Username + ")"
+	if !u.IsActive {
+		s += " inactive"
+	}
+
+	return s
+}
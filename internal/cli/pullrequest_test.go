@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRCreate(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusCreated,
+		`{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u2","u3"]}}`)
+
+	out, _, err := runCLI(t, server, "pr", "create", "pr-1", "--name", "Add search", "--author", "u1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/pullRequest/create", rec.path)
+	assert.JSONEq(t, `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1"}`, rec.body)
+	assert.Equal(t, ""+
+		"PR ID  NAME        AUTHOR  STATUS  REVIEWERS  CREATED  MERGED\n"+
+		"pr-1   Add search  u1      OPEN    u2, u3     -        -\n", out)
+}
+
+func TestPRCreate_AuthorDefaultsToSignedInUser(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusCreated,
+		`{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u2"]}}`)
+
+	_, _, err := runCLI(t, server, "pr", "create", "pr-1", "--name", "Add search")
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":""}`, rec.body)
+}
+
+func TestPRReassign(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK,
+		`{"pr":{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u4","u3"]},"replaced_by":"u4"}`)
+
+	out, _, err := runCLI(t, server, "pr", "reassign", "pr-1", "--reviewer", "u2")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/pullRequest/reassign", rec.path)
+	assert.JSONEq(t, `{"pull_request_id":"pr-1","old_user_id":"u2"}`, rec.body)
+	assert.Equal(t, ""+
+		"PR ID  OLD REVIEWER  NEW REVIEWER  REVIEWERS\n"+
+		"pr-1   u2            u4            u4, u3\n", out)
+}
+
+func TestPRReview(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"pull_request_id":"pr-1","reviewer_id":"u2","review_outcome":"APPROVED"}`)
+
+	out, _, err := runCLI(t, server, "pr", "review", "pr-1", "--outcome", "APPROVED")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/pullRequest/review", rec.path)
+	assert.JSONEq(t, `{"pull_request_id":"pr-1","outcome":"APPROVED","reviewer_id":""}`, rec.body)
+	assert.Equal(t, ""+
+		"PR ID  REVIEWER  OUTCOME\n"+
+		"pr-1   u2        APPROVED\n", out)
+}
+
+func TestPRMerge_Conflict(t *testing.T) {
+	server, _ := newStandIn(t, http.StatusConflict, `{"error":{"code":"PR_CLOSED","message":"PR is closed"}}`)
+
+	_, _, err := runCLI(t, server, "pr", "merge", "pr-1")
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, "PR_CLOSED", apiErr.Code)
+}
+
+func TestPRGet_ExpandedUsers(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"pr":{
+		"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u2"],
+		"author":{"user_id":"u1","username":"Alice","team_name":"backend","is_active":true},
+		"reviewers":[{"user_id":"u2","username":"Bob","team_name":"backend","is_active":false}]
+	}}`)
+
+	out, _, err := runCLI(t, server, "pr", "get", "pr-1", "--expand", "author,reviewers")
+	require.NoError(t, err)
+
+	assert.Equal(t, "expand=author%2Creviewers&pull_request_id=pr-1", rec.query)
+	assert.Contains(t, out, "u1 (Alice)")
+	assert.Contains(t, out, "u2 (Bob) inactive")
+}
+
+func TestPRHistory(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"pull_request_id":"pr-1","status":"OPEN","events":[
+		{"type":"created","occurred_at":"2026-10-18T10:00:00Z","user_id":"u1"},
+		{"type":"reviewer_unassigned","occurred_at":"2026-10-18T11:00:00Z","user_id":"u2","replaced_by":"u3","reason":"AUTOMATIC"}
+	]}`)
+
+	out, _, err := runCLI(t, server, "pr", "history", "pr-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/pullRequest/history", rec.path)
+	assert.Equal(t, "pull_request_id=pr-1", rec.query)
+	assert.Contains(t, out, "reviewer_unassigned")
+	assert.Contains(t, out, "replaced by u3 (AUTOMATIC)")
+}
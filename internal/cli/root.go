@@ -0,0 +1,196 @@
+// Package cli implements the prreviews command-line client of the HTTP API.
+package cli
+
+import (
+	"encoding/json"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// App holds the state shared by CLI commands; the config is resolved lazily so that commands
+// and shell completions only read it once flags are parsed.
+type App struct {
+	out    io.Writer
+	errOut io.Writer
+
+	configPath string
+	flags      settings
+
+	settings settings
+	client   *Client
+}
+
+// NewRootCommand builds the prreviews command tree.
+func NewRootCommand() *cobra.Command {
+	a := &App{}
+
+	root := &cobra.Command{
+		Use:   "prreviews",
+		Short: "Command-line client for the PR review service",
+		Long: `Command-line client for the PR review service.
+
+Connection settings are read from the config file (see "prreviews config"),
+overridden by PRREVIEWS_URL, PRREVIEWS_TOKEN, PRREVIEWS_OUTPUT and PRREVIEWS_TIMEOUT,
+which are in turn overridden by flags.`,
+		SilenceUsage: true,
+		PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+			a.out = cmd.OutOrStdout()
+			a.errOut = cmd.ErrOrStderr()
+		},
+	}
+
+	flags := root.PersistentFlags()
+	flags.StringVar(&a.configPath, "config", "", "config file (default "+DefaultConfigPath()+")")
+	flags.StringVar(&a.flags.baseURL, "url", "", "service base URL (default "+_defaultBaseURL+")")
+	flags.StringVar(&a.flags.token, "token", "", "bearer token sent with every request")
+	flags.StringVarP(&a.flags.output, "output", "o", "", "output format: table or json (default table)")
+	flags.DurationVar(&a.flags.timeout, "timeout", 0, "request timeout (default 10s)")
+
+	_ = root.RegisterFlagCompletionFunc("output", cobra.FixedCompletions([]string{OutputTable, OutputJSON}, cobra.ShellCompDirectiveNoFileComp))
+
+	root.AddCommand(
+		newTeamCommand(a),
+		newUserCommand(a),
+		newPullRequestCommand(a),
+		newStatsCommand(a),
+		newWebhookCommand(a),
+		newAPIKeyCommand(a),
+		newAuditCommand(a),
+		newIdentityCommand(a),
+		newEventsCommand(a),
+		newConfigCommand(a),
+	)
+
+	return root
+}
+
+// connect resolves settings and returns the API client.
+func (a *App) connect() (*Client, error) {
+	if a.client != nil {
+		return a.client, nil
+	}
+
+	cfg, err := LoadConfig(a.path(), a.configPath != "")
+	if err != nil {
+		return nil, err
+	}
+
+	a.settings, err = resolveSettings(cfg, a.flags)
+	if err != nil {
+		return nil, err
+	}
+
+	a.client = NewClient(a.settings.baseURL, a.settings.token, a.settings.timeout)
+
+	return a.client, nil
+}
+
+// get calls a GET endpoint and renders the response.
+func (a *App) get(cmd *cobra.Command, path string, query url.Values, v any, tableOf func() *table) error {
+	client, err := a.connect()
+	if err != nil {
+		return err
+	}
+
+	body, err := client.Get(cmd.Context(), path, query)
+	if err != nil {
+		return err
+	}
+
+	return a.render(body, v, tableOf)
+}
+
+// post calls a POST endpoint and renders the response.
+func (a *App) post(cmd *cobra.Command, path string, payload any, v any, tableOf func() *table) error {
+	client, err := a.connect()
+	if err != nil {
+		return err
+	}
+
+	body, err := client.Post(cmd.Context(), path, payload)
+	if err != nil {
+		return err
+	}
+
+	return a.render(body, v, tableOf)
+}
+
+// completeTeams completes the first argument with team names known to the service.
+func (a *App) completeTeams(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return a.completeTeamFlag(cmd, args, toComplete)
+}
+
+// completeTeamFlag completes a --team flag with team names known to the service.
+func (a *App) completeTeamFlag(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var resp teamListResponse
+
+	client, err := a.connect()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	body, err := client.Get(cmd.Context(), "/team/list", nil)
+	if err != nil || json.Unmarshal(body, &resp) != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(resp.Teams))
+	for _, team := range resp.Teams {
+		if strings.HasPrefix(team.TeamName, toComplete) {
+			names = append(names, team.TeamName)
+		}
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// pageFlags are the pagination flags shared by listing commands.
+type pageFlags struct {
+	limit  int
+	cursor string
+	order  string
+}
+
+func (p *pageFlags) register(cmd *cobra.Command, withOrder bool) {
+	cmd.Flags().IntVar(&p.limit, "limit", 0, "page size, 1-100 (default 50)")
+	cmd.Flags().StringVar(&p.cursor, "cursor", "", "cursor returned with the previous page")
+
+	if withOrder {
+		cmd.Flags().StringVar(&p.order, "order", "", "sort order by creation time: asc or desc (default desc)")
+		_ = cmd.RegisterFlagCompletionFunc("order", cobra.FixedCompletions([]string{"asc", "desc"}, cobra.ShellCompDirectiveNoFileComp))
+	}
+}
+
+func (p *pageFlags) apply(q url.Values) {
+	if p.limit > 0 {
+		q.Set("limit", strconv.Itoa(p.limit))
+	}
+
+	setQuery(q, "cursor", p.cursor)
+	setQuery(q, "order", p.order)
+}
+
+// setQuery adds a query parameter unless the value is empty.
+func setQuery(q url.Values, key, value string) {
+	if value != "" {
+		q.Set(key, value)
+	}
+}
+
+// statusCompletions are the PR status values accepted by status filters.
+func statusCompletions(withAll bool) cobra.CompletionFunc {
+	statuses := []string{"OPEN", "MERGED", "CLOSED"}
+	if withAll {
+		statuses = append(statuses, "ALL")
+	}
+
+	return cobra.FixedCompletions(statuses, cobra.ShellCompDirectiveNoFileComp)
+}
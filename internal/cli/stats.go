@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+func newStatsCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show review statistics over a time window",
+	}
+
+	cmd.AddCommand(newStatsReviewersCommand(a), newStatsTeamsCommand(a))
+
+	return cmd
+}
+
+func newStatsReviewersCommand(a *App) *cobra.Command {
+	var from, to, team string
+
+	cmd := &cobra.Command{
+		Use:   "reviewers",
+		Short: "Show per-reviewer assignment and merge-time statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "from", from)
+			setQuery(q, "to", to)
+			setQuery(q, "team_name", team)
+
+			var report entity.ReviewerStatsReport
+
+			return a.get(cmd, "/stats/reviewers", q, &report, func() *table {
+				t := newTable("USER ID", "USERNAME", "TEAM", "ASSIGNMENTS", "OPEN", "REASSIGNED AWAY", "MEDIAN TO MERGE")
+				for _, r := range report.Reviewers {
+					t.add(append([]string{r.UserID, r.Username, r.TeamName}, statsCells(r.ReviewStats)...)...)
+				}
+
+				return t
+			})
+		},
+	}
+
+	registerWindowFlags(cmd, &from, &to)
+	cmd.Flags().StringVar(&team, "team", "", "only reviewers of the team")
+	_ = cmd.RegisterFlagCompletionFunc("team", a.completeTeamFlag)
+
+	return cmd
+}
+
+func newStatsTeamsCommand(a *App) *cobra.Command {
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "teams",
+		Short: "Show per-team assignment and merge-time statistics",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "from", from)
+			setQuery(q, "to", to)
+
+			var report entity.TeamStatsReport
+
+			return a.get(cmd, "/stats/teams", q, &report, func() *table {
+				t := newTable("TEAM", "ASSIGNMENTS", "OPEN", "REASSIGNED AWAY", "MEDIAN TO MERGE")
+				for _, s := range report.Teams {
+					t.add(append([]string{s.TeamName}, statsCells(s.ReviewStats)...)...)
+				}
+
+				return t
+			})
+		},
+	}
+
+	registerWindowFlags(cmd, &from, &to)
+
+	return cmd
+}
+
+func registerWindowFlags(cmd *cobra.Command, from, to *string) {
+	cmd.Flags().StringVar(from, "from", "", "window start, RFC 3339 (default 30 days before --to)")
+	cmd.Flags().StringVar(to, "to", "", "window end, RFC 3339 (default now)")
+}
+
+func statsCells(s entity.ReviewStats) []string {
+	median := "-"
+	if s.MedianTimeToMergeSeconds != nil {
+		median = time.Duration(*s.MedianTimeToMergeSeconds * float64(time.Second)).Round(time.Second).String()
+	}
+
+	return []string{
+		strconv.Itoa(s.Assignments),
+		strconv.Itoa(s.OpenReviews),
+		strconv.Itoa(s.ReassignmentsAway),
+		median,
+	}
+}
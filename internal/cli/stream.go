@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+const _eventRowFormat = "%-19s  %-21s  %-24s  %s\n"
+
+func newEventsCommand(a *App) *cobra.Command {
+	var team, user string
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Follow review activity live until interrupted",
+		Long: `Follow review activity live until interrupted.
+
+In json output every event is printed on its own line.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if _, err := a.connect(); err != nil {
+				return err
+			}
+
+			q := url.Values{}
+			setQuery(q, "team_name", team)
+			setQuery(q, "user_id", user)
+
+			// The stream outlives any request timeout
+			client := NewClient(a.settings.baseURL, a.settings.token, 0)
+
+			body, err := client.Stream(cmd.Context(), "/events/stream", q)
+			if err != nil {
+				return err
+			}
+			defer body.Close()
+
+			if a.settings.output == OutputTable {
+				fmt.Fprintf(a.out, _eventRowFormat, "TIME", "TYPE", "PR ID", "USERS")
+			}
+
+			scanner := bufio.NewScanner(body)
+			scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+			for scanner.Scan() {
+				data, ok := strings.CutPrefix(scanner.Text(), "data: ")
+				if !ok {
+					continue
+				}
+
+				if err := a.printEvent(data); err != nil {
+					return err
+				}
+			}
+
+			if err := scanner.Err(); err != nil && !errors.Is(cmd.Context().Err(), context.Canceled) {
+				return fmt.Errorf("read event stream: %w", err)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&team, "team", "", "only events concerning the team")
+	cmd.Flags().StringVar(&user, "user", "", "only events concerning the user")
+	_ = cmd.RegisterFlagCompletionFunc("team", a.completeTeamFlag)
+
+	return cmd
+}
+
+// printEvent prints one SSE data payload, which is a JSON-encoded entity.StreamEvent.
+func (a *App) printEvent(data string) error {
+	if a.settings.output == OutputJSON {
+		_, err := fmt.Fprintln(a.out, data)
+
+		return err
+	}
+
+	var event struct {
+		entity.StreamEvent
+		Data struct {
+			PullRequestID string             `json:"pull_request_id"`
+			PullRequest   entity.PullRequest `json:"pull_request"`
+		} `json:"data"`
+	}
+
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return fmt.Errorf("decode event: %w", err)
+	}
+
+	prID := event.Data.PullRequestID
+	if prID == "" {
+		prID = event.Data.PullRequest.PullRequestID
+	}
+
+	_, err := fmt.Fprintf(a.out, _eventRowFormat,
+		event.OccurredAt.Local().Format(time.DateTime), event.Type, prID, formatList(event.UserIDs))
+
+	return err
+}
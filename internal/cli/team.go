@@ -0,0 +1,240 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+var errInvalidMember = errors.New("invalid member")
+
+type teamResponse struct {
+	Team entity.Team `json:"team"`
+}
+
+type teamListResponse struct {
+	Teams []entity.TeamSummary `json:"teams"`
+}
+
+func newTeamCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "team",
+		Short: "Manage teams",
+	}
+
+	cmd.AddCommand(
+		newTeamAddCommand(a),
+		&cobra.Command{
+			Use:               "get TEAM",
+			Short:             "Show a team with its members",
+			Args:              cobra.ExactArgs(1),
+			ValidArgsFunction: a.completeTeams,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var team entity.Team
+
+				return a.get(cmd, "/team/get", url.Values{"team_name": {args[0]}}, &team, func() *table {
+					return membersTable(team)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "list",
+			Short: "List teams with member counts and settings",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				var resp teamListResponse
+
+				return a.get(cmd, "/team/list", nil, &resp, func() *table {
+					t := newTable("TEAM", "MEMBERS", "ACTIVE", "REVIEW SLA", "ESCALATION", "CHAT")
+					for _, team := range resp.Teams {
+						t.add(team.TeamName, formatInt(team.MemberCount), formatInt(team.ActiveCount),
+							formatOptionalSeconds(team.ReviewSLASeconds), formatOptionalSeconds(team.EscalationSeconds),
+							formatBool(team.ChatWebhookConfigured))
+					}
+
+					return t
+				})
+			},
+		},
+		newTeamDurationCommand(a, "set-sla", "Set the review SLA of a team; 0 restores the service default",
+			"/team/setReviewSLA", func(team string, seconds int) any {
+				return request.SetTeamReviewSLARequest{TeamName: team, ReviewSLASeconds: &seconds}
+			}),
+		newTeamDurationCommand(a, "set-escalation", "Set the age after which stale reviews are reassigned; 0 restores the service default",
+			"/team/setEscalationThreshold", func(team string, seconds int) any {
+				return request.SetTeamEscalationRequest{TeamName: team, EscalationSeconds: &seconds}
+			}),
+		&cobra.Command{
+			Use:               "set-chat-webhook TEAM [URL]",
+			Short:             "Set the incoming-webhook URL of a team's chat channel; omit the URL to disable notifications",
+			Args:              cobra.RangeArgs(1, 2),
+			ValidArgsFunction: a.completeTeams,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				req := request.SetTeamChatWebhookRequest{TeamName: args[0]}
+				if len(args) == 2 {
+					req.URL = args[1]
+				}
+
+				var resp struct {
+					TeamName              string `json:"team_name"`
+					ChatWebhookConfigured bool   `json:"chat_webhook_configured"`
+				}
+
+				return a.post(cmd, "/team/setChatWebhook", req, &resp, func() *table {
+					t := newTable("TEAM", "CHAT")
+					t.add(resp.TeamName, formatBool(resp.ChatWebhookConfigured))
+
+					return t
+				})
+			},
+		},
+	)
+
+	return cmd
+}
+
+func newTeamAddCommand(a *App) *cobra.Command {
+	var (
+		members []string
+		file    string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "add TEAM --member USER_ID:USERNAME[:inactive]...",
+		Short: "Create a team with its members",
+		Long: `Create a team with its members.
+
+Members are given as USER_ID:USERNAME, with an optional ":inactive" suffix,
+or read with the whole request body from a JSON file ("-" for stdin):
+
+  prreviews team add backend --member u1:Alice --member u2:Bob:inactive
+  prreviews team add --file team.json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var req request.CreateTeamRequest
+
+			if file != "" {
+				if err := readJSONFile(cmd, file, &req); err != nil {
+					return err
+				}
+			}
+
+			if len(args) == 1 {
+				req.TeamName = args[0]
+			}
+
+			for _, spec := range members {
+				member, err := parseMember(spec)
+				if err != nil {
+					return err
+				}
+
+				req.Members = append(req.Members, member)
+			}
+
+			if req.TeamName == "" {
+				return errors.New("team name is required")
+			}
+
+			var resp teamResponse
+
+			return a.post(cmd, "/team/add", req, &resp, func() *table {
+				return membersTable(resp.Team)
+			})
+		},
+	}
+
+	cmd.Flags().StringArrayVarP(&members, "member", "m", nil, "team member as USER_ID:USERNAME[:inactive], repeatable")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "JSON file with the request body, - for stdin")
+
+	return cmd
+}
+
+// newTeamDurationCommand builds a command setting a per-team duration that the API takes in seconds.
+func newTeamDurationCommand(a *App, use, short, path string, newRequest func(team string, seconds int) any) *cobra.Command {
+	return &cobra.Command{
+		Use:               use + " TEAM DURATION",
+		Short:             short,
+		Example:           fmt.Sprintf("  prreviews team %s backend 24h", use),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: a.completeTeams,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			d, err := time.ParseDuration(args[1])
+			if err != nil || d < 0 {
+				return fmt.Errorf("invalid duration %q, expected e.g. 4h or 30m", args[1])
+			}
+
+			var resp map[string]any
+
+			return a.post(cmd, path, newRequest(args[0], int(d.Seconds())), &resp, func() *table {
+				t := newTable("TEAM", "DURATION")
+				value := "default"
+				if d > 0 {
+					value = d.String()
+				}
+
+				t.add(args[0], value)
+
+				return t
+			})
+		},
+	}
+}
+
+// parseMember parses USER_ID:USERNAME[:inactive].
+func parseMember(spec string) (request.CreateTeamMemberRequest, error) {
+	parts := strings.Split(spec, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return request.CreateTeamMemberRequest{}, fmt.Errorf("%w %q, expected USER_ID:USERNAME[:inactive]", errInvalidMember, spec)
+	}
+
+	member := request.CreateTeamMemberRequest{UserID: parts[0], Username: parts[1], IsActive: true}
+	if len(parts) == 3 {
+		if parts[2] != "inactive" {
+			return request.CreateTeamMemberRequest{}, fmt.Errorf("%w %q, expected USER_ID:USERNAME[:inactive]", errInvalidMember, spec)
+		}
+
+		member.IsActive = false
+	}
+
+	return member, nil
+}
+
+func membersTable(team entity.Team) *table {
+	t := newTable("TEAM", "USER ID", "USERNAME", "ACTIVE")
+	for _, m := range team.Members {
+		t.add(team.TeamName, m.UserID, m.Username, formatBool(m.IsActive))
+	}
+
+	return t
+}
+
+// readJSONFile decodes a JSON file, or stdin for "-".
+func readJSONFile(cmd *cobra.Command, path string, v any) error {
+	var r io.Reader = cmd.InOrStdin()
+
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordedRequest is what the stand-in server received.
+type recordedRequest struct {
+	method string
+	path   string
+	query  string
+	body   string
+}
+
+// newStandIn serves a canned response and records the last request.
+func newStandIn(t *testing.T, status int, response string) (*httptest.Server, *recordedRequest) {
+	t.Helper()
+
+	rec := &recordedRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		*rec = recordedRequest{method: r.Method, path: r.URL.Path, query: r.URL.RawQuery, body: string(body)}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(response))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, rec
+}
+
+// runCLI runs the CLI against the server with an empty config and returns stdout and stderr.
+func runCLI(t *testing.T, server *httptest.Server, args ...string) (string, string, error) {
+	t.Helper()
+
+	clearEnv(t)
+	t.Setenv(_envConfig, filepath.Join(t.TempDir(), "config.yaml"))
+
+	var out, errOut bytes.Buffer
+
+	cmd := NewRootCommand()
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+	cmd.SetIn(strings.NewReader(""))
+	cmd.SetArgs(append([]string{"--url", server.URL}, args...))
+
+	err := cmd.Execute()
+
+	return out.String(), errOut.String(), err
+}
+
+func TestTeamAdd_Members(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusCreated,
+		`{"team":{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true},{"user_id":"u2","username":"Bob","is_active":false}]}}`)
+
+	out, _, err := runCLI(t, server, "team", "add", "backend", "--member", "u1:Alice", "-m", "u2:Bob:inactive")
+	require.NoError(t, err)
+
+	assert.Equal(t, http.MethodPost, rec.method)
+	assert.Equal(t, "/team/add", rec.path)
+	assert.JSONEq(t, `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true},{"user_id":"u2","username":"Bob","is_active":false}]}`, rec.body)
+	assert.Equal(t, ""+
+		"TEAM     USER ID  USERNAME  ACTIVE\n"+
+		"backend  u1       Alice     yes\n"+
+		"backend  u2       Bob       no\n", out)
+}
+
+func TestTeamAdd_InvalidMember(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusCreated, `{}`)
+
+	_, _, err := runCLI(t, server, "team", "add", "backend", "--member", "u1")
+	assert.ErrorIs(t, err, errInvalidMember)
+	assert.Empty(t, rec.path)
+}
+
+func TestTeamGet_JSON(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"team_name":"backend","members":[]}`)
+
+	out, _, err := runCLI(t, server, "team", "get", "backend", "-o", "json")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/team/get", rec.path)
+	assert.Equal(t, "team_name=backend", rec.query)
+	assert.Equal(t, "{\n  \"team_name\": \"backend\",\n  \"members\": []\n}\n", out)
+}
+
+func TestTeamSetSLA_Duration(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"team_name":"backend","review_sla_seconds":14400}`)
+
+	out, _, err := runCLI(t, server, "team", "set-sla", "backend", "4h")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/team/setReviewSLA", rec.path)
+	assert.JSONEq(t, `{"team_name":"backend","review_sla_seconds":14400}`, rec.body)
+	assert.Contains(t, out, "4h0m0s")
+}
+
+func TestTeamGet_NotFound(t *testing.T) {
+	server, _ := newStandIn(t, http.StatusNotFound, `{"error":{"code":"NOT_FOUND","message":"resource not found"}}`)
+
+	_, errOut, err := runCLI(t, server, "team", "get", "missing")
+	assert.EqualError(t, err, "NOT_FOUND: resource not found (HTTP 404)")
+	assert.Contains(t, errOut, "Error: NOT_FOUND: resource not found")
+}
+
+func TestTeamGet_CompletesTeamNames(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"teams":[{"team_name":"backend"},{"team_name":"billing"},{"team_name":"frontend"}]}`)
+
+	out, _, err := runCLI(t, server, "__complete", "team", "get", "b")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/team/list", rec.path)
+	assert.Equal(t, "backend\nbilling\n:4\n", firstLines(out, 3))
+}
+
+// firstLines returns the first n lines of s, each terminated by a newline.
+func firstLines(s string, n int) string {
+	lines := strings.SplitAfter(s, "\n")
+	if len(lines) > n {
+		lines = lines[:n]
+	}
+
+	return strings.Join(lines, "")
+}
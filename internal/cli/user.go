@@ -0,0 +1,264 @@
+package cli
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+func newUserCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "user",
+		Short: "Manage users and list their pull requests",
+	}
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:       "set-active USER_ID true|false",
+			Short:     "Activate or deactivate a user for review assignment",
+			Args:      cobra.ExactArgs(2),
+			ValidArgs: []string{"true", "false"},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				isActive, err := strconv.ParseBool(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid activity %q, expected true or false", args[1])
+				}
+
+				var resp struct {
+					User entity.User `json:"user"`
+				}
+
+				return a.post(cmd, "/users/setIsActive", request.SetIsActiveRequest{UserID: args[0], IsActive: &isActive}, &resp, func() *table {
+					return usersTable([]entity.User{resp.User})
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "set-role USER_ID admin|team_lead|member|bot",
+			Short: "Change what a user may change",
+			Args:  cobra.ExactArgs(2),
+			ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				if len(args) == 1 {
+					return []string{
+						string(entity.RoleAdmin), string(entity.RoleTeamLead), string(entity.RoleMember), string(entity.RoleBot),
+					}, cobra.ShellCompDirectiveNoFileComp
+				}
+
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			},
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp struct {
+					UserID string      `json:"user_id"`
+					Role   entity.Role `json:"role"`
+				}
+
+				return a.post(cmd, "/users/setRole", request.SetRoleRequest{UserID: args[0], Role: args[1]}, &resp, func() *table {
+					t := newTable("USER ID", "ROLE")
+					t.add(resp.UserID, string(resp.Role))
+
+					return t
+				})
+			},
+		},
+		newUserSetDigestCommand(a),
+		newUserListCommand(a),
+		newUserReviewsCommand(a),
+		newUserAuthoredCommand(a),
+	)
+
+	return cmd
+}
+
+func newUserSetDigestCommand(a *App) *cobra.Command {
+	var (
+		email   string
+		enabled bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "set-digest USER_ID --enabled=true|false [--email ADDRESS]",
+		Short: "Configure the daily email digest of a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp struct {
+				DigestSettings entity.DigestSettings `json:"digest_settings"`
+			}
+
+			req := request.SetDigestSettingsRequest{UserID: args[0], Email: email, DigestEnabled: &enabled}
+
+			return a.post(cmd, "/users/setDigestSettings", req, &resp, func() *table {
+				t := newTable("USER ID", "EMAIL", "DIGEST")
+				email := resp.DigestSettings.Email
+				if email == "" {
+					email = "-"
+				}
+
+				t.add(resp.DigestSettings.UserID, email, formatBool(resp.DigestSettings.DigestEnabled))
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "digest recipient address")
+	cmd.Flags().BoolVar(&enabled, "enabled", false, "whether the digest is sent")
+	_ = cmd.MarkFlagRequired("enabled")
+
+	return cmd
+}
+
+func newUserListCommand(a *App) *cobra.Command {
+	var (
+		team   string
+		active string
+		page   pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users, optionally by team and activity",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			q := url.Values{}
+			setQuery(q, "team_name", team)
+			setQuery(q, "is_active", active)
+			page.apply(q)
+
+			var resp entity.UserPage
+
+			err := a.get(cmd, "/users/list", q, &resp, func() *table {
+				return usersTable(resp.Users)
+			})
+			if err == nil {
+				a.printNextCursor(resp.NextCursor)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&team, "team", "", "only members of the team")
+	cmd.Flags().StringVar(&active, "active", "", "only active (true) or inactive (false) users")
+	page.register(cmd, false)
+
+	_ = cmd.RegisterFlagCompletionFunc("team", a.completeTeamFlag)
+	_ = cmd.RegisterFlagCompletionFunc("active", cobra.FixedCompletions([]string{"true", "false"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newUserReviewsCommand(a *App) *cobra.Command {
+	var (
+		status  string
+		include string
+		page    pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "reviews USER_ID",
+		Short: "List pull requests a user is assigned to review",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := url.Values{"user_id": {args[0]}}
+			setQuery(q, "status", status)
+			setQuery(q, "include", include)
+			page.apply(q)
+
+			var resp entity.ReviewQueuePage
+
+			err := a.get(cmd, "/users/getReview", q, &resp, func() *table {
+				t := newTable("PR ID", "NAME", "AUTHOR", "STATUS", "CREATED", "REVIEWERS", "AGE")
+				for _, pr := range resp.PullRequests {
+					age := "-"
+					if pr.AgeSeconds != nil {
+						age = formatSeconds(*pr.AgeSeconds)
+					}
+
+					t.add(pr.PullRequestID, pr.PullRequestName, pr.AuthorID, string(pr.Status),
+						formatTime(pr.CreatedAt), formatList(pr.AssignedReviewers), age)
+				}
+
+				return t
+			})
+			if err == nil {
+				a.printNextCursor(resp.NextCursor)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "OPEN, MERGED, CLOSED or ALL (default OPEN)")
+	cmd.Flags().StringVar(&include, "include", "", "extra fields: reviewers, age (comma-separated)")
+	page.register(cmd, true)
+
+	_ = cmd.RegisterFlagCompletionFunc("status", statusCompletions(true))
+	_ = cmd.RegisterFlagCompletionFunc("include", cobra.FixedCompletions([]string{"reviewers", "age", "reviewers,age"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newUserAuthoredCommand(a *App) *cobra.Command {
+	var (
+		status string
+		page   pageFlags
+	)
+
+	cmd := &cobra.Command{
+		Use:   "authored USER_ID",
+		Short: "List pull requests a user authored with their review progress",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := url.Values{"user_id": {args[0]}}
+			setQuery(q, "status", status)
+			page.apply(q)
+
+			var resp entity.AuthoredPage
+
+			err := a.get(cmd, "/users/getAuthored", q, &resp, func() *table {
+				t := newTable("PR ID", "NAME", "STATUS", "REVIEW STATUS", "CREATED", "REVIEWERS")
+				for _, pr := range resp.PullRequests {
+					reviewers := make([]string, 0, len(pr.Reviewers))
+					for _, r := range pr.Reviewers {
+						reviewer := r.UserID
+						if r.ReviewOutcome != nil {
+							reviewer += "(" + string(*r.ReviewOutcome) + ")"
+						}
+
+						reviewers = append(reviewers, reviewer)
+					}
+
+					t.add(pr.PullRequestID, pr.PullRequestName, string(pr.Status), string(pr.ReviewStatus),
+						formatTime(pr.CreatedAt), formatList(reviewers))
+				}
+
+				return t
+			})
+			if err == nil {
+				a.printNextCursor(resp.NextCursor)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "OPEN, MERGED, CLOSED or ALL (default ALL)")
+	page.register(cmd, true)
+
+	_ = cmd.RegisterFlagCompletionFunc("status", statusCompletions(true))
+
+	return cmd
+}
+
+func usersTable(users []entity.User) *table {
+	t := newTable("USER ID", "USERNAME", "TEAM", "ACTIVE")
+	for _, u := range users {
+		t.add(u.UserID, u.Username, u.TeamName, formatBool(u.IsActive))
+	}
+
+	return t
+}
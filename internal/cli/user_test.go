@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSetActive_Deactivate(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"user":{"user_id":"u2","username":"Bob","team_name":"backend","is_active":false}}`)
+
+	out, _, err := runCLI(t, server, "user", "set-active", "u2", "false")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/users/setIsActive", rec.path)
+	assert.JSONEq(t, `{"user_id":"u2","is_active":false}`, rec.body)
+	assert.Equal(t, ""+
+		"USER ID  USERNAME  TEAM     ACTIVE\n"+
+		"u2       Bob       backend  no\n", out)
+}
+
+func TestUserSetActive_InvalidValue(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{}`)
+
+	_, _, err := runCLI(t, server, "user", "set-active", "u2", "maybe")
+	assert.Error(t, err)
+	assert.Empty(t, rec.path)
+}
+
+func TestUserSetRole(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{"user_id":"u1","role":"team_lead"}`)
+
+	out, _, err := runCLI(t, server, "user", "set-role", "u1", "team_lead")
+	require.NoError(t, err)
+
+	assert.Equal(t, "/users/setRole", rec.path)
+	assert.JSONEq(t, `{"user_id":"u1","role":"team_lead"}`, rec.body)
+	assert.Equal(t, ""+
+		"USER ID  ROLE\n"+
+		"u1       team_lead\n", out)
+}
+
+func TestUserReviews_QueryAndNextCursor(t *testing.T) {
+	server, rec := newStandIn(t, http.StatusOK, `{
+		"user_id": "u2",
+		"pull_requests": [
+			{"pull_request_id":"pr-1","pull_request_name":"Add search","author_id":"u1","status":"OPEN","assigned_reviewers":["u2","u3"],"age_seconds":7200}
+		],
+		"next_cursor": "abc"
+	}`)
+
+	out, errOut, err := runCLI(t, server, "user", "reviews", "u2", "--status", "ALL", "--include", "reviewers,age", "--limit", "1")
+	require.NoError(t, err)
+
+	query, err := url.ParseQuery(rec.query)
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{"user_id": {"u2"}, "status": {"ALL"}, "include": {"reviewers,age"}, "limit": {"1"}}, query)
+
+	assert.Contains(t, out, "pr-1")
+	assert.Contains(t, out, "u2, u3")
+	assert.Contains(t, out, "2h0m0s")
+	assert.Equal(t, "More results: repeat with --cursor abc\n", errOut)
+}
+
+func TestUserList_JSONHasNoCursorHint(t *testing.T) {
+	server, _ := newStandIn(t, http.StatusOK, `{"users":[],"next_cursor":"abc"}`)
+
+	out, errOut, err := runCLI(t, server, "user", "list", "--team", "backend", "-o", "json")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, `"next_cursor": "abc"`)
+	assert.Empty(t, errOut)
+}
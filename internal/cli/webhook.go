@@ -0,0 +1,157 @@
+package cli
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/spf13/cobra"
+)
+
+func newWebhookCommand(a *App) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Manage outbound webhook subscriptions",
+	}
+
+	cmd.AddCommand(
+		newWebhookCreateCommand(a),
+		&cobra.Command{
+			Use:   "list",
+			Short: "List webhook subscriptions",
+			Args:  cobra.NoArgs,
+			RunE: func(cmd *cobra.Command, _ []string) error {
+				var resp struct {
+					Webhooks []entity.Webhook `json:"webhooks"`
+				}
+
+				return a.get(cmd, "/webhooks/list", nil, &resp, func() *table {
+					return webhooksTable(resp.Webhooks)
+				})
+			},
+		},
+		&cobra.Command{
+			Use:   "delete WEBHOOK_ID",
+			Short: "Delete a webhook subscription",
+			Args:  cobra.ExactArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				var resp struct {
+					WebhookID string `json:"webhook_id"`
+				}
+
+				return a.post(cmd, "/webhooks/delete", request.DeleteWebhookRequest{WebhookID: args[0]}, &resp, func() *table {
+					t := newTable("DELETED WEBHOOK ID")
+					t.add(resp.WebhookID)
+
+					return t
+				})
+			},
+		},
+		newWebhookDeliveriesCommand(a),
+	)
+
+	return cmd
+}
+
+func newWebhookCreateCommand(a *App) *cobra.Command {
+	var events []string
+
+	cmd := &cobra.Command{
+		Use:   "create URL",
+		Short: "Subscribe a URL to events; the signing secret is shown only once",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var resp struct {
+				Webhook entity.Webhook `json:"webhook"`
+			}
+
+			req := request.CreateWebhookRequest{URL: args[0], EventTypes: events}
+
+			return a.post(cmd, "/webhooks/create", req, &resp, func() *table {
+				t := webhooksTable([]entity.Webhook{resp.Webhook})
+				t.header = append(t.header, "SECRET")
+				t.rows[0] = append(t.rows[0], resp.Webhook.Secret)
+
+				return t
+			})
+		},
+	}
+
+	eventTypes := make([]string, 0, len(entity.EventTypes()))
+	for _, eventType := range entity.EventTypes() {
+		eventTypes = append(eventTypes, string(eventType))
+	}
+
+	cmd.Flags().StringSliceVar(&events, "event", nil, "event type to deliver, repeatable (default all)")
+	_ = cmd.RegisterFlagCompletionFunc("event", cobra.FixedCompletions(eventTypes, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func newWebhookDeliveriesCommand(a *App) *cobra.Command {
+	var (
+		status string
+		limit  int
+	)
+
+	cmd := &cobra.Command{
+		Use:   "deliveries WEBHOOK_ID",
+		Short: "List recent deliveries of a webhook",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			q := url.Values{"webhook_id": {args[0]}}
+			setQuery(q, "status", status)
+			if limit > 0 {
+				q.Set("limit", strconv.Itoa(limit))
+			}
+
+			var resp struct {
+				Deliveries []entity.WebhookDelivery `json:"deliveries"`
+			}
+
+			return a.get(cmd, "/webhooks/deliveries", q, &resp, func() *table {
+				t := newTable("DELIVERY ID", "EVENT", "STATUS", "ATTEMPTS", "LAST STATUS", "NEXT ATTEMPT", "LAST ERROR")
+				for _, d := range resp.Deliveries {
+					lastStatus, lastError := "-", "-"
+					if d.LastStatusCode != nil {
+						lastStatus = strconv.Itoa(*d.LastStatusCode)
+					}
+
+					if d.LastError != nil {
+						lastError = *d.LastError
+					}
+
+					t.add(strconv.FormatInt(d.DeliveryID, 10), string(d.EventType), string(d.Status), strconv.Itoa(d.Attempts),
+						lastStatus, formatTime(d.NextAttemptAt), lastError)
+				}
+
+				return t
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "PENDING, SUCCEEDED or FAILED")
+	cmd.Flags().IntVar(&limit, "limit", 0, "number of deliveries, 1-100 (default 50)")
+	_ = cmd.RegisterFlagCompletionFunc("status", cobra.FixedCompletions([]string{"PENDING", "SUCCEEDED", "FAILED"}, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
+
+func webhooksTable(webhooks []entity.Webhook) *table {
+	t := newTable("WEBHOOK ID", "URL", "EVENTS", "ACTIVE", "CREATED")
+	for _, w := range webhooks {
+		events := make([]string, 0, len(w.EventTypes))
+		for _, eventType := range w.EventTypes {
+			events = append(events, string(eventType))
+		}
+
+		if len(events) == 0 {
+			events = []string{"all"}
+		}
+
+		t.add(w.WebhookID, w.URL, formatList(events), formatBool(w.IsActive), formatTime(w.CreatedAt))
+	}
+
+	return t
+}
@@ -0,0 +1,93 @@
+// Package graphql implements the GraphQL endpoint.
+package graphql
+
+import (
+	_ "embed"
+	"errors"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Handler serves GraphQL queries over HTTP.
+type Handler struct {
+	schema        *gql.Schema
+	lookupUseCase usecase.Lookup
+}
+
+// request is a GraphQL over HTTP request body.
+type request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+// New creates a new Handler; maxDepth limits the nesting of accepted queries.
+func New(teamUseCase usecase.Team, pullRequestUseCase usecase.PullRequest, lookupUseCase usecase.Lookup, l logger.Interface, maxDepth int) *Handler {
+	root := &queryResolver{
+		teamUseCase:        teamUseCase,
+		pullRequestUseCase: pullRequestUseCase,
+		l:                  l,
+	}
+
+	return &Handler{
+		schema:        gql.MustParseSchema(schemaSDL, root, gql.MaxDepth(maxDepth)),
+		lookupUseCase: lookupUseCase,
+	}
+}
+
+// NewRouter -.
+func NewRouter(app fiber.Router, teamUseCase usecase.Team, pullRequestUseCase usecase.PullRequest, lookupUseCase usecase.Lookup, l logger.Interface, maxDepth int) {
+	h := New(teamUseCase, pullRequestUseCase, lookupUseCase, l, maxDepth)
+
+	app.Post("/graphql", h.serve)
+}
+
+// serve - POST /graphql
+func (h *Handler) serve(c *fiber.Ctx) error {
+	var req request
+	if err := c.BodyParser(&req); err != nil || req.Query == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "request body must be JSON with a query",
+			},
+		})
+	}
+
+	ctx := withLoaders(c.Context(), h.lookupUseCase)
+
+	return c.JSON(h.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+// queryError is a resolver error carrying the domain error code in its extensions.
+type queryError struct {
+	code    entity.ErrorCode
+	message string
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+// Extensions -.
+func (e *queryError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"code": e.code}
+}
+
+// resolverError converts a domain error into a GraphQL error without exposing internal details
+func resolverError(l logger.Interface, err error) error {
+	if errors.Is(err, entity.ErrNotFound) {
+		return &queryError{code: entity.ErrorCodeNotFound, message: entity.ErrNotFound.Error()}
+	}
+
+	l.Error(err, "internal error")
+
+	return &queryError{code: entity.ErrorCodeInternal, message: "internal server error"}
+}
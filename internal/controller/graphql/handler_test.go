@@ -0,0 +1,346 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockTeamUseCase struct {
+	mock.Mock
+}
+
+func (m *mockTeamUseCase) CreateTeam(ctx context.Context, team entity.Team) error {
+	args := m.Called(ctx, team)
+	return args.Error(0)
+}
+
+func (m *mockTeamUseCase) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return entity.Team{}, args.Error(1)
+	}
+	return args.Get(0).(entity.Team), args.Error(1)
+}
+
+func (m *mockTeamUseCase) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamUseCase) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+var _ usecase.Team = (*mockTeamUseCase)(nil)
+
+type mockPullRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID, prName, authorID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+var _ usecase.PullRequest = (*mockPullRequestUseCase)(nil)
+
+type mockLookupUseCase struct {
+	mock.Mock
+}
+
+func (m *mockLookupUseCase) GetUsers(ctx context.Context, userIDs []string) (map[string]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]entity.User), args.Error(1)
+}
+
+func (m *mockLookupUseCase) GetTeamMembers(ctx context.Context, teamNames []string) (map[string][]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.User), args.Error(1)
+}
+
+func (m *mockLookupUseCase) GetAssignedPRs(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+var _ usecase.Lookup = (*mockLookupUseCase)(nil)
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execQuery(t *testing.T, teamUC usecase.Team, prUC usecase.PullRequest, lookupUC usecase.Lookup, query string) graphQLResponse {
+	t.Helper()
+
+	app := fiber.New()
+	NewRouter(app, teamUC, prUC, lookupUC, logger.New("error"), 8)
+
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var result graphQLResponse
+	require.NoError(t, json.Unmarshal(raw, &result))
+
+	return result
+}
+
+func TestGraphQL_TeamsBatchesEachLevel(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	lookupUC := new(mockLookupUseCase)
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	teamUC.On("ListTeams", mock.Anything).Return([]entity.TeamSummary{
+		{TeamName: "backend"},
+		{TeamName: "frontend"},
+	}, nil)
+	lookupUC.On("GetTeamMembers", mock.Anything, []string{"backend", "frontend"}).Return(map[string][]entity.User{
+		"backend": {
+			{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+			{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		},
+		"frontend": {
+			{UserID: "u3", Username: "Carol", TeamName: "frontend", IsActive: false},
+		},
+	}, nil).Once()
+	lookupUC.On("GetAssignedPRs", mock.Anything, []string{"u1", "u2", "u3"}, entity.PullRequestStatusOpen).Return(map[string][]entity.PullRequest{
+		"u2": {
+			{PullRequestID: "pr-1", PullRequestName: "Add feature", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u2", "u3"}, CreatedAt: &createdAt},
+		},
+		"u3": {
+			{PullRequestID: "pr-1", PullRequestName: "Add feature", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u2", "u3"}, CreatedAt: &createdAt},
+		},
+	}, nil).Once()
+
+	result := execQuery(t, teamUC, prUC, lookupUC, `{
+		teams {
+			name
+			members {
+				id
+				assignedPullRequests {
+					id
+					status
+					createdAt
+					author { username }
+					reviewers { username }
+				}
+			}
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"teams": [
+		{"name": "backend", "members": [
+			{"id": "u1", "assignedPullRequests": []},
+			{"id": "u2", "assignedPullRequests": [{"id": "pr-1", "status": "OPEN", "createdAt": "2025-01-02T03:04:05Z", "author": {"username": "Alice"}, "reviewers": [{"username": "Bob"}, {"username": "Carol"}]}]}
+		]},
+		{"name": "frontend", "members": [
+			{"id": "u3", "assignedPullRequests": [{"id": "pr-1", "status": "OPEN", "createdAt": "2025-01-02T03:04:05Z", "author": {"username": "Alice"}, "reviewers": [{"username": "Bob"}, {"username": "Carol"}]}]}
+		]}
+	]}`, string(result.Data))
+	lookupUC.AssertExpectations(t)
+	// Authors and reviewers are members already fetched with their teams
+	lookupUC.AssertNotCalled(t, "GetUsers", mock.Anything, mock.Anything)
+}
+
+func TestGraphQL_TeamFetchesOutsideReviewersOnce(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	lookupUC := new(mockLookupUseCase)
+
+	teamUC.On("GetTeam", mock.Anything, "backend").Return(entity.Team{
+		TeamName: "backend",
+		Members: []entity.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+			{UserID: "u2", Username: "Bob", IsActive: false},
+		},
+	}, nil)
+	lookupUC.On("GetAssignedPRs", mock.Anything, []string{"u1", "u2"}, entity.PullRequestStatus("")).Return(map[string][]entity.PullRequest{
+		"u1": {
+			{PullRequestID: "pr-1", AuthorID: "x1", Status: entity.PullRequestStatusMerged, AssignedReviewers: []string{"u1", "x2"}},
+			{PullRequestID: "pr-2", AuthorID: "x2", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u1", "x3"}},
+		},
+	}, nil).Once()
+	lookupUC.On("GetUsers", mock.Anything, []string{"x1", "x2", "x3"}).Return(map[string]entity.User{
+		"x1": {UserID: "x1", Username: "Xavier", TeamName: "frontend"},
+		"x2": {UserID: "x2", Username: "Yuri", TeamName: "frontend"},
+	}, nil).Once()
+
+	result := execQuery(t, teamUC, prUC, lookupUC, `{
+		team(name: "backend") {
+			members(isActive: true) {
+				assignedPullRequests(allStatuses: true) {
+					id
+					author { username }
+					reviewers { id }
+				}
+			}
+		}
+	}`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"team": {"members": [{"assignedPullRequests": [
+		{"id": "pr-1", "author": {"username": "Xavier"}, "reviewers": [{"id": "u1"}, {"id": "x2"}]},
+		{"id": "pr-2", "author": {"username": "Yuri"}, "reviewers": [{"id": "u1"}]}
+	]}]}}`, string(result.Data))
+	lookupUC.AssertExpectations(t)
+}
+
+func TestGraphQL_MissingTeamIsNull(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	teamUC.On("GetTeam", mock.Anything, "missing").Return(nil, entity.ErrNotFound)
+
+	result := execQuery(t, teamUC, new(mockPullRequestUseCase), new(mockLookupUseCase), `{ team(name: "missing") { name } }`)
+
+	require.Empty(t, result.Errors)
+	assert.JSONEq(t, `{"team": null}`, string(result.Data))
+}
+
+func TestGraphQL_InternalErrorHidden(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	lookupUC := new(mockLookupUseCase)
+
+	teamUC.On("ListTeams", mock.Anything).Return([]entity.TeamSummary{{TeamName: "backend"}}, nil)
+	lookupUC.On("GetTeamMembers", mock.Anything, []string{"backend"}).Return(nil, assert.AnError)
+
+	result := execQuery(t, teamUC, new(mockPullRequestUseCase), lookupUC, `{ teams { members { id } } }`)
+
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "internal server error", result.Errors[0].Message)
+	assert.Equal(t, "INTERNAL", result.Errors[0].Extensions["code"])
+}
+
+func TestGraphQL_MaxDepth(t *testing.T) {
+	result := execQuery(t, new(mockTeamUseCase), new(mockPullRequestUseCase), new(mockLookupUseCase), `{
+		teams { members { assignedPullRequests { reviewers { assignedPullRequests { reviewers { assignedPullRequests { reviewers { id } } } } } } } }
+	}`)
+
+	require.NotEmpty(t, result.Errors)
+	assert.True(t, strings.Contains(result.Errors[0].Message, "depth"))
+}
+
+func TestGraphQL_InvalidBody(t *testing.T) {
+	app := fiber.New()
+	NewRouter(app, new(mockTeamUseCase), new(mockPullRequestUseCase), new(mockLookupUseCase), logger.New("error"), 8)
+
+	req := httptest.NewRequest("POST", "/graphql", bytes.NewReader([]byte(`{"variables": {}}`)))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -0,0 +1,193 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+// loader batches lookups by key within a single request. A resolver that produces a list primes the keys its
+// children will ask for, and the first child to load fetches all of them in one call, so every level of a query
+// costs a single fetch instead of one per parent.
+type loader[K comparable, V any] struct {
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+
+	mu      sync.Mutex
+	pending []K
+	queued  map[K]struct{}
+	done    map[K]struct{}
+	values  map[K]V
+}
+
+func newLoader[K comparable, V any](fetch func(ctx context.Context, keys []K) (map[K]V, error)) *loader[K, V] {
+	return &loader[K, V]{
+		fetch:  fetch,
+		queued: make(map[K]struct{}),
+		done:   make(map[K]struct{}),
+		values: make(map[K]V),
+	}
+}
+
+// prime queues keys to be fetched with the next load
+func (l *loader[K, V]) prime(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.primeLocked(keys...)
+}
+
+func (l *loader[K, V]) primeLocked(keys ...K) {
+	for _, k := range keys {
+		if _, ok := l.done[k]; ok {
+			continue
+		}
+
+		if _, ok := l.queued[k]; ok {
+			continue
+		}
+
+		l.queued[k] = struct{}{}
+		l.pending = append(l.pending, k)
+	}
+}
+
+// store records values fetched elsewhere so that loading them costs nothing
+func (l *loader[K, V]) store(values map[K]V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for k, v := range values {
+		l.done[k] = struct{}{}
+		l.values[k] = v
+	}
+}
+
+// load returns the value for key, fetching it together with every primed key; found is false for keys the fetch did not return
+func (l *loader[K, V]) load(ctx context.Context, key K) (value V, found bool, err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, ok := l.done[key]; !ok {
+		l.primeLocked(key)
+
+		keys := l.pending
+		l.pending = nil
+		l.queued = make(map[K]struct{})
+
+		values, err := l.fetch(ctx, keys)
+		if err != nil {
+			return value, false, err
+		}
+
+		for _, k := range keys {
+			l.done[k] = struct{}{}
+
+			if v, ok := values[k]; ok {
+				l.values[k] = v
+			}
+		}
+	}
+
+	value, found = l.values[key]
+
+	return value, found, nil
+}
+
+// loaders holds the request-scoped loaders shared by all resolvers of one query.
+type loaders struct {
+	users   *loader[string, entity.User]
+	members *loader[string, []entity.User]
+
+	lookupUseCase usecase.Lookup
+
+	mu sync.Mutex
+	// reviewerIDs are team members seen so far; they are primed into the assigned PR loader of every status
+	reviewerIDs []string
+	assigned    map[entity.PullRequestStatus]*loader[string, []entity.PullRequest]
+}
+
+type loadersKey struct{}
+
+func newLoaders(lookupUseCase usecase.Lookup) *loaders {
+	l := &loaders{
+		lookupUseCase: lookupUseCase,
+		assigned:      make(map[entity.PullRequestStatus]*loader[string, []entity.PullRequest]),
+	}
+
+	l.users = newLoader(lookupUseCase.GetUsers)
+	l.members = newLoader(func(ctx context.Context, teamNames []string) (map[string][]entity.User, error) {
+		members, err := lookupUseCase.GetTeamMembers(ctx, teamNames)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range teamNames {
+			l.addMembers(members[name])
+		}
+
+		return members, nil
+	})
+
+	return l
+}
+
+// withLoaders returns a context carrying fresh loaders for one request
+func withLoaders(ctx context.Context, lookupUseCase usecase.Lookup) context.Context {
+	return context.WithValue(ctx, loadersKey{}, newLoaders(lookupUseCase))
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders) //nolint:forcetypeassert // set by the handler for every request
+}
+
+// addMembers records team members as known users and primes their assigned pull requests
+func (l *loaders) addMembers(users []entity.User) {
+	byID := make(map[string]entity.User, len(users))
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		byID[u.UserID] = u
+		ids = append(ids, u.UserID)
+	}
+
+	l.users.store(byID)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.reviewerIDs = append(l.reviewerIDs, ids...)
+	for _, assigned := range l.assigned {
+		assigned.prime(ids...)
+	}
+}
+
+// assignedTo returns the loader of pull requests assigned to reviewers in the given status, empty meaning any status
+func (l *loaders) assignedTo(status entity.PullRequestStatus) *loader[string, []entity.PullRequest] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if assigned, ok := l.assigned[status]; ok {
+		return assigned
+	}
+
+	assigned := newLoader(func(ctx context.Context, reviewerIDs []string) (map[string][]entity.PullRequest, error) {
+		prs, err := l.lookupUseCase.GetAssignedPRs(ctx, reviewerIDs, status)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range reviewerIDs {
+			for _, pr := range prs[id] {
+				l.users.prime(pr.AuthorID)
+				l.users.prime(pr.AssignedReviewers...)
+			}
+		}
+
+		return prs, nil
+	})
+	assigned.prime(l.reviewerIDs...)
+	l.assigned[status] = assigned
+
+	return assigned
+}
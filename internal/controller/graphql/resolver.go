@@ -0,0 +1,253 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// queryResolver resolves the fields of the Query type.
+type queryResolver struct {
+	teamUseCase        usecase.Team
+	pullRequestUseCase usecase.PullRequest
+	l                  logger.Interface
+}
+
+// Teams -.
+func (r *queryResolver) Teams(ctx context.Context) ([]*teamResolver, error) {
+	teams, err := r.teamUseCase.ListTeams(ctx)
+	if err != nil {
+		return nil, resolverError(r.l, err)
+	}
+
+	names := make([]string, 0, len(teams))
+	resolvers := make([]*teamResolver, 0, len(teams))
+	for _, t := range teams {
+		names = append(names, t.TeamName)
+		resolvers = append(resolvers, &teamResolver{name: t.TeamName, l: r.l})
+	}
+
+	// Members of every team are fetched together
+	loadersFrom(ctx).members.prime(names...)
+
+	return resolvers, nil
+}
+
+// Team -.
+func (r *queryResolver) Team(ctx context.Context, args struct{ Name string }) (*teamResolver, error) {
+	team, err := r.teamUseCase.GetTeam(ctx, args.Name)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, nil //nolint:nilnil // a missing team resolves to null
+		}
+
+		return nil, resolverError(r.l, err)
+	}
+
+	members := make([]entity.User, 0, len(team.Members))
+	for _, m := range team.Members {
+		members = append(members, entity.User{
+			UserID:   m.UserID,
+			Username: m.Username,
+			TeamName: team.TeamName,
+			IsActive: m.IsActive,
+		})
+	}
+
+	loadersFrom(ctx).addMembers(members)
+
+	return &teamResolver{name: team.TeamName, members: members, l: r.l}, nil
+}
+
+// User -.
+func (r *queryResolver) User(ctx context.Context, args struct{ ID gql.ID }) (*userResolver, error) {
+	user, found, err := loadersFrom(ctx).users.load(ctx, string(args.ID))
+	if err != nil {
+		return nil, resolverError(r.l, err)
+	}
+
+	if !found {
+		return nil, nil //nolint:nilnil // a missing user resolves to null
+	}
+
+	return &userResolver{user: user, l: r.l}, nil
+}
+
+// PullRequest -.
+func (r *queryResolver) PullRequest(ctx context.Context, args struct{ ID gql.ID }) (*pullRequestResolver, error) {
+	pr, err := r.pullRequestUseCase.GetPR(ctx, string(args.ID), entity.PullRequestExpand{})
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return nil, nil //nolint:nilnil // a missing pull request resolves to null
+		}
+
+		return nil, resolverError(r.l, err)
+	}
+
+	loadersFrom(ctx).users.prime(append([]string{pr.AuthorID}, pr.AssignedReviewers...)...)
+
+	return &pullRequestResolver{pr: pr.PullRequest, l: r.l}, nil
+}
+
+// teamResolver resolves the fields of the Team type.
+type teamResolver struct {
+	name string
+	// members is set when the team was loaded together with its members
+	members []entity.User
+	l       logger.Interface
+}
+
+// Name -.
+func (r *teamResolver) Name() string {
+	return r.name
+}
+
+// Members -.
+func (r *teamResolver) Members(ctx context.Context, args struct{ IsActive *bool }) ([]*userResolver, error) {
+	members := r.members
+	if members == nil {
+		var err error
+
+		members, _, err = loadersFrom(ctx).members.load(ctx, r.name)
+		if err != nil {
+			return nil, resolverError(r.l, err)
+		}
+	}
+
+	resolvers := make([]*userResolver, 0, len(members))
+	for _, u := range members {
+		if args.IsActive != nil && u.IsActive != *args.IsActive {
+			continue
+		}
+
+		resolvers = append(resolvers, &userResolver{user: u, l: r.l})
+	}
+
+	return resolvers, nil
+}
+
+// userResolver resolves the fields of the User type.
+type userResolver struct {
+	user entity.User
+	l    logger.Interface
+}
+
+// ID -.
+func (r *userResolver) ID() gql.ID {
+	return gql.ID(r.user.UserID)
+}
+
+// Username -.
+func (r *userResolver) Username() string {
+	return r.user.Username
+}
+
+// TeamName -.
+func (r *userResolver) TeamName() string {
+	return r.user.TeamName
+}
+
+// IsActive -.
+func (r *userResolver) IsActive() bool {
+	return r.user.IsActive
+}
+
+// AssignedPullRequests -.
+func (r *userResolver) AssignedPullRequests(ctx context.Context, args struct {
+	Status      string
+	AllStatuses bool
+}) ([]*pullRequestResolver, error) {
+	status := entity.PullRequestStatus(args.Status)
+	if args.AllStatuses {
+		status = ""
+	}
+
+	prs, _, err := loadersFrom(ctx).assignedTo(status).load(ctx, r.user.UserID)
+	if err != nil {
+		return nil, resolverError(r.l, err)
+	}
+
+	resolvers := make([]*pullRequestResolver, 0, len(prs))
+	for _, pr := range prs {
+		resolvers = append(resolvers, &pullRequestResolver{pr: pr, l: r.l})
+	}
+
+	return resolvers, nil
+}
+
+// pullRequestResolver resolves the fields of the PullRequest type.
+type pullRequestResolver struct {
+	pr entity.PullRequest
+	l  logger.Interface
+}
+
+// ID -.
+func (r *pullRequestResolver) ID() gql.ID {
+	return gql.ID(r.pr.PullRequestID)
+}
+
+// Name -.
+func (r *pullRequestResolver) Name() string {
+	return r.pr.PullRequestName
+}
+
+// Status -.
+func (r *pullRequestResolver) Status() string {
+	return string(r.pr.Status)
+}
+
+// CreatedAt -.
+func (r *pullRequestResolver) CreatedAt() *gql.Time {
+	if r.pr.CreatedAt == nil {
+		return nil
+	}
+
+	return &gql.Time{Time: *r.pr.CreatedAt}
+}
+
+// MergedAt -.
+func (r *pullRequestResolver) MergedAt() *gql.Time {
+	if r.pr.MergedAt == nil {
+		return nil
+	}
+
+	return &gql.Time{Time: *r.pr.MergedAt}
+}
+
+// Author -.
+func (r *pullRequestResolver) Author(ctx context.Context) (*userResolver, error) {
+	user, found, err := loadersFrom(ctx).users.load(ctx, r.pr.AuthorID)
+	if err != nil {
+		return nil, resolverError(r.l, err)
+	}
+
+	if !found {
+		return nil, nil //nolint:nilnil // the author may have been removed
+	}
+
+	return &userResolver{user: user, l: r.l}, nil
+}
+
+// Reviewers -.
+func (r *pullRequestResolver) Reviewers(ctx context.Context) ([]*userResolver, error) {
+	users := loadersFrom(ctx).users
+	users.prime(r.pr.AssignedReviewers...)
+
+	resolvers := make([]*userResolver, 0, len(r.pr.AssignedReviewers))
+	for _, id := range r.pr.AssignedReviewers {
+		user, found, err := users.load(ctx, id)
+		if err != nil {
+			return nil, resolverError(r.l, err)
+		}
+
+		if found {
+			resolvers = append(resolvers, &userResolver{user: user, l: r.l})
+		}
+	}
+
+	return resolvers, nil
+}
@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Authenticator resolves a bearer token (an API key or an identity provider JWT) to the caller.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (entity.Principal, error)
+}
+
+// AuthConfig lists the methods that are treated differently by AuthInterceptor.
+type AuthConfig struct {
+	// ReadMethods only need the read scope; every other method needs the write scope
+	ReadMethods []string
+	// MethodRoles restricts methods to callers with one of the roles
+	MethodRoles map[string][]entity.Role
+}
+
+type principalKey struct{}
+
+// AuthInterceptor rejects calls without a valid "authorization: Bearer" metadata entry, checks the scope and
+// role the method needs and passes the caller on to handlers and, as the audit actor, to repositories.
+func AuthInterceptor(auth Authenticator, cfg AuthConfig, l Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		token, ok := bearerToken(ctx)
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, entity.ErrUnauthorized.Error())
+		}
+
+		principal, err := auth.Authenticate(ctx, token)
+		if errors.Is(err, entity.ErrUnauthorized) {
+			return nil, status.Error(codes.Unauthenticated, entity.ErrUnauthorized.Error())
+		}
+
+		if err != nil {
+			l.Error(err, "middleware - AuthInterceptor - Authenticate")
+
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		scope := entity.APIKeyScopeWrite
+		if slices.Contains(cfg.ReadMethods, info.FullMethod) {
+			scope = entity.APIKeyScopeRead
+		}
+
+		if !principal.HasScope(scope) {
+			return nil, status.Error(codes.PermissionDenied, entity.ErrForbidden.Error())
+		}
+
+		if roles, ok := cfg.MethodRoles[info.FullMethod]; ok && !slices.Contains(roles, principal.Role) {
+			return nil, status.Error(codes.PermissionDenied, entity.ErrInsufficientRole.Error())
+		}
+
+		ctx = context.WithValue(ctx, principalKey{}, principal)
+		ctx = entity.WithAuditMeta(ctx, entity.AuditMeta{Actor: principal.Actor()})
+
+		return handler(ctx, req)
+	}
+}
+
+// PrincipalFromContext returns the caller the call was authenticated as.
+func PrincipalFromContext(ctx context.Context) (entity.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(entity.Principal)
+
+	return principal, ok
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) != 1 {
+		return "", false
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
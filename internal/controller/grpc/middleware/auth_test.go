@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	_readMethod    = "/prreviews.v1.TeamService/GetTeam"
+	_writeMethod   = "/prreviews.v1.PullRequestService/CreatePullRequest"
+	_managerMethod = "/prreviews.v1.TeamService/CreateTeam"
+)
+
+type stubAuthenticator map[string]entity.Principal
+
+func (s stubAuthenticator) Authenticate(_ context.Context, token string) (entity.Principal, error) {
+	if token == "prk_broken" {
+		return entity.Principal{}, errors.New("connection refused")
+	}
+
+	principal, ok := s[token]
+	if !ok {
+		return entity.Principal{}, entity.ErrUnauthorized
+	}
+
+	return principal, nil
+}
+
+func newAuthTestInterceptor() grpc.UnaryServerInterceptor {
+	readWrite := []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite}
+	auth := stubAuthenticator{
+		"prk_read":   {KeyID: "key-read", Role: entity.RoleBot, Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead}},
+		"jwt_member": {UserID: "u2", Role: entity.RoleMember, Scopes: readWrite},
+		"jwt_lead":   {UserID: "u1", Role: entity.RoleTeamLead, Scopes: readWrite},
+	}
+
+	return AuthInterceptor(auth, AuthConfig{
+		ReadMethods: []string{_readMethod},
+		MethodRoles: map[string][]entity.Role{_managerMethod: {entity.RoleAdmin, entity.RoleTeamLead}},
+	}, logger.New("error"))
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		code          codes.Code
+		message       string
+	}{
+		{name: "missing metadata", method: _readMethod, code: codes.Unauthenticated, message: entity.ErrUnauthorized.Error()},
+		{name: "not a bearer token", method: _readMethod, authorization: "Basic cHJrX3JlYWQ=", code: codes.Unauthenticated, message: entity.ErrUnauthorized.Error()},
+		{name: "unknown token", method: _readMethod, authorization: "Bearer prk_unknown", code: codes.Unauthenticated, message: entity.ErrUnauthorized.Error()},
+		{name: "broken authenticator", method: _readMethod, authorization: "Bearer prk_broken", code: codes.Internal, message: "internal server error"},
+		{name: "read key on read method", method: _readMethod, authorization: "Bearer prk_read", code: codes.OK},
+		{name: "read key on write method", method: _writeMethod, authorization: "Bearer prk_read", code: codes.PermissionDenied, message: entity.ErrForbidden.Error()},
+		{name: "member on write method", method: _writeMethod, authorization: "bearer jwt_member", code: codes.OK},
+		{name: "member on manager method", method: _managerMethod, authorization: "Bearer jwt_member", code: codes.PermissionDenied, message: entity.ErrInsufficientRole.Error()},
+		{name: "team lead on manager method", method: _managerMethod, authorization: "Bearer jwt_lead", code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			called := false
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return "ok", nil
+			}
+
+			_, err := newAuthTestInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.code == codes.OK, called)
+			if tt.message != "" {
+				assert.Equal(t, tt.message, status.Convert(err).Message())
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_KeepsPrincipal(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer jwt_member"))
+
+	var (
+		principal entity.Principal
+		meta      entity.AuditMeta
+	)
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		principal, _ = PrincipalFromContext(ctx)
+		meta = entity.AuditMetaFromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := newAuthTestInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: _writeMethod}, handler)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "u2", principal.UserID)
+	assert.Equal(t, "u2", meta.Actor)
+}
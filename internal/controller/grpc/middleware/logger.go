@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Logger interface for middleware
+type Logger interface {
+	Info(message string, args ...interface{})
+	Error(message interface{}, args ...interface{})
+}
+
+func buildRequestMessage(ctx context.Context, method string, err error) string {
+	var result strings.Builder
+
+	if p, ok := peer.FromContext(ctx); ok {
+		result.WriteString(p.Addr.String())
+	}
+
+	result.WriteString(" - ")
+	result.WriteString(method)
+	result.WriteString(" - ")
+	result.WriteString(status.Code(err).String())
+
+	return result.String()
+}
+
+// LoggerInterceptor logs every unary call with its status code.
+func LoggerInterceptor(l Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+
+		l.Info(buildRequestMessage(ctx, info.FullMethod, err))
+
+		return resp, err
+	}
+}
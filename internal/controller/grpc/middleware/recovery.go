@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func buildPanicMessage(ctx context.Context, method string, err interface{}) string {
+	var result strings.Builder
+
+	if p, ok := peer.FromContext(ctx); ok {
+		result.WriteString(p.Addr.String())
+	}
+
+	result.WriteString(" - ")
+	result.WriteString(method)
+	result.WriteString(" PANIC DETECTED: ")
+	result.WriteString(fmt.Sprintf("%v\n%s\n", err, debug.Stack()))
+
+	return result.String()
+}
+
+// RecoveryInterceptor turns a panic in a handler into an Internal error and logs it with the stack trace.
+func RecoveryInterceptor(l Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				l.Error(buildPanicMessage(ctx, info.FullMethod, r))
+
+				err = status.Error(codes.Internal, "internal server error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
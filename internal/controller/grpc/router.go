@@ -0,0 +1,45 @@
+// Package grpc implements gRPC service registration.
+package grpc
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/grpc/middleware"
+	v1 "github.com/finstape/pr-reviews/internal/controller/grpc/v1"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	pbgrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewRouter -.
+func NewRouter(app *pbgrpc.Server, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, l logger.Interface) {
+	// Server reflection for grpcurl and similar clients
+	reflection.Register(app)
+
+	// API services
+	v1.NewRouter(app, teamUseCase, userUseCase, pullRequestUseCase, l)
+}
+
+// NewAuthConfig lists the methods that only read and the methods restricted by role, matching the HTTP routes.
+// Methods that act as a user (creating PRs, reassigning, user settings) are checked by the handlers.
+func NewAuthConfig() middleware.AuthConfig {
+	manager := []entity.Role{entity.RoleAdmin, entity.RoleTeamLead}
+
+	return middleware.AuthConfig{
+		ReadMethods: []string{
+			prreviewsv1.TeamService_GetTeam_FullMethodName,
+			prreviewsv1.TeamService_ListTeams_FullMethodName,
+			prreviewsv1.UserService_ListUsers_FullMethodName,
+			prreviewsv1.UserService_GetReviews_FullMethodName,
+			prreviewsv1.UserService_GetAuthored_FullMethodName,
+			prreviewsv1.PullRequestService_GetPullRequest_FullMethodName,
+			prreviewsv1.PullRequestService_ListPullRequests_FullMethodName,
+		},
+		MethodRoles: map[string][]entity.Role{
+			prreviewsv1.TeamService_CreateTeam_FullMethodName:              manager,
+			prreviewsv1.TeamService_SetChatWebhook_FullMethodName:          manager,
+			prreviewsv1.PullRequestService_MergePullRequest_FullMethodName: manager,
+		},
+	}
+}
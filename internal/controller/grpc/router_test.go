@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/controller/grpc/middleware"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pbgrpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type principalAuthenticator entity.Principal
+
+func (p principalAuthenticator) Authenticate(_ context.Context, _ string) (entity.Principal, error) {
+	return entity.Principal(p), nil
+}
+
+func TestNewAuthConfig_ManagerMethodsRejectMembers(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	app := pbgrpc.NewServer(pbgrpc.UnaryInterceptor(middleware.AuthInterceptor(principalAuthenticator{
+		UserID: "u2",
+		Role:   entity.RoleMember,
+		Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite},
+	}, NewAuthConfig(), logger.New("error"))))
+	// Denied calls never reach the use cases
+	NewRouter(app, nil, nil, nil, logger.New("error"))
+
+	go func() { _ = app.Serve(lis) }()
+	t.Cleanup(app.Stop)
+
+	conn, err := pbgrpc.NewClient("passthrough:///bufnet",
+		pbgrpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		pbgrpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer jwt")
+	teams := prreviewsv1.NewTeamServiceClient(conn)
+	pullRequests := prreviewsv1.NewPullRequestServiceClient(conn)
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{name: "CreateTeam", call: func() error {
+			_, err := teams.CreateTeam(ctx, &prreviewsv1.CreateTeamRequest{Team: &prreviewsv1.Team{TeamName: "backend"}})
+			return err
+		}},
+		{name: "SetChatWebhook", call: func() error {
+			_, err := teams.SetChatWebhook(ctx, &prreviewsv1.SetChatWebhookRequest{TeamName: "backend"})
+			return err
+		}},
+		{name: "MergePullRequest", call: func() error {
+			_, err := pullRequests.MergePullRequest(ctx, &prreviewsv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Equal(t, entity.ErrInsufficientRole.Error(), status.Convert(err).Message())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/finstape/pr-reviews/internal/controller/grpc/middleware"
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// authorizeActor allows a call to act as the user. Callers signed in as a user may name only themselves
+// unless their role may act for others; calls without a caller (disabled authentication) are not restricted.
+func authorizeActor(ctx context.Context, userID string) error {
+	principal, ok := middleware.PrincipalFromContext(ctx)
+	if !ok || principal.Role.CanActForOthers() || (principal.UserID != "" && principal.UserID == userID) {
+		return nil
+	}
+
+	return entity.ErrInsufficientRole
+}
+
+// authorizeUserChange allows changing the user to the user themselves and to team leads and admins
+func authorizeUserChange(ctx context.Context, userID string) error {
+	principal, ok := middleware.PrincipalFromContext(ctx)
+	if !ok || principal.Role.CanManage() || (principal.UserID != "" && principal.UserID == userID) {
+		return nil
+	}
+
+	return entity.ErrInsufficientRole
+}
@@ -0,0 +1,167 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// errorDomain identifies the service in ErrorInfo details attached to error statuses.
+const errorDomain = "pr-reviews"
+
+// handleError converts a domain error into a gRPC status carrying the error code as ErrorInfo reason
+func handleError(l logger.Interface, err error) error {
+	code := entity.GetErrorCode(err)
+	message := entity.GetErrorMessage(err)
+
+	var statusCode codes.Code
+	switch code {
+	case entity.ErrorCodeTeamExists, entity.ErrorCodePRExists:
+		statusCode = codes.AlreadyExists
+	case entity.ErrorCodePRMerged, entity.ErrorCodePRClosed, entity.ErrorCodeNotAssigned, entity.ErrorCodeNoCandidate:
+		statusCode = codes.FailedPrecondition
+	case entity.ErrorCodeNotFound:
+		statusCode = codes.NotFound
+	case entity.ErrorCodeInvalidCursor, entity.ErrorCodeInvalidWindow, entity.ErrorCodeInvalidPayload:
+		statusCode = codes.InvalidArgument
+	case entity.ErrorCodeUnauthorized:
+		statusCode = codes.Unauthenticated
+	case entity.ErrorCodeForbidden:
+		statusCode = codes.PermissionDenied
+	default:
+		// Don't expose internal error details
+		l.Error(err, "internal error")
+
+		return status.Error(codes.Internal, "internal server error")
+	}
+
+	st, detailsErr := status.New(statusCode, message).WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: errorDomain,
+	})
+	if detailsErr != nil {
+		return status.Error(statusCode, message)
+	}
+
+	return st.Err()
+}
+
+// invalidArgument returns an InvalidArgument status for a request that failed validation
+func invalidArgument(format string, args ...interface{}) error {
+	return status.Error(codes.InvalidArgument, fmt.Sprintf(format, args...))
+}
+
+// validateLimit checks a page limit where zero means the default
+func validateLimit(limit int32) error {
+	if limit < 0 || limit > entity.MaxPageLimit {
+		return invalidArgument("limit must be between 1 and %d", entity.MaxPageLimit)
+	}
+
+	return nil
+}
+
+// toTimestamp converts an optional time, returning nil for nil
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+// fromTimestamp converts an optional timestamp, returning nil for nil
+func fromTimestamp(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+
+	t := ts.AsTime()
+
+	return &t
+}
+
+// toPullRequestStatus converts a PR status, returning UNSPECIFIED for an unknown value
+func toPullRequestStatus(s entity.PullRequestStatus) prreviewsv1.PullRequestStatus {
+	switch s {
+	case entity.PullRequestStatusOpen:
+		return prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case entity.PullRequestStatusMerged:
+		return prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	case entity.PullRequestStatusClosed:
+		return prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_CLOSED
+	default:
+		return prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+// fromPullRequestStatus converts a PR status filter, returning an empty status for UNSPECIFIED
+func fromPullRequestStatus(s prreviewsv1.PullRequestStatus) (entity.PullRequestStatus, error) {
+	switch s {
+	case prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED:
+		return "", nil
+	case prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return entity.PullRequestStatusOpen, nil
+	case prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return entity.PullRequestStatusMerged, nil
+	case prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_CLOSED:
+		return entity.PullRequestStatusClosed, nil
+	default:
+		return "", invalidArgument("unknown status %d", s)
+	}
+}
+
+// fromSortOrder converts a sort order, returning an empty order (newest first) for UNSPECIFIED
+func fromSortOrder(o prreviewsv1.SortOrder) (entity.SortOrder, error) {
+	switch o {
+	case prreviewsv1.SortOrder_SORT_ORDER_UNSPECIFIED:
+		return "", nil
+	case prreviewsv1.SortOrder_SORT_ORDER_ASC:
+		return entity.SortOrderAsc, nil
+	case prreviewsv1.SortOrder_SORT_ORDER_DESC:
+		return entity.SortOrderDesc, nil
+	default:
+		return "", invalidArgument("unknown order %d", o)
+	}
+}
+
+// newPageRequest validates and converts pagination fields
+func newPageRequest(limit int32, cursor string, order prreviewsv1.SortOrder) (entity.PageRequest, error) {
+	if err := validateLimit(limit); err != nil {
+		return entity.PageRequest{}, err
+	}
+
+	sortOrder, err := fromSortOrder(order)
+	if err != nil {
+		return entity.PageRequest{}, err
+	}
+
+	return entity.PageRequest{Limit: int(limit), Cursor: cursor, Order: sortOrder}, nil
+}
+
+func toUser(u entity.User) *prreviewsv1.User {
+	return &prreviewsv1.User{
+		UserId:   u.UserID,
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func toPullRequest(pr entity.PullRequest) *prreviewsv1.PullRequest {
+	return &prreviewsv1.PullRequest{
+		PullRequestId:     pr.PullRequestID,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorID,
+		Status:            toPullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         toTimestamp(pr.CreatedAt),
+		MergedAt:          toTimestamp(pr.MergedAt),
+	}
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+)
+
+// PullRequestServer implements prreviewsv1.PullRequestServiceServer.
+type PullRequestServer struct {
+	prreviewsv1.UnimplementedPullRequestServiceServer
+
+	pullRequestUseCase usecase.PullRequest
+	l                  logger.Interface
+}
+
+// NewPullRequestServer creates a new PullRequestServer instance.
+func NewPullRequestServer(pullRequestUseCase usecase.PullRequest, l logger.Interface) *PullRequestServer {
+	return &PullRequestServer{
+		pullRequestUseCase: pullRequestUseCase,
+		l:                  l,
+	}
+}
+
+// CreatePullRequest -.
+func (s *PullRequestServer) CreatePullRequest(ctx context.Context, req *prreviewsv1.CreatePullRequestRequest) (*prreviewsv1.CreatePullRequestResponse, error) {
+	if req.GetPullRequestId() == "" || req.GetPullRequestName() == "" || req.GetAuthorId() == "" {
+		return nil, invalidArgument("pull_request_id, pull_request_name and author_id are required")
+	}
+
+	if err := authorizeActor(ctx, req.GetAuthorId()); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	pr, err := s.pullRequestUseCase.CreatePR(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId())
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.CreatePullRequestResponse{PullRequest: toPullRequest(pr)}, nil
+}
+
+// GetPullRequest -.
+func (s *PullRequestServer) GetPullRequest(ctx context.Context, req *prreviewsv1.GetPullRequestRequest) (*prreviewsv1.GetPullRequestResponse, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+
+	pr, err := s.pullRequestUseCase.GetPR(ctx, req.GetPullRequestId(), entity.PullRequestExpand{
+		Author:    req.GetExpandAuthor(),
+		Reviewers: req.GetExpandReviewers(),
+	})
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.GetPullRequestResponse{PullRequest: toPullRequest(pr.PullRequest)}
+	if pr.Author != nil {
+		resp.Author = toUser(*pr.Author)
+	}
+	for _, u := range pr.Reviewers {
+		resp.Reviewers = append(resp.Reviewers, toUser(u))
+	}
+
+	return resp, nil
+}
+
+// ListPullRequests -.
+func (s *PullRequestServer) ListPullRequests(ctx context.Context, req *prreviewsv1.ListPullRequestsRequest) (*prreviewsv1.ListPullRequestsResponse, error) {
+	prStatus, err := fromPullRequestStatus(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	pageRequest, err := newPageRequest(req.GetLimit(), req.GetCursor(), req.GetOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	filter := entity.PullRequestFilter{
+		Status:       prStatus,
+		AuthorID:     req.GetAuthorId(),
+		ReviewerID:   req.GetReviewerId(),
+		TeamName:     req.GetTeamName(),
+		NameContains: req.GetNameContains(),
+		CreatedFrom:  fromTimestamp(req.GetCreatedFrom()),
+		CreatedTo:    fromTimestamp(req.GetCreatedTo()),
+		MergedFrom:   fromTimestamp(req.GetMergedFrom()),
+		MergedTo:     fromTimestamp(req.GetMergedTo()),
+	}
+
+	page, err := s.pullRequestUseCase.ListPRs(ctx, filter, pageRequest)
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.ListPullRequestsResponse{
+		PullRequests: make([]*prreviewsv1.PullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, toPullRequest(pr))
+	}
+
+	return resp, nil
+}
+
+// MergePullRequest -.
+func (s *PullRequestServer) MergePullRequest(ctx context.Context, req *prreviewsv1.MergePullRequestRequest) (*prreviewsv1.MergePullRequestResponse, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, invalidArgument("pull_request_id is required")
+	}
+
+	pr, err := s.pullRequestUseCase.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.MergePullRequestResponse{PullRequest: toPullRequest(pr)}, nil
+}
+
+// ReassignReviewer -.
+func (s *PullRequestServer) ReassignReviewer(ctx context.Context, req *prreviewsv1.ReassignReviewerRequest) (*prreviewsv1.ReassignReviewerResponse, error) {
+	if req.GetPullRequestId() == "" || req.GetOldUserId() == "" {
+		return nil, invalidArgument("pull_request_id and old_user_id are required")
+	}
+
+	if err := authorizeActor(ctx, req.GetOldUserId()); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	pr, newReviewerID, err := s.pullRequestUseCase.ReassignReviewer(ctx, req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.ReassignReviewerResponse{
+		PullRequest: toPullRequest(pr),
+		ReplacedBy:  newReviewerID,
+	}, nil
+}
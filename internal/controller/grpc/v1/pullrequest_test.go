@@ -0,0 +1,225 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCreatePullRequest_Success(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	createdAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	prUC.On("CreatePR", mock.Anything, "pr-1", "Add feature", "u1").Return(entity.PullRequest{
+		PullRequestID:     "pr-1",
+		PullRequestName:   "Add feature",
+		AuthorID:          "u1",
+		Status:            entity.PullRequestStatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+		CreatedAt:         &createdAt,
+	}, nil)
+
+	resp, err := server.CreatePullRequest(context.Background(), &prreviewsv1.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add feature",
+		AuthorId:        "u1",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, resp.GetPullRequest().GetStatus())
+	assert.Equal(t, []string{"u2", "u3"}, resp.GetPullRequest().GetAssignedReviewers())
+	assert.Equal(t, createdAt, resp.GetPullRequest().GetCreatedAt().AsTime())
+	assert.Nil(t, resp.GetPullRequest().GetMergedAt())
+	prUC.AssertExpectations(t)
+}
+
+func TestCreatePullRequest_Exists(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	prUC.On("CreatePR", mock.Anything, "pr-1", "Add feature", "u1").Return(nil, entity.ErrPRExists)
+
+	_, err := server.CreatePullRequest(context.Background(), &prreviewsv1.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add feature",
+		AuthorId:        "u1",
+	})
+
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestGetPullRequest_Expanded(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	prUC.On("GetPR", mock.Anything, "pr-1", entity.PullRequestExpand{Author: true, Reviewers: true}).Return(entity.PullRequestDetail{
+		PullRequest: entity.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: entity.PullRequestStatusOpen},
+		Author:      &entity.User{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		Reviewers:   []entity.User{{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true}},
+	}, nil)
+
+	resp, err := server.GetPullRequest(context.Background(), &prreviewsv1.GetPullRequestRequest{
+		PullRequestId:   "pr-1",
+		ExpandAuthor:    true,
+		ExpandReviewers: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", resp.GetAuthor().GetUsername())
+	require.Len(t, resp.GetReviewers(), 1)
+	assert.Equal(t, "u2", resp.GetReviewers()[0].GetUserId())
+	prUC.AssertExpectations(t)
+}
+
+func TestListPullRequests_Filters(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := entity.PullRequestFilter{
+		Status:       entity.PullRequestStatusMerged,
+		TeamName:     "backend",
+		NameContains: "fix",
+		CreatedFrom:  &createdFrom,
+	}
+	page := entity.PageRequest{Limit: 20, Order: entity.SortOrderAsc}
+	prUC.On("ListPRs", mock.Anything, filter, page).Return(entity.PullRequestPage{
+		PullRequests: []entity.PullRequest{{PullRequestID: "pr-1", Status: entity.PullRequestStatusMerged}},
+		NextCursor:   "next",
+	}, nil)
+
+	resp, err := server.ListPullRequests(context.Background(), &prreviewsv1.ListPullRequestsRequest{
+		Status:       prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED,
+		TeamName:     "backend",
+		NameContains: "fix",
+		CreatedFrom:  timestamppb.New(createdFrom),
+		Order:        prreviewsv1.SortOrder_SORT_ORDER_ASC,
+		Limit:        20,
+	})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetPullRequests(), 1)
+	assert.Equal(t, "next", resp.GetNextCursor())
+	prUC.AssertExpectations(t)
+}
+
+func TestListPullRequests_InvalidCursor(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	prUC.On("ListPRs", mock.Anything, entity.PullRequestFilter{}, entity.PageRequest{Cursor: "bad"}).Return(nil, entity.ErrInvalidCursor)
+
+	_, err := server.ListPullRequests(context.Background(), &prreviewsv1.ListPullRequestsRequest{Cursor: "bad"})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReassignReviewer_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "merged", err: entity.ErrPRMerged, code: codes.FailedPrecondition},
+		{name: "not assigned", err: entity.ErrNotAssigned, code: codes.FailedPrecondition},
+		{name: "no candidate", err: entity.ErrNoCandidate, code: codes.FailedPrecondition},
+		{name: "not found", err: entity.ErrNotFound, code: codes.NotFound},
+		{name: "wrapped not found", err: fmt.Errorf("GetPR: %w", entity.ErrNotFound), code: codes.NotFound},
+		{name: "internal", err: errors.New("connection refused"), code: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prUC := new(mockPullRequestUseCase)
+			server := NewPullRequestServer(prUC, logger.New("error"))
+
+			prUC.On("ReassignReviewer", mock.Anything, "pr-1", "u2").Return(nil, "", tt.err)
+
+			_, err := server.ReassignReviewer(context.Background(), &prreviewsv1.ReassignReviewerRequest{
+				PullRequestId: "pr-1",
+				OldUserId:     "u2",
+			})
+
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+func TestMergePullRequest_Success(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	server := NewPullRequestServer(prUC, logger.New("error"))
+
+	mergedAt := time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC)
+	prUC.On("MergePR", mock.Anything, "pr-1").Return(entity.PullRequest{
+		PullRequestID: "pr-1",
+		Status:        entity.PullRequestStatusMerged,
+		MergedAt:      &mergedAt,
+	}, nil)
+
+	resp, err := server.MergePullRequest(context.Background(), &prreviewsv1.MergePullRequestRequest{PullRequestId: "pr-1"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED, resp.GetPullRequest().GetStatus())
+	assert.Equal(t, mergedAt, resp.GetPullRequest().GetMergedAt().AsTime())
+}
+
+func TestCreatePullRequest_ActsAsSignedInUser(t *testing.T) {
+	tests := []struct {
+		name     string
+		signedIn string
+		role     entity.Role
+		authorID string
+		code     codes.Code
+	}{
+		{name: "member as author", signedIn: "u1", role: entity.RoleMember, authorID: "u1", code: codes.OK},
+		{name: "member for someone else", signedIn: "u2", role: entity.RoleMember, authorID: "u1", code: codes.PermissionDenied},
+		{name: "bot for someone else", signedIn: "u9", role: entity.RoleBot, authorID: "u1", code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prUC := new(mockPullRequestUseCase)
+			if tt.code == codes.OK {
+				prUC.On("CreatePR", mock.Anything, "pr-1", "Add feature", tt.authorID).Return(entity.PullRequest{PullRequestID: "pr-1", AuthorID: tt.authorID}, nil)
+			}
+
+			client := prreviewsv1.NewPullRequestServiceClient(newTestClient(t, new(mockTeamUseCase), new(mockUserUseCase), prUC, signedInAs(tt.signedIn, tt.role)))
+
+			_, err := client.CreatePullRequest(withToken(), &prreviewsv1.CreatePullRequestRequest{
+				PullRequestId:   "pr-1",
+				PullRequestName: "Add feature",
+				AuthorId:        tt.authorID,
+			})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReassignReviewer_MemberReassignsSomeoneElse(t *testing.T) {
+	prUC := new(mockPullRequestUseCase)
+	client := prreviewsv1.NewPullRequestServiceClient(newTestClient(t, new(mockTeamUseCase), new(mockUserUseCase), prUC, signedInAs("u2", entity.RoleMember)))
+
+	_, err := client.ReassignReviewer(withToken(), &prreviewsv1.ReassignReviewerRequest{
+		PullRequestId: "pr-1",
+		OldUserId:     "u3",
+	})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, entity.ErrInsufficientRole.Error(), status.Convert(err).Message())
+	prUC.AssertNotCalled(t, "ReassignReviewer", mock.Anything, mock.Anything, mock.Anything)
+}
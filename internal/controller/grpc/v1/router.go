@@ -0,0 +1,15 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"google.golang.org/grpc"
+)
+
+// NewRouter -.
+func NewRouter(app *grpc.Server, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, l logger.Interface) {
+	prreviewsv1.RegisterTeamServiceServer(app, NewTeamServer(teamUseCase, l))
+	prreviewsv1.RegisterUserServiceServer(app, NewUserServer(userUseCase, l))
+	prreviewsv1.RegisterPullRequestServiceServer(app, NewPullRequestServer(pullRequestUseCase, l))
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+)
+
+// TeamServer implements prreviewsv1.TeamServiceServer.
+type TeamServer struct {
+	prreviewsv1.UnimplementedTeamServiceServer
+
+	teamUseCase usecase.Team
+	l           logger.Interface
+}
+
+// NewTeamServer creates a new TeamServer instance.
+func NewTeamServer(teamUseCase usecase.Team, l logger.Interface) *TeamServer {
+	return &TeamServer{
+		teamUseCase: teamUseCase,
+		l:           l,
+	}
+}
+
+// CreateTeam -.
+func (s *TeamServer) CreateTeam(ctx context.Context, req *prreviewsv1.CreateTeamRequest) (*prreviewsv1.CreateTeamResponse, error) {
+	if req.GetTeam().GetTeamName() == "" {
+		return nil, invalidArgument("team.team_name is required")
+	}
+
+	members := make([]entity.TeamMember, 0, len(req.GetTeam().GetMembers()))
+	for _, m := range req.GetTeam().GetMembers() {
+		if m.GetUserId() == "" || m.GetUsername() == "" {
+			return nil, invalidArgument("team.members require user_id and username")
+		}
+
+		members = append(members, entity.TeamMember{
+			UserID:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		})
+	}
+
+	team := entity.Team{
+		TeamName: req.GetTeam().GetTeamName(),
+		Members:  members,
+	}
+
+	if err := s.teamUseCase.CreateTeam(ctx, team); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.CreateTeamResponse{Team: toTeam(team)}, nil
+}
+
+// GetTeam -.
+func (s *TeamServer) GetTeam(ctx context.Context, req *prreviewsv1.GetTeamRequest) (*prreviewsv1.GetTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	team, err := s.teamUseCase.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.GetTeamResponse{Team: toTeam(team)}, nil
+}
+
+// ListTeams -.
+func (s *TeamServer) ListTeams(ctx context.Context, _ *prreviewsv1.ListTeamsRequest) (*prreviewsv1.ListTeamsResponse, error) {
+	teams, err := s.teamUseCase.ListTeams(ctx)
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.ListTeamsResponse{Teams: make([]*prreviewsv1.TeamSummary, 0, len(teams))}
+	for _, t := range teams {
+		resp.Teams = append(resp.Teams, toTeamSummary(t))
+	}
+
+	return resp, nil
+}
+
+// SetChatWebhook -.
+func (s *TeamServer) SetChatWebhook(ctx context.Context, req *prreviewsv1.SetChatWebhookRequest) (*prreviewsv1.SetChatWebhookResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, invalidArgument("team_name is required")
+	}
+
+	if err := s.teamUseCase.SetChatWebhook(ctx, req.GetTeamName(), req.GetUrl()); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.SetChatWebhookResponse{
+		TeamName:              req.GetTeamName(),
+		ChatWebhookConfigured: req.GetUrl() != "",
+	}, nil
+}
+
+func toTeam(team entity.Team) *prreviewsv1.Team {
+	members := make([]*prreviewsv1.TeamMember, 0, len(team.Members))
+	for _, m := range team.Members {
+		members = append(members, &prreviewsv1.TeamMember{
+			UserId:   m.UserID,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		})
+	}
+
+	return &prreviewsv1.Team{
+		TeamName: team.TeamName,
+		Members:  members,
+	}
+}
+
+func toTeamSummary(t entity.TeamSummary) *prreviewsv1.TeamSummary {
+	summary := &prreviewsv1.TeamSummary{
+		TeamName:              t.TeamName,
+		MemberCount:           int32(t.MemberCount), //nolint:gosec // member counts are far below int32 range
+		ActiveCount:           int32(t.ActiveCount), //nolint:gosec // member counts are far below int32 range
+		ChatWebhookConfigured: t.ChatWebhookConfigured,
+	}
+
+	if t.ReviewSLASeconds != nil {
+		sla := int64(*t.ReviewSLASeconds)
+		summary.ReviewSlaSeconds = &sla
+	}
+
+	if t.EscalationSeconds != nil {
+		escalation := int64(*t.EscalationSeconds)
+		summary.EscalationSeconds = &escalation
+	}
+
+	return summary
+}
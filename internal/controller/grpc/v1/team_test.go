@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/controller/grpc/middleware"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type mockTeamUseCase struct {
+	mock.Mock
+}
+
+func (m *mockTeamUseCase) CreateTeam(ctx context.Context, team entity.Team) error {
+	args := m.Called(ctx, team)
+	return args.Error(0)
+}
+
+func (m *mockTeamUseCase) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return entity.Team{}, args.Error(1)
+	}
+	return args.Get(0).(entity.Team), args.Error(1)
+}
+
+func (m *mockTeamUseCase) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamUseCase) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+var _ usecase.Team = (*mockTeamUseCase)(nil)
+
+type mockUserUseCase struct {
+	mock.Mock
+}
+
+func (m *mockUserUseCase) SetIsActive(ctx context.Context, userID string, isActive bool) (entity.User, error) {
+	args := m.Called(ctx, userID, isActive)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.ReviewQueuePage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.ReviewQueuePage), args.Error(1)
+}
+
+func (m *mockUserUseCase) GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.AuthoredPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.AuthoredPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.UserPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.UserPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error) {
+	args := m.Called(ctx, settings)
+	return args.Get(0).(entity.DigestSettings), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+var _ usecase.User = (*mockUserUseCase)(nil)
+
+type mockPullRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID, prName, authorID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+var _ usecase.PullRequest = (*mockPullRequestUseCase)(nil)
+
+// newTestClient serves the API over an in-memory listener and returns a connection to it
+func newTestClient(t *testing.T, teamUC usecase.Team, userUC usecase.User, prUC usecase.PullRequest, opts ...grpc.ServerOption) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	app := grpc.NewServer(opts...)
+	NewRouter(app, teamUC, userUC, prUC, logger.New("error"))
+
+	go func() { _ = app.Serve(lis) }()
+	t.Cleanup(app.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
+
+type principalAuthenticator entity.Principal
+
+func (p principalAuthenticator) Authenticate(_ context.Context, _ string) (entity.Principal, error) {
+	return entity.Principal(p), nil
+}
+
+// signedInAs authenticates every call as the user, as an OIDC token would
+func signedInAs(userID string, role entity.Role) grpc.ServerOption {
+	return grpc.UnaryInterceptor(middleware.AuthInterceptor(principalAuthenticator{
+		UserID: userID,
+		Role:   role,
+		Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite},
+	}, middleware.AuthConfig{}, logger.New("error")))
+}
+
+// withToken attaches a bearer token to an outgoing call
+func withToken() context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer jwt")
+}
+
+func TestCreateTeam_Success(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	server := NewTeamServer(teamUC, logger.New("error"))
+
+	expected := entity.Team{
+		TeamName: "backend",
+		Members: []entity.TeamMember{
+			{UserID: "u1", Username: "Alice", IsActive: true},
+		},
+	}
+	teamUC.On("CreateTeam", mock.Anything, expected).Return(nil)
+
+	resp, err := server.CreateTeam(context.Background(), &prreviewsv1.CreateTeamRequest{
+		Team: &prreviewsv1.Team{
+			TeamName: "backend",
+			Members: []*prreviewsv1.TeamMember{
+				{UserId: "u1", Username: "Alice", IsActive: true},
+			},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "backend", resp.GetTeam().GetTeamName())
+	assert.Len(t, resp.GetTeam().GetMembers(), 1)
+	teamUC.AssertExpectations(t)
+}
+
+func TestCreateTeam_MissingMemberFields(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	server := NewTeamServer(teamUC, logger.New("error"))
+
+	_, err := server.CreateTeam(context.Background(), &prreviewsv1.CreateTeamRequest{
+		Team: &prreviewsv1.Team{
+			TeamName: "backend",
+			Members:  []*prreviewsv1.TeamMember{{UserId: "u1"}},
+		},
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	teamUC.AssertNotCalled(t, "CreateTeam", mock.Anything, mock.Anything)
+}
+
+func TestCreateTeam_ExistsOverConnection(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	teamUC.On("CreateTeam", mock.Anything, mock.AnythingOfType("entity.Team")).
+		Return(entity.ErrTeamExists)
+
+	client := prreviewsv1.NewTeamServiceClient(newTestClient(t, teamUC, new(mockUserUseCase), new(mockPullRequestUseCase)))
+
+	_, err := client.CreateTeam(context.Background(), &prreviewsv1.CreateTeamRequest{
+		Team: &prreviewsv1.Team{TeamName: "backend"},
+	})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.AlreadyExists, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	assert.Equal(t, string(entity.ErrorCodeTeamExists), info.GetReason())
+}
+
+func TestGetTeam_MissingTeamName(t *testing.T) {
+	server := NewTeamServer(new(mockTeamUseCase), logger.New("error"))
+
+	_, err := server.GetTeam(context.Background(), &prreviewsv1.GetTeamRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListTeams_Success(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	server := NewTeamServer(teamUC, logger.New("error"))
+
+	sla := 3600
+	teamUC.On("ListTeams", mock.Anything).Return([]entity.TeamSummary{
+		{TeamName: "backend", MemberCount: 3, ActiveCount: 2, ReviewSLASeconds: &sla},
+		{TeamName: "frontend", MemberCount: 1, ActiveCount: 1, ChatWebhookConfigured: true},
+	}, nil)
+
+	resp, err := server.ListTeams(context.Background(), &prreviewsv1.ListTeamsRequest{})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetTeams(), 2)
+	assert.Equal(t, int64(3600), resp.GetTeams()[0].GetReviewSlaSeconds())
+	assert.Nil(t, resp.GetTeams()[1].ReviewSlaSeconds)
+	assert.True(t, resp.GetTeams()[1].GetChatWebhookConfigured())
+}
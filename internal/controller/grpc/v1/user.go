@@ -0,0 +1,260 @@
+package v1
+
+import (
+	"context"
+	"net/mail"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+)
+
+// UserServer implements prreviewsv1.UserServiceServer.
+type UserServer struct {
+	prreviewsv1.UnimplementedUserServiceServer
+
+	userUseCase usecase.User
+	l           logger.Interface
+}
+
+// NewUserServer creates a new UserServer instance.
+func NewUserServer(userUseCase usecase.User, l logger.Interface) *UserServer {
+	return &UserServer{
+		userUseCase: userUseCase,
+		l:           l,
+	}
+}
+
+// SetIsActive -.
+func (s *UserServer) SetIsActive(ctx context.Context, req *prreviewsv1.SetIsActiveRequest) (*prreviewsv1.SetIsActiveResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	if err := authorizeUserChange(ctx, req.GetUserId()); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	user, err := s.userUseCase.SetIsActive(ctx, req.GetUserId(), req.GetIsActive())
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.SetIsActiveResponse{User: toUser(user)}, nil
+}
+
+// SetDigestSettings -.
+func (s *UserServer) SetDigestSettings(ctx context.Context, req *prreviewsv1.SetDigestSettingsRequest) (*prreviewsv1.SetDigestSettingsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	if req.GetEmail() != "" {
+		if addr, err := mail.ParseAddress(req.GetEmail()); err != nil || addr.Address != req.GetEmail() {
+			return nil, invalidArgument("email must be a valid address")
+		}
+	}
+
+	if err := authorizeActor(ctx, req.GetUserId()); err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	settings, err := s.userUseCase.SetDigestSettings(ctx, entity.DigestSettings{
+		UserID:        req.GetUserId(),
+		Email:         req.GetEmail(),
+		DigestEnabled: req.GetDigestEnabled(),
+	})
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	return &prreviewsv1.SetDigestSettingsResponse{
+		DigestSettings: &prreviewsv1.DigestSettings{
+			UserId:        settings.UserID,
+			Email:         settings.Email,
+			DigestEnabled: settings.DigestEnabled,
+		},
+	}, nil
+}
+
+// ListUsers -.
+func (s *UserServer) ListUsers(ctx context.Context, req *prreviewsv1.ListUsersRequest) (*prreviewsv1.ListUsersResponse, error) {
+	if err := validateLimit(req.GetLimit()); err != nil {
+		return nil, err
+	}
+
+	filter := entity.UserFilter{TeamName: req.GetTeamName()}
+	if req.IsActive != nil {
+		isActive := req.GetIsActive()
+		filter.IsActive = &isActive
+	}
+
+	page, err := s.userUseCase.ListUsers(ctx, filter, entity.PageRequest{Limit: int(req.GetLimit()), Cursor: req.GetCursor()})
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.ListUsersResponse{
+		Users:      make([]*prreviewsv1.User, 0, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+	for _, u := range page.Users {
+		resp.Users = append(resp.Users, toUser(u))
+	}
+
+	return resp, nil
+}
+
+// GetReviews -.
+func (s *UserServer) GetReviews(ctx context.Context, req *prreviewsv1.GetReviewsRequest) (*prreviewsv1.GetReviewsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	query, err := newReviewQueueQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.userUseCase.GetUserReviews(ctx, req.GetUserId(), query)
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.GetReviewsResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*prreviewsv1.ReviewQueueItem, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, item := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, &prreviewsv1.ReviewQueueItem{
+			PullRequestId:     item.PullRequestID,
+			PullRequestName:   item.PullRequestName,
+			AuthorId:          item.AuthorID,
+			Status:            toPullRequestStatus(item.Status),
+			CreatedAt:         toTimestamp(item.CreatedAt),
+			AssignedReviewers: item.AssignedReviewers,
+			AgeSeconds:        item.AgeSeconds,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetAuthored -.
+func (s *UserServer) GetAuthored(ctx context.Context, req *prreviewsv1.GetAuthoredRequest) (*prreviewsv1.GetAuthoredResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, invalidArgument("user_id is required")
+	}
+
+	prStatus, err := fromPullRequestStatus(req.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	pageRequest, err := newPageRequest(req.GetLimit(), req.GetCursor(), req.GetOrder())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.userUseCase.GetAuthoredPRs(ctx, req.GetUserId(), entity.AuthoredQuery{Status: prStatus, Page: pageRequest})
+	if err != nil {
+		return nil, handleError(s.l, err)
+	}
+
+	resp := &prreviewsv1.GetAuthoredResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*prreviewsv1.AuthoredPullRequest, 0, len(page.PullRequests)),
+		NextCursor:   page.NextCursor,
+	}
+	for _, pr := range page.PullRequests {
+		resp.PullRequests = append(resp.PullRequests, toAuthoredPullRequest(pr))
+	}
+
+	return resp, nil
+}
+
+// newReviewQueueQuery converts review queue request fields; status defaults to OPEN, all_statuses disables the filter
+func newReviewQueueQuery(req *prreviewsv1.GetReviewsRequest) (entity.ReviewQueueQuery, error) {
+	prStatus, err := fromPullRequestStatus(req.GetStatus())
+	if err != nil {
+		return entity.ReviewQueueQuery{}, err
+	}
+
+	switch {
+	case req.GetAllStatuses():
+		prStatus = ""
+	case prStatus == "":
+		prStatus = entity.PullRequestStatusOpen
+	}
+
+	pageRequest, err := newPageRequest(req.GetLimit(), req.GetCursor(), req.GetOrder())
+	if err != nil {
+		return entity.ReviewQueueQuery{}, err
+	}
+
+	return entity.ReviewQueueQuery{
+		Status:           prStatus,
+		IncludeReviewers: req.GetIncludeReviewers(),
+		IncludeAge:       req.GetIncludeAge(),
+		Page:             pageRequest,
+	}, nil
+}
+
+func toAuthoredPullRequest(pr entity.AuthoredPullRequest) *prreviewsv1.AuthoredPullRequest {
+	reviewers := make([]*prreviewsv1.ReviewerAssignment, 0, len(pr.Reviewers))
+	for _, r := range pr.Reviewers {
+		assignment := &prreviewsv1.ReviewerAssignment{
+			UserId:     r.UserID,
+			Username:   r.Username,
+			IsActive:   r.IsActive,
+			AssignedAt: toTimestamp(r.AssignedAt),
+			ReviewedAt: toTimestamp(r.ReviewedAt),
+		}
+		if r.ReviewOutcome != nil {
+			assignment.ReviewOutcome = toReviewOutcome(*r.ReviewOutcome)
+		}
+
+		reviewers = append(reviewers, assignment)
+	}
+
+	return &prreviewsv1.AuthoredPullRequest{
+		PullRequestId:   pr.PullRequestID,
+		PullRequestName: pr.PullRequestName,
+		AuthorId:        pr.AuthorID,
+		Status:          toPullRequestStatus(pr.Status),
+		CreatedAt:       toTimestamp(pr.CreatedAt),
+		MergedAt:        toTimestamp(pr.MergedAt),
+		ReviewStatus:    toReviewStatus(pr.ReviewStatus),
+		Reviewers:       reviewers,
+	}
+}
+
+func toReviewStatus(s entity.ReviewStatus) prreviewsv1.ReviewStatus {
+	switch s {
+	case entity.ReviewStatusUnassigned:
+		return prreviewsv1.ReviewStatus_REVIEW_STATUS_UNASSIGNED
+	case entity.ReviewStatusPending:
+		return prreviewsv1.ReviewStatus_REVIEW_STATUS_PENDING
+	case entity.ReviewStatusMerged:
+		return prreviewsv1.ReviewStatus_REVIEW_STATUS_MERGED
+	case entity.ReviewStatusClosed:
+		return prreviewsv1.ReviewStatus_REVIEW_STATUS_CLOSED
+	default:
+		return prreviewsv1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func toReviewOutcome(o entity.ReviewOutcome) prreviewsv1.ReviewOutcome {
+	switch o {
+	case entity.ReviewOutcomeApproved:
+		return prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_APPROVED
+	case entity.ReviewOutcomeChangesRequested:
+		return prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_CHANGES_REQUESTED
+	case entity.ReviewOutcomeCommented:
+		return prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_COMMENTED
+	default:
+		return prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_UNSPECIFIED
+	}
+}
@@ -0,0 +1,216 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	prreviewsv1 "github.com/finstape/pr-reviews/proto/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetReviews_DefaultsToOpen(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	query := entity.ReviewQueueQuery{Status: entity.PullRequestStatusOpen}
+	userUC.On("GetUserReviews", mock.Anything, "u1", query).Return(entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{
+			{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", AuthorID: "u2", Status: entity.PullRequestStatusOpen}},
+		},
+		NextCursor: "next",
+	}, nil)
+
+	resp, err := server.GetReviews(context.Background(), &prreviewsv1.GetReviewsRequest{UserId: "u1"})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetPullRequests(), 1)
+	assert.Equal(t, prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN, resp.GetPullRequests()[0].GetStatus())
+	assert.Equal(t, "next", resp.GetNextCursor())
+	userUC.AssertExpectations(t)
+}
+
+func TestGetReviews_AllStatusesWithOptions(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	age := int64(120)
+	query := entity.ReviewQueueQuery{
+		IncludeReviewers: true,
+		IncludeAge:       true,
+		Page:             entity.PageRequest{Limit: 10, Cursor: "c", Order: entity.SortOrderAsc},
+	}
+	userUC.On("GetUserReviews", mock.Anything, "u1", query).Return(entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{
+			{
+				PullRequestShort:  entity.PullRequestShort{PullRequestID: "pr-1", Status: entity.PullRequestStatusMerged},
+				AssignedReviewers: []string{"u1", "u3"},
+				AgeSeconds:        &age,
+			},
+		},
+	}, nil)
+
+	resp, err := server.GetReviews(context.Background(), &prreviewsv1.GetReviewsRequest{
+		UserId:           "u1",
+		Status:           prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_OPEN,
+		AllStatuses:      true,
+		IncludeReviewers: true,
+		IncludeAge:       true,
+		Order:            prreviewsv1.SortOrder_SORT_ORDER_ASC,
+		Limit:            10,
+		Cursor:           "c",
+	})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetPullRequests(), 1)
+	assert.Equal(t, []string{"u1", "u3"}, resp.GetPullRequests()[0].GetAssignedReviewers())
+	assert.Equal(t, int64(120), resp.GetPullRequests()[0].GetAgeSeconds())
+	userUC.AssertExpectations(t)
+}
+
+func TestGetReviews_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *prreviewsv1.GetReviewsRequest
+	}{
+		{name: "missing user_id", req: &prreviewsv1.GetReviewsRequest{}},
+		{name: "limit too large", req: &prreviewsv1.GetReviewsRequest{UserId: "u1", Limit: 101}},
+		{name: "negative limit", req: &prreviewsv1.GetReviewsRequest{UserId: "u1", Limit: -1}},
+		{name: "unknown status", req: &prreviewsv1.GetReviewsRequest{UserId: "u1", Status: 42}},
+		{name: "unknown order", req: &prreviewsv1.GetReviewsRequest{UserId: "u1", Order: 42}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userUC := new(mockUserUseCase)
+			server := NewUserServer(userUC, logger.New("error"))
+
+			_, err := server.GetReviews(context.Background(), tt.req)
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			userUC.AssertNotCalled(t, "GetUserReviews", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestGetAuthored_Success(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	outcome := entity.ReviewOutcomeApproved
+	query := entity.AuthoredQuery{Status: entity.PullRequestStatusMerged, Page: entity.PageRequest{Limit: 5}}
+	userUC.On("GetAuthoredPRs", mock.Anything, "u1", query).Return(entity.AuthoredPage{
+		PullRequests: []entity.AuthoredPullRequest{
+			{
+				PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", AuthorID: "u1", Status: entity.PullRequestStatusMerged},
+				ReviewStatus:     entity.ReviewStatusMerged,
+				Reviewers: []entity.ReviewerAssignment{
+					{UserID: "u2", Username: "Bob", IsActive: true, ReviewOutcome: &outcome},
+					{UserID: "u3", Username: "Carol", IsActive: true},
+				},
+			},
+		},
+	}, nil)
+
+	resp, err := server.GetAuthored(context.Background(), &prreviewsv1.GetAuthoredRequest{
+		UserId: "u1",
+		Status: prreviewsv1.PullRequestStatus_PULL_REQUEST_STATUS_MERGED,
+		Limit:  5,
+	})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetPullRequests(), 1)
+	pr := resp.GetPullRequests()[0]
+	assert.Equal(t, prreviewsv1.ReviewStatus_REVIEW_STATUS_MERGED, pr.GetReviewStatus())
+	require.Len(t, pr.GetReviewers(), 2)
+	assert.Equal(t, prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_APPROVED, pr.GetReviewers()[0].GetReviewOutcome())
+	assert.Equal(t, prreviewsv1.ReviewOutcome_REVIEW_OUTCOME_UNSPECIFIED, pr.GetReviewers()[1].GetReviewOutcome())
+	userUC.AssertExpectations(t)
+}
+
+func TestGetAuthored_UserNotFound(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	userUC.On("GetAuthoredPRs", mock.Anything, "missing", entity.AuthoredQuery{}).Return(nil, entity.ErrNotFound)
+
+	_, err := server.GetAuthored(context.Background(), &prreviewsv1.GetAuthoredRequest{UserId: "missing"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestListUsers_IsActiveFilter(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	isActive := false
+	filter := entity.UserFilter{TeamName: "backend", IsActive: &isActive}
+	userUC.On("ListUsers", mock.Anything, filter, entity.PageRequest{}).Return(entity.UserPage{
+		Users: []entity.User{{UserID: "u1", Username: "Alice", TeamName: "backend"}},
+	}, nil)
+
+	resp, err := server.ListUsers(context.Background(), &prreviewsv1.ListUsersRequest{TeamName: "backend", IsActive: &isActive})
+
+	assert.NoError(t, err)
+	require.Len(t, resp.GetUsers(), 1)
+	assert.Equal(t, "u1", resp.GetUsers()[0].GetUserId())
+	userUC.AssertExpectations(t)
+}
+
+func TestSetDigestSettings_InvalidEmail(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	server := NewUserServer(userUC, logger.New("error"))
+
+	_, err := server.SetDigestSettings(context.Background(), &prreviewsv1.SetDigestSettingsRequest{
+		UserId: "u1",
+		Email:  "Alice <alice@example.com>",
+	})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	userUC.AssertNotCalled(t, "SetDigestSettings", mock.Anything, mock.Anything)
+}
+
+func TestSetIsActive_ChangesOnlySelfUnlessManager(t *testing.T) {
+	tests := []struct {
+		name     string
+		signedIn string
+		role     entity.Role
+		code     codes.Code
+	}{
+		{name: "self", signedIn: "u2", role: entity.RoleMember, code: codes.OK},
+		{name: "member changes someone else", signedIn: "u3", role: entity.RoleMember, code: codes.PermissionDenied},
+		{name: "bot changes someone else", signedIn: "u9", role: entity.RoleBot, code: codes.PermissionDenied},
+		{name: "team lead changes someone else", signedIn: "u1", role: entity.RoleTeamLead, code: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userUC := new(mockUserUseCase)
+			if tt.code == codes.OK {
+				userUC.On("SetIsActive", mock.Anything, "u2", false).Return(entity.User{UserID: "u2"}, nil)
+			}
+
+			client := prreviewsv1.NewUserServiceClient(newTestClient(t, new(mockTeamUseCase), userUC, new(mockPullRequestUseCase), signedInAs(tt.signedIn, tt.role)))
+
+			_, err := client.SetIsActive(withToken(), &prreviewsv1.SetIsActiveRequest{UserId: "u2", IsActive: false})
+
+			assert.Equal(t, tt.code, status.Code(err))
+			userUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSetDigestSettings_MemberChangesSomeoneElse(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	client := prreviewsv1.NewUserServiceClient(newTestClient(t, new(mockTeamUseCase), userUC, new(mockPullRequestUseCase), signedInAs("u3", entity.RoleMember)))
+
+	_, err := client.SetDigestSettings(withToken(), &prreviewsv1.SetDigestSettingsRequest{UserId: "u2", DigestEnabled: true})
+
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	userUC.AssertNotCalled(t, "SetDigestSettings", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/utils"
+)
+
+const (
+	_requestIDLocal  = "request_id"
+	_maxRequestIDLen = 128
+)
+
+// RequestID assigns every request an ID and returns it in the X-Request-ID response header. An incoming
+// X-Request-ID of up to 128 printable ASCII characters is kept, so that calls can be traced across services.
+func RequestID() func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		id := ctx.Get(fiber.HeaderXRequestID)
+		if !validRequestID(id) {
+			id = utils.UUIDv4()
+		}
+
+		ctx.Locals(_requestIDLocal, id)
+		ctx.Set(fiber.HeaderXRequestID, id)
+
+		return ctx.Next()
+	}
+}
+
+// RequestIDFromContext returns the ID assigned to the request by RequestID
+func RequestIDFromContext(ctx *fiber.Ctx) string {
+	id, _ := ctx.Locals(_requestIDLocal).(string)
+
+	return id
+}
+
+// AuditMeta attaches the caller and the request ID to the request context, where repositories pick them up
+// when they record changes in the audit log. It must come after RequestID and Auth.
+func AuditMeta() func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		meta := entity.AuditMeta{RequestID: RequestIDFromContext(ctx)}
+		if principal, ok := PrincipalFromContext(ctx); ok {
+			meta.Actor = principal.Actor()
+		}
+
+		// Handlers pass either the fasthttp request context or the user context to use cases
+		ctx.Context().SetUserValue(entity.AuditMetaKey{}, meta)
+		ctx.SetUserContext(entity.WithAuditMeta(ctx.UserContext(), meta))
+
+		return ctx.Next()
+	}
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > _maxRequestIDLen {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		if id[i] < 0x21 || id[i] > 0x7e {
+			return false
+		}
+	}
+
+	return true
+}
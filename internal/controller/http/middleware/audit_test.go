@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newAuditTestApp() *fiber.App {
+	app := fiber.New()
+	app.Use(RequestID())
+	app.Use(Auth(stubAuthenticator{
+		"prk_write": {KeyID: "key-write", Scopes: []entity.APIKeyScope{entity.APIKeyScopeWrite}},
+		"jwt_u1":    {UserID: "u1", Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite}},
+	}, AuthConfig{PublicPaths: []string{"/public"}}, logger.New("error")))
+	app.Use(AuditMeta())
+
+	// Reports the audit metadata as seen by a use case called with the request context
+	handler := func(ctx *fiber.Ctx) error {
+		var c context.Context = ctx.Context()
+		meta := entity.AuditMetaFromContext(c)
+		return ctx.SendString(meta.Actor + "|" + meta.RequestID)
+	}
+	app.Post("/change", handler)
+	app.Post("/public", handler)
+
+	return app
+}
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name     string
+		incoming string
+		keep     bool
+	}{
+		{name: "generated when missing"},
+		{name: "incoming kept", incoming: "req-42", keep: true},
+		{name: "too long replaced", incoming: strings.Repeat("a", 129)},
+		{name: "non-printable replaced", incoming: "req 42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/public", nil)
+			if tt.incoming != "" {
+				req.Header.Set("X-Request-ID", tt.incoming)
+			}
+
+			resp, err := newAuditTestApp().Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			id := resp.Header.Get("X-Request-ID")
+			if tt.keep {
+				assert.Equal(t, tt.incoming, id)
+			} else {
+				assert.NotEmpty(t, id)
+				assert.NotEqual(t, tt.incoming, id)
+			}
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, "|"+id, string(body))
+		})
+	}
+}
+
+func TestAuditMeta(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected string
+	}{
+		{name: "user", token: "jwt_u1", expected: "u1|req-1"},
+		{name: "API key", token: "prk_write", expected: "apikey:key-write|req-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/change", nil)
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			req.Header.Set("X-Request-ID", "req-1")
+
+			resp, err := newAuditTestApp().Test(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, tt.expected, string(body))
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/response"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+const _principalLocal = "principal"
+
+// Authenticator resolves a bearer token (an API key or an identity provider JWT) to the caller.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (entity.Principal, error)
+}
+
+// AuthConfig lists the paths that are treated differently by Auth.
+type AuthConfig struct {
+	// PublicPaths are served without a token
+	PublicPaths []string
+	// ReadPaths only need the read scope although they are called with POST
+	ReadPaths []string
+}
+
+// Auth rejects requests without a valid "Authorization: Bearer" token. GET and HEAD requests and the read
+// paths need the read scope, every other request the write scope; the caller is kept for RequireScope and handlers.
+func Auth(auth Authenticator, cfg AuthConfig, l Logger) func(c *fiber.Ctx) error {
+	public := pathSet(cfg.PublicPaths)
+	readOnly := pathSet(cfg.ReadPaths)
+
+	return func(ctx *fiber.Ctx) error {
+		path := ctx.Path()
+		if public[path] {
+			return ctx.Next()
+		}
+
+		token, ok := bearerToken(ctx.Get(fiber.HeaderAuthorization))
+		if !ok {
+			return unauthorized(ctx)
+		}
+
+		principal, err := auth.Authenticate(ctx.UserContext(), token)
+		if errors.Is(err, entity.ErrUnauthorized) {
+			return unauthorized(ctx)
+		}
+
+		if err != nil {
+			l.Error(err, "middleware - Auth - Authenticate")
+
+			return ctx.Status(fiber.StatusInternalServerError).
+				JSON(response.NewErrorResponse(entity.ErrorCodeInternal, "internal server error"))
+		}
+
+		scope := entity.APIKeyScopeWrite
+		if ctx.Method() == fiber.MethodGet || ctx.Method() == fiber.MethodHead || readOnly[path] {
+			scope = entity.APIKeyScopeRead
+		}
+
+		if !principal.HasScope(scope) {
+			return forbidden(ctx)
+		}
+
+		ctx.Locals(_principalLocal, principal)
+
+		return ctx.Next()
+	}
+}
+
+// RequireScope rejects requests whose caller lacks the scope. Unauthenticated requests pass,
+// so that the routes stay usable when authentication is disabled.
+func RequireScope(scope entity.APIKeyScope) func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		principal, ok := PrincipalFromContext(ctx)
+		if ok && !principal.HasScope(scope) {
+			return forbidden(ctx)
+		}
+
+		return ctx.Next()
+	}
+}
+
+// RequireRole rejects requests whose caller has none of the roles. Unauthenticated requests pass,
+// so that the routes stay usable when authentication is disabled.
+func RequireRole(roles ...entity.Role) func(c *fiber.Ctx) error {
+	return func(ctx *fiber.Ctx) error {
+		principal, ok := PrincipalFromContext(ctx)
+		if ok && !slices.Contains(roles, principal.Role) {
+			return forbiddenRole(ctx)
+		}
+
+		return ctx.Next()
+	}
+}
+
+// PrincipalFromContext returns the caller the request was authenticated as.
+func PrincipalFromContext(ctx *fiber.Ctx) (entity.Principal, bool) {
+	principal, ok := ctx.Locals(_principalLocal).(entity.Principal)
+
+	return principal, ok
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
+
+func unauthorized(ctx *fiber.Ctx) error {
+	ctx.Set(fiber.HeaderWWWAuthenticate, "Bearer")
+
+	return ctx.Status(fiber.StatusUnauthorized).
+		JSON(response.NewErrorResponse(entity.ErrorCodeUnauthorized, entity.ErrUnauthorized.Error()))
+}
+
+func forbidden(ctx *fiber.Ctx) error {
+	return ctx.Status(fiber.StatusForbidden).
+		JSON(response.NewErrorResponse(entity.ErrorCodeForbidden, entity.ErrForbidden.Error()))
+}
+
+func forbiddenRole(ctx *fiber.Ctx) error {
+	return ctx.Status(fiber.StatusForbidden).
+		JSON(response.NewErrorResponse(entity.ErrorCodeForbidden, entity.ErrInsufficientRole.Error()))
+}
+
+func pathSet(paths []string) map[string]bool {
+	set := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		set[strings.TrimSpace(path)] = true
+	}
+
+	return set
+}
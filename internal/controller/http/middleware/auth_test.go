@@ -0,0 +1,185 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/response"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubAuthenticator map[string]entity.Principal
+
+func (s stubAuthenticator) Authenticate(_ context.Context, token string) (entity.Principal, error) {
+	if token == "prk_broken" {
+		return entity.Principal{}, errors.New("connection refused")
+	}
+
+	principal, ok := s[token]
+	if !ok {
+		return entity.Principal{}, entity.ErrUnauthorized
+	}
+
+	return principal, nil
+}
+
+func newAuthTestApp() *fiber.App {
+	auth := stubAuthenticator{
+		"prk_read":  {KeyID: "key-read", Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead}},
+		"prk_write": {KeyID: "key-write", Scopes: []entity.APIKeyScope{entity.APIKeyScopeWrite}},
+		"prk_admin": {KeyID: "key-admin", Scopes: []entity.APIKeyScope{entity.APIKeyScopeAdmin}},
+		"jwt_u1":    {UserID: "u1", Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite}},
+	}
+
+	app := fiber.New()
+	app.Use(Auth(auth, AuthConfig{
+		PublicPaths: []string{"/healthz"},
+		ReadPaths:   []string{"/graphql"},
+	}, logger.New("error")))
+
+	ok := func(ctx *fiber.Ctx) error { return ctx.SendStatus(http.StatusOK) }
+	app.Get("/healthz", ok)
+	app.Get("/team/get", ok)
+	app.Post("/team/add", ok)
+	app.Post("/graphql", ok)
+	app.Post("/apikeys/create", RequireScope(entity.APIKeyScopeAdmin), ok)
+	app.Get("/whoami", func(ctx *fiber.Ctx) error {
+		principal, _ := PrincipalFromContext(ctx)
+		return ctx.SendString(principal.UserID)
+	})
+
+	return app
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		authorization string
+		expected      int
+	}{
+		{name: "public path without key", method: "GET", path: "/healthz", expected: http.StatusOK},
+		{name: "missing header", method: "GET", path: "/team/get", expected: http.StatusUnauthorized},
+		{name: "not a bearer token", method: "GET", path: "/team/get", authorization: "Basic cHJrX3JlYWQ=", expected: http.StatusUnauthorized},
+		{name: "unknown key", method: "GET", path: "/team/get", authorization: "Bearer prk_unknown", expected: http.StatusUnauthorized},
+		{name: "read key on GET", method: "GET", path: "/team/get", authorization: "Bearer prk_read", expected: http.StatusOK},
+		{name: "read key on POST", method: "POST", path: "/team/add", authorization: "Bearer prk_read", expected: http.StatusForbidden},
+		{name: "read key on read path", method: "POST", path: "/graphql", authorization: "Bearer prk_read", expected: http.StatusOK},
+		{name: "write key on POST", method: "POST", path: "/team/add", authorization: "bearer prk_write", expected: http.StatusOK},
+		{name: "write key on admin route", method: "POST", path: "/apikeys/create", authorization: "Bearer prk_write", expected: http.StatusForbidden},
+		{name: "admin key on admin route", method: "POST", path: "/apikeys/create", authorization: "Bearer prk_admin", expected: http.StatusOK},
+		{name: "user token on POST", method: "POST", path: "/team/add", authorization: "Bearer jwt_u1", expected: http.StatusOK},
+		{name: "user token on admin route", method: "POST", path: "/apikeys/create", authorization: "Bearer jwt_u1", expected: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := newAuthTestApp()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+
+			if tt.expected == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", resp.Header.Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestAuth_InternalError(t *testing.T) {
+	app := newAuthTestApp()
+
+	req := httptest.NewRequest("GET", "/team/get", nil)
+	req.Header.Set("Authorization", "Bearer prk_broken")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+
+	var body response.ErrorResponse
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, entity.ErrorCodeInternal, body.Error.Code)
+	assert.Equal(t, "internal server error", body.Error.Message)
+}
+
+func TestRequireScope_PassesWithoutAuthentication(t *testing.T) {
+	app := fiber.New()
+	app.Post("/apikeys/create", RequireScope(entity.APIKeyScopeAdmin), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(http.StatusOK)
+	})
+
+	resp, err := app.Test(httptest.NewRequest("POST", "/apikeys/create", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAuth_KeepsPrincipal(t *testing.T) {
+	app := newAuthTestApp()
+
+	req := httptest.NewRequest("GET", "/whoami", nil)
+	req.Header.Set("Authorization", "Bearer jwt_u1")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", string(body))
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *entity.Principal
+		expected  int
+	}{
+		{name: "not authenticated", expected: http.StatusOK},
+		{name: "team lead", principal: &entity.Principal{UserID: "u1", Role: entity.RoleTeamLead}, expected: http.StatusOK},
+		{name: "admin key", principal: &entity.Principal{KeyID: "key-1", Role: entity.RoleAdmin}, expected: http.StatusOK},
+		{name: "member", principal: &entity.Principal{UserID: "u2", Role: entity.RoleMember}, expected: http.StatusForbidden},
+		{name: "bot", principal: &entity.Principal{KeyID: "key-2", Role: entity.RoleBot}, expected: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			if tt.principal != nil {
+				app.Use(func(ctx *fiber.Ctx) error {
+					ctx.Locals(_principalLocal, *tt.principal)
+					return ctx.Next()
+				})
+			}
+
+			app.Post("/team/add", RequireRole(entity.RoleAdmin, entity.RoleTeamLead), func(ctx *fiber.Ctx) error {
+				return ctx.SendStatus(http.StatusOK)
+			})
+
+			resp, err := app.Test(httptest.NewRequest("POST", "/team/add", nil))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+
+			if tt.expected == http.StatusForbidden {
+				var body response.ErrorResponse
+				assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+				assert.Equal(t, entity.ErrorCodeForbidden, body.Error.Code)
+				assert.Equal(t, entity.ErrInsufficientRole.Error(), body.Error.Message)
+			}
+		})
+	}
+}
@@ -3,33 +3,56 @@ package http
 
 import (
 	"net/http"
+	"slices"
 
 	"github.com/ansrivas/fiberprometheus/v2"
 	"github.com/finstape/pr-reviews/config"
+	"github.com/finstape/pr-reviews/internal/controller/graphql"
 	"github.com/finstape/pr-reviews/internal/controller/http/middleware"
 	v1 "github.com/finstape/pr-reviews/internal/controller/http/v1"
+	"github.com/finstape/pr-reviews/internal/controller/metrics"
 	"github.com/finstape/pr-reviews/internal/usecase"
 	"github.com/finstape/pr-reviews/pkg/logger"
 	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 // NewRouter -.
-func NewRouter(app *fiber.App, cfg *config.Config, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, l logger.Interface) {
+func NewRouter(app *fiber.App, cfg *config.Config, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, statsUseCase usecase.Stats, slaUseCase usecase.SLA, webhookUseCase usecase.Webhook, integrationUseCase usecase.Integration, streamUseCase usecase.Stream, lookupUseCase usecase.Lookup, apiKeyUseCase usecase.APIKey, authUseCase usecase.Auth, auditUseCase usecase.Audit, l logger.Interface) {
 	// Options
+	app.Use(middleware.RequestID())
 	app.Use(middleware.LoggerMiddleware(l))
 	app.Use(middleware.Recovery(l))
 
+	// Bearer token authentication (API keys and OIDC JWTs); it comes before every route so that /healthz and /metrics are only public when listed
+	if cfg.Auth.Enabled {
+		app.Use(middleware.Auth(authUseCase, middleware.AuthConfig{
+			// Code host webhooks are verified by their own signatures
+			PublicPaths: slices.Concat(cfg.Auth.PublicPaths, []string{"/integrations/github/webhook", "/integrations/gitlab/webhook"}),
+			// GraphQL only serves queries
+			ReadPaths: []string{"/graphql"},
+		}, l))
+	}
+
+	// Caller and request ID of changes recorded in the audit log
+	app.Use(middleware.AuditMeta())
+
 	// Prometheus metrics
 	if cfg.Metrics.Enabled {
-		prometheus := fiberprometheus.New("pr-review-service")
-		prometheus.RegisterAt(app, "/metrics")
-		app.Use(prometheus.Middleware)
+		registry := prometheus.NewRegistry()
+		registry.MustRegister(metrics.NewOverdueCollector(slaUseCase, l))
+
+		prom := fiberprometheus.NewWithRegistry(registry, "pr-review-service", "http", "", nil)
+		prom.RegisterAt(app, "/metrics")
+		app.Use(prom.Middleware)
 	}
 
 	// K8s probe
 	app.Get("/healthz", func(ctx *fiber.Ctx) error { return ctx.SendStatus(http.StatusOK) })
 
 	// API routes
-	v1.NewRouter(app, teamUseCase, userUseCase, pullRequestUseCase, l)
-}
+	v1.NewRouter(app, teamUseCase, userUseCase, pullRequestUseCase, statsUseCase, slaUseCase, webhookUseCase, integrationUseCase, streamUseCase, apiKeyUseCase, auditUseCase, l)
 
+	// GraphQL
+	graphql.NewRouter(app, teamUseCase, pullRequestUseCase, lookupUseCase, l, cfg.GraphQL.MaxDepth)
+}
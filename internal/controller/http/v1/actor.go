@@ -0,0 +1,44 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/middleware"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+var errActorRequired = errors.New("acting user is required")
+
+// resolveActor returns the user a request acts as. Callers signed in as a user act as themselves unless they name
+// another user, which members may not do; other callers (API keys, disabled authentication) must name the user.
+func resolveActor(c *fiber.Ctx, requested string) (string, error) {
+	principal, ok := middleware.PrincipalFromContext(c)
+	if ok && principal.UserID != "" {
+		if requested == "" || requested == principal.UserID {
+			return principal.UserID, nil
+		}
+
+		if !principal.Role.CanActForOthers() {
+			return "", entity.ErrInsufficientRole
+		}
+
+		return requested, nil
+	}
+
+	if requested == "" {
+		return "", errActorRequired
+	}
+
+	return requested, nil
+}
+
+// authorizeUserChange allows changing the user to the user themselves and to team leads and admins
+func authorizeUserChange(c *fiber.Ctx, userID string) error {
+	principal, ok := middleware.PrincipalFromContext(c)
+	if !ok || principal.Role.CanManage() || (principal.UserID != "" && principal.UserID == userID) {
+		return nil
+	}
+
+	return entity.ErrInsufficientRole
+}
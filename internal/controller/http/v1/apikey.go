@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// createAPIKey - POST /apikeys/create
+func (v *V1) createAPIKey(c *fiber.Ctx) error {
+	var req request.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	scopes := make([]entity.APIKeyScope, 0, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		scopes = append(scopes, entity.APIKeyScope(scope))
+	}
+
+	key, err := v.apiKeyUseCase.CreateAPIKey(c.Context(), req.Name, scopes, parseOptionalTime(req.ExpiresAt))
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"api_key": key,
+	})
+}
+
+// listAPIKeys - GET /apikeys/list
+func (v *V1) listAPIKeys(c *fiber.Ctx) error {
+	keys, err := v.apiKeyUseCase.ListAPIKeys(c.Context())
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"api_keys": keys,
+	})
+}
+
+// revokeAPIKey - POST /apikeys/revoke
+func (v *V1) revokeAPIKey(c *fiber.Ctx) error {
+	var req request.RevokeAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	err := v.apiKeyUseCase.RevokeAPIKey(c.Context(), req.KeyID)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"key_id": req.KeyID,
+	})
+}
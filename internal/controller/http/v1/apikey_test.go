@@ -0,0 +1,146 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyUseCase) CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (entity.APIKey, error) {
+	args := m.Called(ctx, name, scopes, expiresAt)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyUseCase) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyUseCase) RevokeAPIKey(ctx context.Context, keyID string) error {
+	args := m.Called(ctx, keyID)
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyUseCase) Authenticate(ctx context.Context, token string) (entity.APIKey, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+var _ usecase.APIKey = (*mockAPIKeyUseCase)(nil)
+
+func TestCreateAPIKeyHandler_Success(t *testing.T) {
+	app := fiber.New()
+	apiKeyUC := new(mockAPIKeyUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, apiKeyUC, nil, logger.New("error"))
+
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	created := entity.APIKey{
+		KeyID:       "key-1",
+		Name:        "ci",
+		Token:       "prk_secret",
+		TokenPrefix: "prk_secr",
+		Scopes:      []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite},
+		ExpiresAt:   &expiresAt,
+	}
+
+	apiKeyUC.On("CreateAPIKey", mock.Anything, "ci", created.Scopes, mock.MatchedBy(func(t *time.Time) bool {
+		return t != nil && t.Equal(expiresAt)
+	})).Return(created, nil)
+
+	app.Post("/apikeys/create", v1.createAPIKey)
+
+	req := httptest.NewRequest("POST", "/apikeys/create", strings.NewReader(`{"name":"ci","scopes":["read","write"],"expires_at":"2027-01-01T00:00:00Z"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		APIKey entity.APIKey `json:"api_key"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "prk_secret", body.APIKey.Token)
+	assert.Empty(t, body.APIKey.TokenHash)
+
+	apiKeyUC.AssertExpectations(t)
+}
+
+func TestCreateAPIKeyHandler_InvalidBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing name", body: `{"scopes":["read"]}`},
+		{name: "missing scopes", body: `{"name":"ci"}`},
+		{name: "unknown scope", body: `{"name":"ci","scopes":["root"]}`},
+		{name: "bad expiry", body: `{"name":"ci","scopes":["read"],"expires_at":"tomorrow"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			apiKeyUC := new(mockAPIKeyUseCase)
+
+			v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, apiKeyUC, nil, logger.New("error"))
+
+			app.Post("/apikeys/create", v1.createAPIKey)
+
+			req := httptest.NewRequest("POST", "/apikeys/create", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			apiKeyUC.AssertNotCalled(t, "CreateAPIKey")
+		})
+	}
+}
+
+func TestCreateAPIKeyHandler_ExpiryInPast(t *testing.T) {
+	app := fiber.New()
+	apiKeyUC := new(mockAPIKeyUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, apiKeyUC, nil, logger.New("error"))
+
+	apiKeyUC.On("CreateAPIKey", mock.Anything, "ci", mock.Anything, mock.Anything).Return(entity.APIKey{}, entity.ErrInvalidExpiry)
+
+	app.Post("/apikeys/create", v1.createAPIKey)
+
+	req := httptest.NewRequest("POST", "/apikeys/create", strings.NewReader(`{"name":"ci","scopes":["read"],"expires_at":"2020-01-01T00:00:00Z"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestRevokeAPIKeyHandler_NotFound(t *testing.T) {
+	app := fiber.New()
+	apiKeyUC := new(mockAPIKeyUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, apiKeyUC, nil, logger.New("error"))
+
+	apiKeyUC.On("RevokeAPIKey", mock.Anything, "key-404").Return(entity.ErrNotFound)
+
+	app.Post("/apikeys/revoke", v1.revokeAPIKey)
+
+	req := httptest.NewRequest("POST", "/apikeys/revoke", strings.NewReader(`{"key_id":"key-404"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
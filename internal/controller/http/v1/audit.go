@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// listAuditEntries - GET /audit
+func (v *V1) listAuditEntries(c *fiber.Ctx) error {
+	var req request.ListAuditEntriesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	filter := entity.AuditFilter{
+		Actor:      req.Actor,
+		Action:     entity.AuditAction(req.Action),
+		EntityType: entity.AuditEntityType(req.EntityType),
+		EntityID:   req.EntityID,
+		RequestID:  req.RequestID,
+		From:       parseOptionalTime(req.From),
+		To:         parseOptionalTime(req.To),
+	}
+
+	page, err := v.auditUseCase.ListEntries(c.Context(), filter, entity.PageRequest{Limit: req.Limit, Cursor: req.Cursor})
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(page)
+}
@@ -0,0 +1,112 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAuditUseCase struct {
+	mock.Mock
+}
+
+func (m *mockAuditUseCase) ListEntries(ctx context.Context, filter entity.AuditFilter, page entity.PageRequest) (entity.AuditPage, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).(entity.AuditPage), args.Error(1)
+}
+
+var _ usecase.Audit = (*mockAuditUseCase)(nil)
+
+func TestListAuditEntriesHandler_Success(t *testing.T) {
+	app := fiber.New()
+	auditUC := new(mockAuditUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, auditUC, logger.New("error"))
+
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	auditUC.On("ListEntries", mock.Anything, mock.MatchedBy(func(f entity.AuditFilter) bool {
+		return f.Actor == "u1" && f.Action == entity.AuditReviewerReassigned && f.EntityType == entity.AuditEntityPullRequest &&
+			f.EntityID == "pr-1" && f.From != nil && f.From.Equal(from) && f.To == nil
+	}), entity.PageRequest{Limit: 10, Cursor: "abc"}).Return(entity.AuditPage{
+		Entries: []entity.AuditEntry{{
+			AuditID:    7,
+			Action:     entity.AuditReviewerReassigned,
+			EntityType: entity.AuditEntityPullRequest,
+			EntityID:   "pr-1",
+			Actor:      "u1",
+			RequestID:  "req-1",
+			Before:     json.RawMessage(`{"reviewer_id":"u2"}`),
+			After:      json.RawMessage(`{"reviewer_id":"u3"}`),
+		}},
+		NextCursor: "next",
+	}, nil)
+
+	app.Get("/audit", v1.listAuditEntries)
+
+	req := httptest.NewRequest("GET", "/audit?actor=u1&action=pull_request.reviewer_reassigned&entity_type=pull_request&entity_id=pr-1&from=2026-10-01T00:00:00Z&limit=10&cursor=abc", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var page entity.AuditPage
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&page))
+	assert.Len(t, page.Entries, 1)
+	assert.JSONEq(t, `{"reviewer_id":"u2"}`, string(page.Entries[0].Before))
+	assert.Equal(t, "next", page.NextCursor)
+
+	auditUC.AssertExpectations(t)
+}
+
+func TestListAuditEntriesHandler_InvalidQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown action", query: "action=pull_request.deleted"},
+		{name: "unknown entity type", query: "entity_type=outbox_event"},
+		{name: "bad from", query: "from=yesterday"},
+		{name: "limit too large", query: "limit=1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			auditUC := new(mockAuditUseCase)
+
+			v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, auditUC, logger.New("error"))
+
+			app.Get("/audit", v1.listAuditEntries)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/audit?"+tt.query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			auditUC.AssertNotCalled(t, "ListEntries")
+		})
+	}
+}
+
+func TestListAuditEntriesHandler_InvalidCursor(t *testing.T) {
+	app := fiber.New()
+	auditUC := new(mockAuditUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, nil, nil, auditUC, logger.New("error"))
+
+	auditUC.On("ListEntries", mock.Anything, entity.AuditFilter{}, entity.PageRequest{Cursor: "bogus"}).
+		Return(entity.AuditPage{}, entity.ErrInvalidCursor)
+
+	app.Get("/audit", v1.listAuditEntries)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/audit?cursor=bogus", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/finstape/pr-reviews/internal/controller/http/v1/response"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/internal/usecase"
@@ -14,16 +16,30 @@ type V1 struct {
 	teamUseCase        usecase.Team
 	userUseCase        usecase.User
 	pullRequestUseCase usecase.PullRequest
+	statsUseCase       usecase.Stats
+	slaUseCase         usecase.SLA
+	webhookUseCase     usecase.Webhook
+	integrationUseCase usecase.Integration
+	streamUseCase      usecase.Stream
+	apiKeyUseCase      usecase.APIKey
+	auditUseCase       usecase.Audit
 	l                  logger.Interface
 	v                  *validator.Validate
 }
 
 // New creates a new V1 controller instance.
-func New(teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, l logger.Interface) *V1 {
+func New(teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, statsUseCase usecase.Stats, slaUseCase usecase.SLA, webhookUseCase usecase.Webhook, integrationUseCase usecase.Integration, streamUseCase usecase.Stream, apiKeyUseCase usecase.APIKey, auditUseCase usecase.Audit, l logger.Interface) *V1 {
 	return &V1{
 		teamUseCase:        teamUseCase,
 		userUseCase:        userUseCase,
 		pullRequestUseCase: pullRequestUseCase,
+		statsUseCase:       statsUseCase,
+		slaUseCase:         slaUseCase,
+		webhookUseCase:     webhookUseCase,
+		integrationUseCase: integrationUseCase,
+		streamUseCase:      streamUseCase,
+		apiKeyUseCase:      apiKeyUseCase,
+		auditUseCase:       auditUseCase,
 		l:                  l,
 		v:                  validator.New(validator.WithRequiredStructEnabled()),
 	}
@@ -32,16 +48,22 @@ func New(teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase
 // handleError handles domain errors and returns appropriate HTTP response
 func (v *V1) handleError(c *fiber.Ctx, err error) error {
 	code := entity.GetErrorCode(err)
-	message := err.Error()
+	message := entity.GetErrorMessage(err)
 
 	var statusCode int
 	switch code {
 	case entity.ErrorCodeTeamExists, entity.ErrorCodePRExists:
 		statusCode = fiber.StatusConflict
-	case entity.ErrorCodePRMerged, entity.ErrorCodeNotAssigned, entity.ErrorCodeNoCandidate:
+	case entity.ErrorCodePRMerged, entity.ErrorCodePRClosed, entity.ErrorCodeNotAssigned, entity.ErrorCodeNoCandidate:
 		statusCode = fiber.StatusConflict
 	case entity.ErrorCodeNotFound:
 		statusCode = fiber.StatusNotFound
+	case entity.ErrorCodeInvalidCursor, entity.ErrorCodeInvalidWindow, entity.ErrorCodeInvalidPayload, entity.ErrorCodeInvalidExpiry:
+		statusCode = fiber.StatusBadRequest
+	case entity.ErrorCodeInvalidSignature, entity.ErrorCodeUnauthorized:
+		statusCode = fiber.StatusUnauthorized
+	case entity.ErrorCodeForbidden:
+		statusCode = fiber.StatusForbidden
 	default:
 		statusCode = fiber.StatusInternalServerError
 		// Don't expose internal error details
@@ -52,3 +74,19 @@ func (v *V1) handleError(c *fiber.Ctx, err error) error {
 	return c.Status(statusCode).JSON(response.NewErrorResponse(code, message))
 }
 
+// parseOptionalTime parses an RFC 3339 timestamp that has already passed validation, returning nil for an empty string.
+// The time is converted to UTC, since TIMESTAMP columns store UTC and pgx drops the offset.
+func parseOptionalTime(s string) *time.Time {
+	if s == "" {
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil
+	}
+
+	utc := t.UTC()
+
+	return &utc
+}
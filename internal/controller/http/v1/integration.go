@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// handleGitHubWebhook - POST /integrations/github/webhook
+func (v *V1) handleGitHubWebhook(c *fiber.Ctx) error {
+	result, err := v.integrationUseCase.HandleGitHubWebhook(
+		c.Context(),
+		c.Get("X-GitHub-Event"),
+		c.Get("X-Hub-Signature-256"),
+		c.Body(),
+	)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"result": result,
+	})
+}
+
+// handleGitLabWebhook - POST /integrations/gitlab/webhook
+func (v *V1) handleGitLabWebhook(c *fiber.Ctx) error {
+	result, err := v.integrationUseCase.HandleGitLabWebhook(
+		c.Context(),
+		c.Get("X-Gitlab-Event"),
+		c.Get("X-Gitlab-Token"),
+		c.Body(),
+	)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"result": result,
+	})
+}
+
+// setIdentity - POST /integrations/identities/set
+func (v *V1) setIdentity(c *fiber.Ctx) error {
+	var req request.SetIdentityRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	identity, err := v.integrationUseCase.SetIdentity(c.Context(), entity.UserIdentity{
+		Provider: entity.IdentityProvider(req.Provider),
+		Login:    req.Login,
+		UserID:   req.UserID,
+	})
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"identity": identity,
+	})
+}
+
+// listIdentities - GET /integrations/identities/list
+func (v *V1) listIdentities(c *fiber.Ctx) error {
+	var req request.ListIdentitiesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	identities, err := v.integrationUseCase.ListIdentities(c.Context(), entity.IdentityProvider(req.Provider))
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"identities": identities,
+	})
+}
+
+// deleteIdentity - POST /integrations/identities/delete
+func (v *V1) deleteIdentity(c *fiber.Ctx) error {
+	var req request.DeleteIdentityRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	err := v.integrationUseCase.DeleteIdentity(c.Context(), entity.IdentityProvider(req.Provider), req.Login)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"provider": req.Provider,
+		"login":    req.Login,
+	})
+}
@@ -0,0 +1,243 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockIntegrationUseCase struct {
+	mock.Mock
+}
+
+func (m *mockIntegrationUseCase) HandleGitHubWebhook(ctx context.Context, event string, signature string, body []byte) (entity.IntegrationResult, error) {
+	args := m.Called(ctx, event, signature, body)
+	return args.Get(0).(entity.IntegrationResult), args.Error(1)
+}
+
+func (m *mockIntegrationUseCase) HandleGitLabWebhook(ctx context.Context, event string, token string, body []byte) (entity.IntegrationResult, error) {
+	args := m.Called(ctx, event, token, body)
+	return args.Get(0).(entity.IntegrationResult), args.Error(1)
+}
+
+func (m *mockIntegrationUseCase) SetIdentity(ctx context.Context, identity entity.UserIdentity) (entity.UserIdentity, error) {
+	args := m.Called(ctx, identity)
+	return args.Get(0).(entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockIntegrationUseCase) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	args := m.Called(ctx, provider)
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockIntegrationUseCase) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	args := m.Called(ctx, provider, login)
+	return args.Error(0)
+}
+
+func TestGitHubWebhookHandler_Success(t *testing.T) {
+	app := fiber.New()
+	integrationUC := new(mockIntegrationUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+	payload := `{"action":"opened"}`
+	result := entity.IntegrationResult{
+		Event:         "pull_request",
+		Action:        "opened",
+		Status:        entity.IntegrationStatusProcessed,
+		PullRequestID: "acme/api#42",
+	}
+
+	integrationUC.On("HandleGitHubWebhook", mock.Anything, "pull_request", "sha256=abc", []byte(payload)).Return(result, nil)
+
+	app.Post("/integrations/github/webhook", v1.handleGitHubWebhook)
+
+	req := httptest.NewRequest("POST", "/integrations/github/webhook", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-GitHub-Event", "pull_request")
+	req.Header.Set("X-Hub-Signature-256", "sha256=abc")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Result entity.IntegrationResult `json:"result"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, result, body.Result)
+
+	integrationUC.AssertExpectations(t)
+}
+
+func TestGitHubWebhookHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "invalid signature", err: entity.ErrInvalidSignature, wantStatus: http.StatusUnauthorized},
+		{name: "invalid payload", err: entity.ErrInvalidPayload, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			integrationUC := new(mockIntegrationUseCase)
+
+			v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+			integrationUC.On("HandleGitHubWebhook", mock.Anything, "pull_request", "", mock.Anything).Return(entity.IntegrationResult{}, tt.err)
+
+			app.Post("/integrations/github/webhook", v1.handleGitHubWebhook)
+
+			req := httptest.NewRequest("POST", "/integrations/github/webhook", strings.NewReader(`{}`))
+			req.Header.Set("X-GitHub-Event", "pull_request")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+		})
+	}
+}
+
+func TestSetIdentityHandler_Success(t *testing.T) {
+	app := fiber.New()
+	integrationUC := new(mockIntegrationUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+	identity := entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "octocat", UserID: "u1"}
+	integrationUC.On("SetIdentity", mock.Anything, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "OctoCat", UserID: "u1"}).Return(identity, nil)
+
+	app.Post("/integrations/identities/set", v1.setIdentity)
+
+	req := httptest.NewRequest("POST", "/integrations/identities/set", strings.NewReader(`{"provider":"github","login":"OctoCat","user_id":"u1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Identity entity.UserIdentity `json:"identity"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, identity, body.Identity)
+
+	integrationUC.AssertExpectations(t)
+}
+
+func TestSetIdentityHandler_UnknownProvider(t *testing.T) {
+	app := fiber.New()
+	integrationUC := new(mockIntegrationUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+	app.Post("/integrations/identities/set", v1.setIdentity)
+
+	req := httptest.NewRequest("POST", "/integrations/identities/set", strings.NewReader(`{"provider":"bitbucket","login":"octocat","user_id":"u1"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	integrationUC.AssertNotCalled(t, "SetIdentity")
+}
+
+func TestDeleteIdentityHandler_NotFound(t *testing.T) {
+	app := fiber.New()
+	integrationUC := new(mockIntegrationUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+	integrationUC.On("DeleteIdentity", mock.Anything, entity.IdentityProviderGitHub, "ghost").Return(entity.ErrNotFound)
+
+	app.Post("/integrations/identities/delete", v1.deleteIdentity)
+
+	req := httptest.NewRequest("POST", "/integrations/identities/delete", strings.NewReader(`{"provider":"github","login":"ghost"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestSetIdentityRoute_AdminOnly(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     entity.Role
+		expected int
+	}{
+		{name: "member maps own token to another user", role: entity.RoleMember, expected: http.StatusForbidden},
+		{name: "bot", role: entity.RoleBot, expected: http.StatusForbidden},
+		{name: "admin", role: entity.RoleAdmin, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			integrationUC := new(mockIntegrationUseCase)
+
+			signedInAs(app, "u2", tt.role)
+			NewRouter(app, nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+			identity := entity.UserIdentity{Provider: entity.IdentityProviderOIDC, Login: "sub-u2", UserID: "u1"}
+			if tt.expected == http.StatusOK {
+				integrationUC.On("SetIdentity", mock.Anything, identity).Return(identity, nil)
+			}
+
+			req := httptest.NewRequest("POST", "/integrations/identities/set", strings.NewReader(`{"provider":"oidc","login":"sub-u2","user_id":"u1"}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer jwt")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+
+			integrationUC.AssertExpectations(t)
+			if tt.expected == http.StatusForbidden {
+				integrationUC.AssertNotCalled(t, "SetIdentity", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestGitLabWebhookHandler_Success(t *testing.T) {
+	app := fiber.New()
+	integrationUC := new(mockIntegrationUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, integrationUC, nil, nil, nil, logger.New("error"))
+
+	payload := `{"object_kind":"merge_request"}`
+	result := entity.IntegrationResult{
+		Event:         "Merge Request Hook",
+		Action:        "merge",
+		Status:        entity.IntegrationStatusProcessed,
+		PullRequestID: "acme/billing!7",
+	}
+
+	integrationUC.On("HandleGitLabWebhook", mock.Anything, "Merge Request Hook", "token", []byte(payload)).Return(result, nil)
+
+	app.Post("/integrations/gitlab/webhook", v1.handleGitLabWebhook)
+
+	req := httptest.NewRequest("POST", "/integrations/gitlab/webhook", strings.NewReader(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Gitlab-Event", "Merge Request Hook")
+	req.Header.Set("X-Gitlab-Token", "token")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Result entity.IntegrationResult `json:"result"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, result, body.Result)
+
+	integrationUC.AssertExpectations(t)
+}
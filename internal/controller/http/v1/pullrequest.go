@@ -1,10 +1,17 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/gofiber/fiber/v2"
 )
 
+var errUnknownExpand = errors.New("unknown expand value")
+
 // createPR - POST /pullRequest/create
 func (v *V1) createPR(c *fiber.Ctx) error {
 	var req request.CreatePRRequest
@@ -26,7 +33,21 @@ func (v *V1) createPR(c *fiber.Ctx) error {
 		})
 	}
 
-	pr, err := v.pullRequestUseCase.CreatePR(c.Context(), req.PullRequestID, req.PullRequestName, req.AuthorID)
+	authorID, err := resolveActor(c, req.AuthorID)
+	if errors.Is(err, errActorRequired) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "author_id is required",
+			},
+		})
+	}
+
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	pr, err := v.pullRequestUseCase.CreatePR(c.Context(), req.PullRequestID, req.PullRequestName, authorID)
 	if err != nil {
 		return v.handleError(c, err)
 	}
@@ -36,6 +57,125 @@ func (v *V1) createPR(c *fiber.Ctx) error {
 	})
 }
 
+// getPR - GET /pullRequest/get
+func (v *V1) getPR(c *fiber.Ctx) error {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "pull_request_id is required",
+			},
+		})
+	}
+
+	expand, err := parsePRExpand(c.Query("expand"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	pr, err := v.pullRequestUseCase.GetPR(c.Context(), prID, expand)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"pr": pr,
+	})
+}
+
+// getPRHistory - GET /pullRequest/history
+func (v *V1) getPRHistory(c *fiber.Ctx) error {
+	prID := c.Query("pull_request_id")
+	if prID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "pull_request_id is required",
+			},
+		})
+	}
+
+	history, err := v.pullRequestUseCase.GetPRHistory(c.Context(), prID)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(history)
+}
+
+// listPRs - GET /pullRequest/list
+func (v *V1) listPRs(c *fiber.Ctx) error {
+	var req request.ListPRsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	filter := entity.PullRequestFilter{
+		Status:       entity.PullRequestStatus(req.Status),
+		AuthorID:     req.AuthorID,
+		ReviewerID:   req.ReviewerID,
+		TeamName:     req.TeamName,
+		NameContains: req.Name,
+		CreatedFrom:  parseOptionalTime(req.CreatedFrom),
+		CreatedTo:    parseOptionalTime(req.CreatedTo),
+		MergedFrom:   parseOptionalTime(req.MergedFrom),
+		MergedTo:     parseOptionalTime(req.MergedTo),
+	}
+
+	page, err := v.pullRequestUseCase.ListPRs(c.Context(), filter, entity.PageRequest{
+		Limit:  req.Limit,
+		Cursor: req.Cursor,
+		Order:  entity.SortOrder(req.Order),
+	})
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(page)
+}
+
+// parsePRExpand parses a comma-separated expand parameter (author, reviewers)
+func parsePRExpand(raw string) (entity.PullRequestExpand, error) {
+	var expand entity.PullRequestExpand
+
+	if raw == "" {
+		return expand, nil
+	}
+
+	for _, item := range strings.Split(raw, ",") {
+		switch strings.TrimSpace(item) {
+		case "author":
+			expand.Author = true
+		case "reviewers":
+			expand.Reviewers = true
+		default:
+			return entity.PullRequestExpand{}, fmt.Errorf("%w: %q", errUnknownExpand, item)
+		}
+	}
+
+	return expand, nil
+}
+
 // mergePR - POST /pullRequest/merge
 func (v *V1) mergePR(c *fiber.Ctx) error {
 	var req request.MergePRRequest
@@ -88,7 +228,21 @@ func (v *V1) reassignReviewer(c *fiber.Ctx) error {
 		})
 	}
 
-	pr, newReviewerID, err := v.pullRequestUseCase.ReassignReviewer(c.Context(), req.PullRequestID, req.OldUserID)
+	oldUserID, err := resolveActor(c, req.OldUserID)
+	if errors.Is(err, errActorRequired) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "old_user_id is required",
+			},
+		})
+	}
+
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	pr, newReviewerID, err := v.pullRequestUseCase.ReassignReviewer(c.Context(), req.PullRequestID, oldUserID)
 	if err != nil {
 		return v.handleError(c, err)
 	}
@@ -99,3 +253,63 @@ func (v *V1) reassignReviewer(c *fiber.Ctx) error {
 	})
 }
 
+// recordReview - POST /pullRequest/review
+func (v *V1) recordReview(c *fiber.Ctx) error {
+	var req request.RecordReviewRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	reviewerID, err := resolveActor(c, req.ReviewerID)
+	if errors.Is(err, errActorRequired) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "reviewer_id is required",
+			},
+		})
+	}
+
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	outcome := entity.ReviewOutcome(req.Outcome)
+
+	err = v.pullRequestUseCase.RecordReview(c.Context(), req.PullRequestID, reviewerID, outcome)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"pull_request_id": req.PullRequestID,
+		"reviewer_id":     reviewerID,
+		"review_outcome":  outcome,
+	})
+}
+
+// getOverdueReviews - GET /pullRequest/overdue
+func (v *V1) getOverdueReviews(c *fiber.Ctx) error {
+	reviews, err := v.slaUseCase.GetOverdueReviews(c.Context(), c.Query("team_name"))
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"overdue": reviews,
+	})
+}
@@ -4,12 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/finstape/pr-reviews/internal/controller/http/middleware"
 	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/response"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/internal/usecase"
 	"github.com/finstape/pr-reviews/pkg/logger"
@@ -35,6 +40,19 @@ func (m *mockTeamUseCaseForPR) GetTeam(ctx context.Context, teamName string) (en
 	return args.Get(0).(entity.Team), args.Error(1)
 }
 
+func (m *mockTeamUseCaseForPR) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamUseCaseForPR) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
 var _ usecase.Team = (*mockTeamUseCaseForPR)(nil)
 
 type mockUserUseCaseForPR struct {
@@ -49,12 +67,38 @@ func (m *mockUserUseCaseForPR) SetIsActive(ctx context.Context, userID string, i
 	return args.Get(0).(entity.User), args.Error(1)
 }
 
-func (m *mockUserUseCaseForPR) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *mockUserUseCaseForPR) GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error) {
+	args := m.Called(ctx, userID, query)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return entity.ReviewQueuePage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.ReviewQueuePage), args.Error(1)
+}
+
+func (m *mockUserUseCaseForPR) GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.AuthoredPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.AuthoredPage), args.Error(1)
+}
+
+func (m *mockUserUseCaseForPR) ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.UserPage{}, args.Error(1)
 	}
-	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+	return args.Get(0).(entity.UserPage), args.Error(1)
+}
+
+func (m *mockUserUseCaseForPR) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error) {
+	args := m.Called(ctx, settings)
+	return args.Get(0).(entity.DigestSettings), args.Error(1)
+}
+
+func (m *mockUserUseCaseForPR) SetRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
 }
 
 var _ usecase.User = (*mockUserUseCaseForPR)(nil)
@@ -71,6 +115,22 @@ func (m *mockPullRequestUseCaseForPR) CreatePR(ctx context.Context, prID string,
 	return args.Get(0).(entity.PullRequest), args.Error(1)
 }
 
+func (m *mockPullRequestUseCaseForPR) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCaseForPR) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
 func (m *mockPullRequestUseCaseForPR) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
 	args := m.Called(ctx, prID)
 	if args.Get(0) == nil {
@@ -87,6 +147,43 @@ func (m *mockPullRequestUseCaseForPR) ReassignReviewer(ctx context.Context, prID
 	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
 }
 
+func (m *mockPullRequestUseCaseForPR) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCaseForPR) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCaseForPR) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCaseForPR) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCaseForPR) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
 var _ usecase.PullRequest = (*mockPullRequestUseCaseForPR)(nil)
 
 func TestCreatePRHandler_Success(t *testing.T) {
@@ -95,7 +192,7 @@ func TestCreatePRHandler_Success(t *testing.T) {
 	userUC := new(mockUserUseCaseForPR)
 	prUC := new(mockPullRequestUseCaseForPR)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	reqBody := request.CreatePRRequest{
 		PullRequestID:   "pr-1",
@@ -133,7 +230,7 @@ func TestMergePRHandler_Success(t *testing.T) {
 	userUC := new(mockUserUseCaseForPR)
 	prUC := new(mockPullRequestUseCaseForPR)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	reqBody := request.MergePRRequest{
 		PullRequestID: "pr-1",
@@ -171,7 +268,7 @@ func TestReassignReviewerHandler_Success(t *testing.T) {
 	userUC := new(mockUserUseCaseForPR)
 	prUC := new(mockPullRequestUseCaseForPR)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	reqBody := request.ReassignReviewerRequest{
 		PullRequestID: "pr-1",
@@ -202,3 +299,429 @@ func TestReassignReviewerHandler_Success(t *testing.T) {
 	prUC.AssertExpectations(t)
 }
 
+func TestGetPRHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	now := time.Now()
+	expectedPR := entity.PullRequestDetail{
+		PullRequest: entity.PullRequest{
+			PullRequestID:     "pr-1",
+			PullRequestName:   "Test PR",
+			AuthorID:          "u1",
+			Status:            entity.PullRequestStatusOpen,
+			AssignedReviewers: []string{"u2"},
+			CreatedAt:         &now,
+		},
+		Author: &entity.User{UserID: "u1", Username: "Author", TeamName: "team1", IsActive: true},
+	}
+
+	req := httptest.NewRequest("GET", "/pullRequest/get?pull_request_id=pr-1&expand=author", nil)
+
+	prUC.On("GetPR", mock.Anything, "pr-1", entity.PullRequestExpand{Author: true}).Return(expectedPR, nil)
+
+	app.Get("/pullRequest/get", v1.getPR)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		PR entity.PullRequestDetail `json:"pr"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "pr-1", body.PR.PullRequestID)
+	assert.Equal(t, "u1", body.PR.Author.UserID)
+
+	prUC.AssertExpectations(t)
+}
+
+func TestGetPRHandler_InvalidExpand(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	req := httptest.NewRequest("GET", "/pullRequest/get?pull_request_id=pr-1&expand=team", nil)
+
+	app.Get("/pullRequest/get", v1.getPR)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	prUC.AssertNotCalled(t, "GetPR")
+}
+
+func TestGetPRHandler_NotFound(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	req := httptest.NewRequest("GET", "/pullRequest/get?pull_request_id=pr-99", nil)
+
+	prUC.On("GetPR", mock.Anything, "pr-99", entity.PullRequestExpand{}).Return(nil, entity.ErrNotFound)
+
+	app.Get("/pullRequest/get", v1.getPR)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestGetPRHandler_WrappedErrors(t *testing.T) {
+	tests := []struct {
+		name            string
+		err             error
+		expectedStatus  int
+		expectedCode    entity.ErrorCode
+		expectedMessage string
+	}{
+		{
+			name:            "not found",
+			err:             fmt.Errorf("PullRequestUseCase - GetPR - GetPR: %w", entity.ErrNotFound),
+			expectedStatus:  http.StatusNotFound,
+			expectedCode:    entity.ErrorCodeNotFound,
+			expectedMessage: entity.ErrNotFound.Error(),
+		},
+		{
+			name:            "internal",
+			err:             fmt.Errorf("PullRequestUseCase - GetPR - GetPR: %w", errors.New("connection refused")),
+			expectedStatus:  http.StatusInternalServerError,
+			expectedCode:    entity.ErrorCodeInternal,
+			expectedMessage: "internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			prUC := new(mockPullRequestUseCaseForPR)
+
+			v1 := New(new(mockTeamUseCaseForPR), new(mockUserUseCaseForPR), prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			prUC.On("GetPR", mock.Anything, "pr-1", entity.PullRequestExpand{}).Return(nil, tt.err)
+
+			app.Get("/pullRequest/get", v1.getPR)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/pullRequest/get?pull_request_id=pr-1", nil))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var body response.ErrorResponse
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, tt.expectedCode, body.Error.Code)
+			assert.Equal(t, tt.expectedMessage, body.Error.Message)
+		})
+	}
+}
+
+func TestGetPRHistoryHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	now := time.Now().UTC()
+	history := entity.PullRequestHistory{
+		PullRequestID: "pr-1",
+		Status:        entity.PullRequestStatusOpen,
+		Events: []entity.HistoryEvent{
+			{Type: entity.HistoryCreated, OccurredAt: now, UserID: "u1"},
+			{Type: entity.HistoryReviewerUnassigned, OccurredAt: now, UserID: "u2", ReplacedBy: "u3", Reason: entity.ReassignmentManual},
+		},
+	}
+
+	req := httptest.NewRequest("GET", "/pullRequest/history?pull_request_id=pr-1", nil)
+
+	prUC.On("GetPRHistory", mock.Anything, "pr-1").Return(history, nil)
+
+	app.Get("/pullRequest/history", v1.getPRHistory)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body entity.PullRequestHistory
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "pr-1", body.PullRequestID)
+	assert.Len(t, body.Events, 2)
+	assert.Equal(t, entity.HistoryReviewerUnassigned, body.Events[1].Type)
+	assert.Equal(t, "u3", body.Events[1].ReplacedBy)
+
+	prUC.AssertExpectations(t)
+}
+
+func TestGetPRHistoryHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name           string
+		query          string
+		ucErr          error
+		expectedStatus int
+	}{
+		{name: "missing pull_request_id", query: "", expectedStatus: http.StatusBadRequest},
+		{name: "not found", query: "?pull_request_id=pr-99", ucErr: entity.ErrNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			prUC := new(mockPullRequestUseCaseForPR)
+
+			v1 := New(new(mockTeamUseCaseForPR), new(mockUserUseCaseForPR), prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			if tt.ucErr != nil {
+				prUC.On("GetPRHistory", mock.Anything, "pr-99").Return(nil, tt.ucErr)
+			}
+
+			app.Get("/pullRequest/history", v1.getPRHistory)
+
+			resp, err := app.Test(httptest.NewRequest("GET", "/pullRequest/history"+tt.query, nil))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListPRsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	createdFrom := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+	expectedFilter := entity.PullRequestFilter{
+		Status:       entity.PullRequestStatusOpen,
+		TeamName:     "backend",
+		NameContains: "search",
+		CreatedFrom:  &createdFrom,
+	}
+	expectedPage := entity.PullRequestPage{
+		PullRequests: []entity.PullRequest{
+			{PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u2"}},
+		},
+		NextCursor: "next",
+	}
+
+	req := httptest.NewRequest("GET", "/pullRequest/list?status=OPEN&team_name=backend&name=search&created_from=2025-10-01T00:00:00Z&limit=1", nil)
+
+	prUC.On("ListPRs", mock.Anything, expectedFilter, entity.PageRequest{Limit: 1}).Return(expectedPage, nil)
+
+	app.Get("/pullRequest/list", v1.listPRs)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body entity.PullRequestPage
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, expectedPage, body)
+
+	prUC.AssertExpectations(t)
+}
+
+func TestListPRsHandler_OffsetConvertedToUTC(t *testing.T) {
+	app := fiber.New()
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(new(mockTeamUseCaseForPR), new(mockUserUseCaseForPR), prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	createdFrom := time.Date(2026, 1, 1, 7, 0, 0, 0, time.UTC)
+	expectedFilter := entity.PullRequestFilter{CreatedFrom: &createdFrom}
+
+	prUC.On("ListPRs", mock.Anything, expectedFilter, entity.PageRequest{}).Return(entity.PullRequestPage{}, nil)
+
+	app.Get("/pullRequest/list", v1.listPRs)
+
+	resp, err := app.Test(httptest.NewRequest("GET", "/pullRequest/list?created_from=2026-01-01T10:00:00%2B03:00", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	prUC.AssertExpectations(t)
+}
+
+func TestListPRsHandler_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unknown status", query: "status=DRAFT"},
+		{name: "malformed time", query: "created_from=yesterday"},
+		{name: "limit too large", query: "limit=1000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			teamUC := new(mockTeamUseCaseForPR)
+			userUC := new(mockUserUseCaseForPR)
+			prUC := new(mockPullRequestUseCaseForPR)
+
+			v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			req := httptest.NewRequest("GET", "/pullRequest/list?"+tt.query, nil)
+
+			app.Get("/pullRequest/list", v1.listPRs)
+
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			prUC.AssertNotCalled(t, "ListPRs")
+		})
+	}
+}
+
+func TestListPRsHandler_InvalidCursor(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCaseForPR)
+	userUC := new(mockUserUseCaseForPR)
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	req := httptest.NewRequest("GET", "/pullRequest/list?cursor=bogus", nil)
+
+	prUC.On("ListPRs", mock.Anything, entity.PullRequestFilter{}, entity.PageRequest{Cursor: "bogus"}).Return(nil, entity.ErrInvalidCursor)
+
+	app.Get("/pullRequest/list", v1.listPRs)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+type principalAuthenticator entity.Principal
+
+func (p principalAuthenticator) Authenticate(_ context.Context, _ string) (entity.Principal, error) {
+	return entity.Principal(p), nil
+}
+
+// signedInAs authenticates every request as the user, as an OIDC token would
+func signedInAs(app *fiber.App, userID string, role entity.Role) {
+	app.Use(middleware.Auth(principalAuthenticator{
+		UserID: userID,
+		Role:   role,
+		Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite},
+	}, middleware.AuthConfig{}, logger.New("error")))
+}
+
+func TestReassignReviewerHandler_ActsAsSignedInUser(t *testing.T) {
+	tests := []struct {
+		name      string
+		signedIn  string
+		role      entity.Role
+		body      string
+		expected  int
+		reassigns string
+	}{
+		{name: "implicit reviewer", signedIn: "u2", role: entity.RoleMember, body: `{"pull_request_id":"pr-1"}`, expected: http.StatusOK, reassigns: "u2"},
+		{name: "own ID repeated", signedIn: "u2", role: entity.RoleMember, body: `{"pull_request_id":"pr-1","old_user_id":"u2"}`, expected: http.StatusOK, reassigns: "u2"},
+		{name: "member reassigns someone else", signedIn: "u2", role: entity.RoleMember, body: `{"pull_request_id":"pr-1","old_user_id":"u3"}`, expected: http.StatusForbidden},
+		{name: "team lead reassigns someone else", signedIn: "u1", role: entity.RoleTeamLead, body: `{"pull_request_id":"pr-1","old_user_id":"u3"}`, expected: http.StatusOK, reassigns: "u3"},
+		{name: "not signed in without reviewer", body: `{"pull_request_id":"pr-1"}`, expected: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			prUC := new(mockPullRequestUseCaseForPR)
+
+			v1 := New(nil, nil, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			if tt.signedIn != "" {
+				signedInAs(app, tt.signedIn, tt.role)
+			}
+
+			if tt.reassigns != "" {
+				prUC.On("ReassignReviewer", mock.Anything, "pr-1", tt.reassigns).Return(entity.PullRequest{PullRequestID: "pr-1"}, "u4", nil)
+			}
+
+			app.Post("/pullRequest/reassign", v1.reassignReviewer)
+
+			req := httptest.NewRequest("POST", "/pullRequest/reassign", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer jwt")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRecordReviewHandler_Success(t *testing.T) {
+	app := fiber.New()
+	prUC := new(mockPullRequestUseCaseForPR)
+
+	v1 := New(nil, nil, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	signedInAs(app, "u2", entity.RoleMember)
+	prUC.On("RecordReview", mock.Anything, "pr-1", "u2", entity.ReviewOutcomeApproved).Return(nil)
+
+	app.Post("/pullRequest/review", v1.recordReview)
+
+	req := httptest.NewRequest("POST", "/pullRequest/review", strings.NewReader(`{"pull_request_id":"pr-1","outcome":"APPROVED"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer jwt")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		ReviewerID    string               `json:"reviewer_id"`
+		ReviewOutcome entity.ReviewOutcome `json:"review_outcome"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "u2", body.ReviewerID)
+	assert.Equal(t, entity.ReviewOutcomeApproved, body.ReviewOutcome)
+
+	prUC.AssertExpectations(t)
+}
+
+func TestRecordReviewHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		err      error
+		expected int
+	}{
+		{name: "unknown outcome", body: `{"pull_request_id":"pr-1","outcome":"LGTM","reviewer_id":"u2"}`, expected: http.StatusBadRequest},
+		{name: "missing reviewer", body: `{"pull_request_id":"pr-1","outcome":"APPROVED"}`, expected: http.StatusBadRequest},
+		{name: "not assigned", body: `{"pull_request_id":"pr-1","outcome":"APPROVED","reviewer_id":"u9"}`, err: entity.ErrNotAssigned, expected: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			prUC := new(mockPullRequestUseCaseForPR)
+
+			v1 := New(nil, nil, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			if tt.err != nil {
+				prUC.On("RecordReview", mock.Anything, "pr-1", mock.Anything, mock.Anything).Return(tt.err)
+			}
+
+			app.Post("/pullRequest/review", v1.recordReview)
+
+			req := httptest.NewRequest("POST", "/pullRequest/review", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
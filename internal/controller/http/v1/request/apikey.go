@@ -0,0 +1,13 @@
+package request
+
+// CreateAPIKeyRequest -.
+type CreateAPIKeyRequest struct {
+	Name      string   `json:"name" validate:"required,max=255"`
+	Scopes    []string `json:"scopes" validate:"required,min=1,dive,oneof=read write admin"`
+	ExpiresAt string   `json:"expires_at,omitempty" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
+
+// RevokeAPIKeyRequest -.
+type RevokeAPIKeyRequest struct {
+	KeyID string `json:"key_id" validate:"required"`
+}
@@ -0,0 +1,14 @@
+package request
+
+// ListAuditEntriesRequest -.
+type ListAuditEntriesRequest struct {
+	Actor      string `query:"actor"`
+	Action     string `query:"action" validate:"omitempty,oneof=team.created team.review_sla_changed team.escalation_threshold_changed team.chat_webhook_changed user.activity_changed user.role_changed user.digest_settings_changed pull_request.created pull_request.merged pull_request.closed pull_request.reopened pull_request.reviewer_reassigned pull_request.review_recorded api_key.created api_key.revoked identity.set identity.deleted webhook.created webhook.deleted"`
+	EntityType string `query:"entity_type" validate:"omitempty,oneof=team user pull_request api_key identity webhook"`
+	EntityID   string `query:"entity_id"`
+	RequestID  string `query:"request_id"`
+	From       string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To         string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Limit      int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor     string `query:"cursor"`
+}
@@ -0,0 +1,19 @@
+package request
+
+// SetIdentityRequest -.
+type SetIdentityRequest struct {
+	Provider string `json:"provider" validate:"required,oneof=github gitlab oidc"`
+	Login    string `json:"login" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+}
+
+// ListIdentitiesRequest -.
+type ListIdentitiesRequest struct {
+	Provider string `query:"provider" validate:"omitempty,oneof=github gitlab oidc"`
+}
+
+// DeleteIdentityRequest -.
+type DeleteIdentityRequest struct {
+	Provider string `json:"provider" validate:"required,oneof=github gitlab oidc"`
+	Login    string `json:"login" validate:"required"`
+}
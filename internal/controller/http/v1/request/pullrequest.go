@@ -4,7 +4,7 @@ package request
 type CreatePRRequest struct {
 	PullRequestID   string `json:"pull_request_id" validate:"required"`
 	PullRequestName string `json:"pull_request_name" validate:"required"`
-	AuthorID        string `json:"author_id" validate:"required"`
+	AuthorID        string `json:"author_id"`
 }
 
 // MergePRRequest -.
@@ -15,6 +15,28 @@ type MergePRRequest struct {
 // ReassignReviewerRequest -.
 type ReassignReviewerRequest struct {
 	PullRequestID string `json:"pull_request_id" validate:"required"`
-	OldUserID     string `json:"old_user_id" validate:"required"`
+	OldUserID     string `json:"old_user_id"`
 }
 
+// RecordReviewRequest -.
+type RecordReviewRequest struct {
+	PullRequestID string `json:"pull_request_id" validate:"required"`
+	Outcome       string `json:"outcome" validate:"required,oneof=APPROVED CHANGES_REQUESTED COMMENTED"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+// ListPRsRequest -.
+type ListPRsRequest struct {
+	Status      string `query:"status" validate:"omitempty,oneof=OPEN MERGED CLOSED"`
+	AuthorID    string `query:"author_id"`
+	ReviewerID  string `query:"reviewer_id"`
+	TeamName    string `query:"team_name"`
+	Name        string `query:"name"`
+	CreatedFrom string `query:"created_from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	CreatedTo   string `query:"created_to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	MergedFrom  string `query:"merged_from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	MergedTo    string `query:"merged_to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	Order       string `query:"order" validate:"omitempty,oneof=asc desc"`
+	Limit       int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor      string `query:"cursor"`
+}
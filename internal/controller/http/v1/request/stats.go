@@ -0,0 +1,14 @@
+package request
+
+// ReviewerStatsRequest -.
+type ReviewerStatsRequest struct {
+	From     string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To       string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	TeamName string `query:"team_name"`
+}
+
+// TeamStatsRequest -.
+type TeamStatsRequest struct {
+	From string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To   string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
@@ -0,0 +1,7 @@
+package request
+
+// StreamEventsRequest -.
+type StreamEventsRequest struct {
+	TeamName string `query:"team_name"`
+	UserID   string `query:"user_id"`
+}
@@ -13,3 +13,20 @@ type CreateTeamMemberRequest struct {
 	IsActive bool   `json:"is_active"`
 }
 
+// SetTeamReviewSLARequest -.
+type SetTeamReviewSLARequest struct {
+	TeamName         string `json:"team_name" validate:"required"`
+	ReviewSLASeconds *int   `json:"review_sla_seconds" validate:"required,min=0"`
+}
+
+// SetTeamEscalationRequest -.
+type SetTeamEscalationRequest struct {
+	TeamName          string `json:"team_name" validate:"required"`
+	EscalationSeconds *int   `json:"escalation_seconds" validate:"required,min=0"`
+}
+
+// SetTeamChatWebhookRequest -.
+type SetTeamChatWebhookRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	URL      string `json:"url" validate:"omitempty,http_url"`
+}
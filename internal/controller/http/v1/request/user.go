@@ -2,7 +2,46 @@ package request
 
 // SetIsActiveRequest -.
 type SetIsActiveRequest struct {
-	UserID  string `json:"user_id" validate:"required"`
-	IsActive bool  `json:"is_active" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+	IsActive *bool  `json:"is_active" validate:"required"`
 }
 
+// SetDigestSettingsRequest -.
+type SetDigestSettingsRequest struct {
+	UserID        string `json:"user_id"`
+	Email         string `json:"email" validate:"omitempty,email"`
+	DigestEnabled *bool  `json:"digest_enabled" validate:"required"`
+}
+
+// SetRoleRequest -.
+type SetRoleRequest struct {
+	UserID string `json:"user_id" validate:"required"`
+	Role   string `json:"role" validate:"required,oneof=admin team_lead member bot"`
+}
+
+// GetUserReviewsRequest -.
+type GetUserReviewsRequest struct {
+	UserID  string `query:"user_id" validate:"required"`
+	Status  string `query:"status" validate:"omitempty,oneof=OPEN MERGED CLOSED ALL"`
+	Include string `query:"include"`
+	Order   string `query:"order" validate:"omitempty,oneof=asc desc"`
+	Limit   int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor  string `query:"cursor"`
+}
+
+// GetAuthoredPRsRequest -.
+type GetAuthoredPRsRequest struct {
+	UserID string `query:"user_id" validate:"required"`
+	Status string `query:"status" validate:"omitempty,oneof=OPEN MERGED CLOSED ALL"`
+	Order  string `query:"order" validate:"omitempty,oneof=asc desc"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor string `query:"cursor"`
+}
+
+// ListUsersRequest -.
+type ListUsersRequest struct {
+	TeamName string `query:"team_name"`
+	IsActive string `query:"is_active" validate:"omitempty,oneof=true false"`
+	Limit    int    `query:"limit" validate:"omitempty,min=1,max=100"`
+	Cursor   string `query:"cursor"`
+}
@@ -0,0 +1,19 @@
+package request
+
+// CreateWebhookRequest -.
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" validate:"required,http_url"`
+	EventTypes []string `json:"event_types" validate:"omitempty,dive,oneof=pull_request.created pull_request.merged reviewer.assigned reviewer.reassigned review.submitted"`
+}
+
+// DeleteWebhookRequest -.
+type DeleteWebhookRequest struct {
+	WebhookID string `json:"webhook_id" validate:"required"`
+}
+
+// ListWebhookDeliveriesRequest -.
+type ListWebhookDeliveriesRequest struct {
+	WebhookID string `query:"webhook_id" validate:"required"`
+	Status    string `query:"status" validate:"omitempty,oneof=PENDING SUCCEEDED FAILED"`
+	Limit     int    `query:"limit" validate:"omitempty,min=1,max=100"`
+}
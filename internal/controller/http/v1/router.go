@@ -1,26 +1,73 @@
 package v1
 
 import (
+	"github.com/finstape/pr-reviews/internal/controller/http/middleware"
+	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/internal/usecase"
 	"github.com/finstape/pr-reviews/pkg/logger"
 	"github.com/gofiber/fiber/v2"
 )
 
 // NewRouter -.
-func NewRouter(apiGroup fiber.Router, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, l logger.Interface) {
-	v1 := New(teamUseCase, userUseCase, pullRequestUseCase, l)
+func NewRouter(apiGroup fiber.Router, teamUseCase usecase.Team, userUseCase usecase.User, pullRequestUseCase usecase.PullRequest, statsUseCase usecase.Stats, slaUseCase usecase.SLA, webhookUseCase usecase.Webhook, integrationUseCase usecase.Integration, streamUseCase usecase.Stream, apiKeyUseCase usecase.APIKey, auditUseCase usecase.Audit, l logger.Interface) {
+	v1 := New(teamUseCase, userUseCase, pullRequestUseCase, statsUseCase, slaUseCase, webhookUseCase, integrationUseCase, streamUseCase, apiKeyUseCase, auditUseCase, l)
+
+	// Roles; members and bots may change only what they act on themselves
+	manager := middleware.RequireRole(entity.RoleAdmin, entity.RoleTeamLead)
+	adminOnly := middleware.RequireRole(entity.RoleAdmin)
 
 	// Teams
-	apiGroup.Post("/team/add", v1.createTeam)
+	apiGroup.Post("/team/add", manager, v1.createTeam)
 	apiGroup.Get("/team/get", v1.getTeam)
+	apiGroup.Get("/team/list", v1.listTeams)
+	apiGroup.Post("/team/setReviewSLA", manager, v1.setTeamReviewSLA)
+	apiGroup.Post("/team/setEscalationThreshold", manager, v1.setTeamEscalation)
+	apiGroup.Post("/team/setChatWebhook", manager, v1.setTeamChatWebhook)
 
 	// Users
 	apiGroup.Post("/users/setIsActive", v1.setIsActive)
+	apiGroup.Post("/users/setRole", adminOnly, v1.setRole)
+	apiGroup.Post("/users/setDigestSettings", v1.setDigestSettings)
+	apiGroup.Get("/users/list", v1.listUsers)
 	apiGroup.Get("/users/getReview", v1.getUserReviews)
+	apiGroup.Get("/users/getAuthored", v1.getAuthoredPRs)
 
 	// Pull Requests
 	apiGroup.Post("/pullRequest/create", v1.createPR)
-	apiGroup.Post("/pullRequest/merge", v1.mergePR)
+	apiGroup.Get("/pullRequest/get", v1.getPR)
+	apiGroup.Get("/pullRequest/list", v1.listPRs)
+	apiGroup.Get("/pullRequest/history", v1.getPRHistory)
+	apiGroup.Get("/pullRequest/overdue", v1.getOverdueReviews)
+	apiGroup.Post("/pullRequest/merge", manager, v1.mergePR)
 	apiGroup.Post("/pullRequest/reassign", v1.reassignReviewer)
-}
+	apiGroup.Post("/pullRequest/review", v1.recordReview)
+
+	// Statistics
+	apiGroup.Get("/stats/reviewers", v1.getReviewerStats)
+	apiGroup.Get("/stats/teams", v1.getTeamStats)
+
+	// Webhooks
+	apiGroup.Post("/webhooks/create", adminOnly, v1.createWebhook)
+	apiGroup.Get("/webhooks/list", v1.listWebhooks)
+	apiGroup.Post("/webhooks/delete", adminOnly, v1.deleteWebhook)
+	apiGroup.Get("/webhooks/deliveries", v1.listWebhookDeliveries)
 
+	// Live events
+	apiGroup.Get("/events/stream", v1.streamEvents)
+
+	// Code host integrations
+	apiGroup.Post("/integrations/github/webhook", v1.handleGitHubWebhook)
+	apiGroup.Post("/integrations/gitlab/webhook", v1.handleGitLabWebhook)
+	apiGroup.Post("/integrations/identities/set", adminOnly, v1.setIdentity)
+	apiGroup.Get("/integrations/identities/list", v1.listIdentities)
+	apiGroup.Post("/integrations/identities/delete", adminOnly, v1.deleteIdentity)
+
+	// API keys
+	admin := middleware.RequireScope(entity.APIKeyScopeAdmin)
+	apiGroup.Post("/apikeys/create", admin, v1.createAPIKey)
+	apiGroup.Get("/apikeys/list", admin, v1.listAPIKeys)
+	apiGroup.Post("/apikeys/revoke", admin, v1.revokeAPIKey)
+
+	// Audit log
+	apiGroup.Get("/audit", adminOnly, v1.listAuditEntries)
+}
@@ -0,0 +1,54 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/response"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouter_AdminOnlyRoutesRejectMembers(t *testing.T) {
+	tests := []struct {
+		path string
+		body string
+	}{
+		{path: "/webhooks/create", body: `{"url":"https://example.com/hook"}`},
+		{path: "/webhooks/delete", body: `{"webhook_id":"wh-1"}`},
+		{path: "/integrations/identities/set", body: `{"provider":"github","login":"octocat","user_id":"u2"}`},
+		{path: "/integrations/identities/delete", body: `{"provider":"github","login":"octocat"}`},
+		{path: "/users/setRole", body: `{"user_id":"u2","role":"admin"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			app := fiber.New()
+			webhookUC := new(mockWebhookUseCase)
+			integrationUC := new(mockIntegrationUseCase)
+
+			signedInAs(app, "u2", entity.RoleMember)
+			NewRouter(app, nil, nil, nil, nil, nil, webhookUC, integrationUC, nil, nil, nil, logger.New("error"))
+
+			req := httptest.NewRequest("POST", tt.path, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer jwt")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+			var body response.ErrorResponse
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+			assert.Equal(t, entity.ErrorCodeForbidden, body.Error.Code)
+			assert.Equal(t, entity.ErrInsufficientRole.Error(), body.Error.Message)
+
+			webhookUC.AssertExpectations(t)
+			integrationUC.AssertExpectations(t)
+		})
+	}
+}
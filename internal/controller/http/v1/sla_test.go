@@ -0,0 +1,188 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockSLAUseCase struct {
+	mock.Mock
+}
+
+func (m *mockSLAUseCase) SetTeamSLA(ctx context.Context, teamName string, sla time.Duration) error {
+	args := m.Called(ctx, teamName, sla)
+	return args.Error(0)
+}
+
+func (m *mockSLAUseCase) GetOverdueReviews(ctx context.Context, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockSLAUseCase) CountOverdueByTeam(ctx context.Context) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockSLAUseCase) SetTeamEscalation(ctx context.Context, teamName string, threshold time.Duration) error {
+	args := m.Called(ctx, teamName, threshold)
+	return args.Error(0)
+}
+
+func TestSetTeamReviewSLAHandler_Success(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	slaUC.On("SetTeamSLA", mock.Anything, "backend", 4*time.Hour).Return(nil)
+
+	app.Post("/team/setReviewSLA", v1.setTeamReviewSLA)
+
+	req := httptest.NewRequest("POST", "/team/setReviewSLA", strings.NewReader(`{"team_name":"backend","review_sla_seconds":14400}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, float64(14400), body["review_sla_seconds"])
+
+	slaUC.AssertExpectations(t)
+}
+
+func TestSetTeamReviewSLAHandler_MissingSLA(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Post("/team/setReviewSLA", v1.setTeamReviewSLA)
+
+	req := httptest.NewRequest("POST", "/team/setReviewSLA", strings.NewReader(`{"team_name":"backend"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	slaUC.AssertNotCalled(t, "SetTeamSLA", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSetTeamReviewSLAHandler_TeamNotFound(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	slaUC.On("SetTeamSLA", mock.Anything, "ghost", time.Duration(0)).Return(entity.ErrNotFound)
+
+	app.Post("/team/setReviewSLA", v1.setTeamReviewSLA)
+
+	req := httptest.NewRequest("POST", "/team/setReviewSLA", strings.NewReader(`{"team_name":"ghost","review_sla_seconds":0}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	slaUC.AssertExpectations(t)
+}
+
+func TestSetTeamEscalationHandler_Success(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	slaUC.On("SetTeamEscalation", mock.Anything, "backend", 48*time.Hour).Return(nil)
+
+	app.Post("/team/setEscalationThreshold", v1.setTeamEscalation)
+
+	req := httptest.NewRequest("POST", "/team/setEscalationThreshold", strings.NewReader(`{"team_name":"backend","escalation_seconds":172800}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, float64(172800), body["escalation_seconds"])
+
+	slaUC.AssertExpectations(t)
+}
+
+func TestSetTeamEscalationHandler_NegativeThreshold(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Post("/team/setEscalationThreshold", v1.setTeamEscalation)
+
+	req := httptest.NewRequest("POST", "/team/setEscalationThreshold", strings.NewReader(`{"team_name":"backend","escalation_seconds":-1}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	slaUC.AssertNotCalled(t, "SetTeamEscalation", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetOverdueReviewsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	slaUC := new(mockSLAUseCase)
+
+	v1 := New(nil, nil, nil, nil, slaUC, nil, nil, nil, nil, nil, logger.New("error"))
+
+	reviews := []entity.OverdueReview{
+		{
+			PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", PullRequestName: "Test PR", AuthorID: "u1", Status: entity.PullRequestStatusOpen},
+			ReviewerID:       "u2",
+			TeamName:         "backend",
+			AssignedAt:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+			SLASeconds:       86400,
+			OverdueSeconds:   3600,
+		},
+	}
+
+	slaUC.On("GetOverdueReviews", mock.Anything, "backend").Return(reviews, nil)
+
+	app.Get("/pullRequest/overdue", v1.getOverdueReviews)
+
+	req := httptest.NewRequest("GET", "/pullRequest/overdue?team_name=backend", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+
+	overdue := body["overdue"].([]interface{})
+	assert.Len(t, overdue, 1)
+	assert.Equal(t, "u2", overdue[0].(map[string]interface{})["reviewer_id"])
+	assert.Equal(t, float64(3600), overdue[0].(map[string]interface{})["overdue_seconds"])
+
+	slaUC.AssertExpectations(t)
+}
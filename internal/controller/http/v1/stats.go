@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// getReviewerStats - GET /stats/reviewers
+func (v *V1) getReviewerStats(c *fiber.Ctx) error {
+	var req request.ReviewerStatsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	report, err := v.statsUseCase.GetReviewerStats(c.Context(), newTimeWindow(req.From, req.To), req.TeamName)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(report)
+}
+
+// getTeamStats - GET /stats/teams
+func (v *V1) getTeamStats(c *fiber.Ctx) error {
+	var req request.TeamStatsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	report, err := v.statsUseCase.GetTeamStats(c.Context(), newTimeWindow(req.From, req.To))
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(report)
+}
+
+// newTimeWindow builds a time window from validated bounds; missing bounds stay zero and are defaulted by the use case
+func newTimeWindow(from, to string) entity.TimeWindow {
+	var window entity.TimeWindow
+
+	if t := parseOptionalTime(from); t != nil {
+		window.From = *t
+	}
+
+	if t := parseOptionalTime(to); t != nil {
+		window.To = *t
+	}
+
+	return window
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockStatsUseCase struct {
+	mock.Mock
+}
+
+func (m *mockStatsUseCase) GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) (entity.ReviewerStatsReport, error) {
+	args := m.Called(ctx, window, teamName)
+	return args.Get(0).(entity.ReviewerStatsReport), args.Error(1)
+}
+
+func (m *mockStatsUseCase) GetTeamStats(ctx context.Context, window entity.TimeWindow) (entity.TeamStatsReport, error) {
+	args := m.Called(ctx, window)
+	return args.Get(0).(entity.TeamStatsReport), args.Error(1)
+}
+
+func TestGetReviewerStatsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	statsUC := new(mockStatsUseCase)
+
+	v1 := New(nil, nil, nil, statsUC, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	from := time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	median := 3600.0
+
+	report := entity.ReviewerStatsReport{
+		From: from,
+		To:   to,
+		Reviewers: []entity.ReviewerStats{
+			{UserID: "u1", Username: "Alice", TeamName: "backend", ReviewStats: entity.ReviewStats{Assignments: 3, OpenReviews: 1, ReassignmentsAway: 1, MedianTimeToMergeSeconds: &median}},
+		},
+	}
+
+	statsUC.On("GetReviewerStats", mock.Anything, entity.TimeWindow{From: from, To: to}, "backend").Return(report, nil)
+
+	app.Get("/stats/reviewers", v1.getReviewerStats)
+
+	req := httptest.NewRequest("GET", "/stats/reviewers?from=2026-09-01T00:00:00Z&to=2026-10-01T00:00:00Z&team_name=backend", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "2026-09-01T00:00:00Z", body["from"])
+
+	reviewers := body["reviewers"].([]interface{})
+	assert.Len(t, reviewers, 1)
+
+	reviewer := reviewers[0].(map[string]interface{})
+	assert.Equal(t, "u1", reviewer["user_id"])
+	assert.Equal(t, float64(3), reviewer["assignments"])
+	assert.Equal(t, float64(1), reviewer["reassignments_away"])
+	assert.Equal(t, median, reviewer["median_time_to_merge_seconds"])
+
+	statsUC.AssertExpectations(t)
+}
+
+func TestGetReviewerStatsHandler_InvalidTime(t *testing.T) {
+	app := fiber.New()
+	statsUC := new(mockStatsUseCase)
+
+	v1 := New(nil, nil, nil, statsUC, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Get("/stats/reviewers", v1.getReviewerStats)
+
+	req := httptest.NewRequest("GET", "/stats/reviewers?from=yesterday", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	statsUC.AssertNotCalled(t, "GetReviewerStats", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestGetTeamStatsHandler_DefaultWindow(t *testing.T) {
+	app := fiber.New()
+	statsUC := new(mockStatsUseCase)
+
+	v1 := New(nil, nil, nil, statsUC, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	report := entity.TeamStatsReport{
+		Teams: []entity.TeamStats{{TeamName: "backend"}},
+	}
+
+	statsUC.On("GetTeamStats", mock.Anything, entity.TimeWindow{}).Return(report, nil)
+
+	app.Get("/stats/teams", v1.getTeamStats)
+
+	req := httptest.NewRequest("GET", "/stats/teams", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	teams := body["teams"].([]interface{})
+	assert.Len(t, teams, 1)
+	assert.Nil(t, teams[0].(map[string]interface{})["median_time_to_merge_seconds"])
+
+	statsUC.AssertExpectations(t)
+}
+
+func TestGetTeamStatsHandler_InvalidWindow(t *testing.T) {
+	app := fiber.New()
+	statsUC := new(mockStatsUseCase)
+
+	v1 := New(nil, nil, nil, statsUC, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	statsUC.On("GetTeamStats", mock.Anything, mock.Anything).Return(entity.TeamStatsReport{}, entity.ErrInvalidWindow)
+
+	app.Get("/stats/teams", v1.getTeamStats)
+
+	req := httptest.NewRequest("GET", "/stats/teams?from=2026-10-01T00:00:00Z&to=2026-09-01T00:00:00Z", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "INVALID_WINDOW", body["error"].(map[string]interface{})["code"])
+
+	statsUC.AssertExpectations(t)
+}
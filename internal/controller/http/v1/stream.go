@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+const (
+	// _streamHeartbeat is how often an idle stream sends a comment, which keeps proxies from closing it
+	// and lets a stream notice a client that went away
+	_streamHeartbeat = 15 * time.Second
+
+	// _streamRetry is the reconnection delay suggested to clients, in milliseconds
+	_streamRetry = 3000
+)
+
+// streamEvents - GET /events/stream
+func (v *V1) streamEvents(c *fiber.Ctx) error {
+	var req request.StreamEventsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	events, unsubscribe := v.streamUseCase.Subscribe(entity.StreamFilter{TeamName: req.TeamName, UserID: req.UserID})
+
+	// Closed when the server shuts down
+	done := c.Context().Done()
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		heartbeat := time.NewTicker(_streamHeartbeat)
+		defer heartbeat.Stop()
+
+		fmt.Fprintf(w, "retry: %d\n\n", _streamRetry)
+		if err := w.Flush(); err != nil {
+			return
+		}
+
+		for {
+			select {
+			case <-done:
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if err := writeStreamEvent(w, event); err != nil {
+					v.l.Error(err, "http - v1 - streamEvents")
+
+					return
+				}
+			case <-heartbeat.C:
+				fmt.Fprint(w, ": heartbeat\n\n")
+			}
+
+			// A failed flush means the client has gone away
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// writeStreamEvent writes the event as an SSE message named after the event type
+func writeStreamEvent(w *bufio.Writer, event entity.StreamEvent) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("Marshal %s: %w", event.EventID, err)
+	}
+
+	fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.EventID, event.Type, data)
+
+	return nil
+}
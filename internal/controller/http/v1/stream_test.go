@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStreamUseCase struct {
+	mock.Mock
+}
+
+func (m *mockStreamUseCase) Publish(ctx context.Context, event entity.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *mockStreamUseCase) Listen(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *mockStreamUseCase) Subscribe(filter entity.StreamFilter) (<-chan entity.StreamEvent, func()) {
+	args := m.Called(filter)
+	return args.Get(0).(chan entity.StreamEvent), args.Get(1).(func())
+}
+
+func TestStreamEventsHandler_WritesEvents(t *testing.T) {
+	app := fiber.New()
+	streamUC := new(mockStreamUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, nil, nil, streamUC, nil, nil, logger.New("error"))
+
+	event := entity.StreamEvent{
+		Event: entity.Event{
+			EventID: "evt-7",
+			Type:    entity.EventReviewerAssigned,
+			Data:    entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"},
+		},
+		UserIDs:   []string{"u1", "u2"},
+		TeamNames: []string{"backend"},
+	}
+
+	// The stream ends once the subscription is closed
+	events := make(chan entity.StreamEvent, 1)
+	events <- event
+	close(events)
+
+	var unsubscribed atomic.Bool
+	streamUC.On("Subscribe", entity.StreamFilter{TeamName: "backend", UserID: "u2"}).
+		Return(events, func() { unsubscribed.Store(true) })
+
+	app.Get("/events/stream", v1.streamEvents)
+
+	req := httptest.NewRequest("GET", "/events/stream?team_name=backend&user_id=u2", nil)
+	resp, err := app.Test(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "no-cache", resp.Header.Get("Cache-Control"))
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(string(body), "retry: 3000\n\n"))
+	assert.Contains(t, string(body), "id: evt-7\nevent: reviewer.assigned\ndata: "+string(data)+"\n\n")
+	assert.True(t, unsubscribed.Load())
+}
@@ -1,6 +1,8 @@
 package v1
 
 import (
+	"time"
+
 	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/gofiber/fiber/v2"
@@ -72,3 +74,114 @@ func (v *V1) getTeam(c *fiber.Ctx) error {
 	return c.JSON(team)
 }
 
+// listTeams - GET /team/list
+func (v *V1) listTeams(c *fiber.Ctx) error {
+	teams, err := v.teamUseCase.ListTeams(c.Context())
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"teams": teams,
+	})
+}
+
+// setTeamReviewSLA - POST /team/setReviewSLA
+func (v *V1) setTeamReviewSLA(c *fiber.Ctx) error {
+	var req request.SetTeamReviewSLARequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	sla := time.Duration(*req.ReviewSLASeconds) * time.Second
+
+	err := v.slaUseCase.SetTeamSLA(c.Context(), req.TeamName, sla)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"team_name":          req.TeamName,
+		"review_sla_seconds": req.ReviewSLASeconds,
+	})
+}
+
+// setTeamEscalation - POST /team/setEscalationThreshold
+func (v *V1) setTeamEscalation(c *fiber.Ctx) error {
+	var req request.SetTeamEscalationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	threshold := time.Duration(*req.EscalationSeconds) * time.Second
+
+	err := v.slaUseCase.SetTeamEscalation(c.Context(), req.TeamName, threshold)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"team_name":          req.TeamName,
+		"escalation_seconds": req.EscalationSeconds,
+	})
+}
+
+// setTeamChatWebhook - POST /team/setChatWebhook
+func (v *V1) setTeamChatWebhook(c *fiber.Ctx) error {
+	var req request.SetTeamChatWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	err := v.teamUseCase.SetChatWebhook(c.Context(), req.TeamName, req.URL)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"team_name":               req.TeamName,
+		"chat_webhook_configured": req.URL != "",
+	})
+}
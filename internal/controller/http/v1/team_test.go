@@ -34,6 +34,19 @@ func (m *mockTeamUseCase) GetTeam(ctx context.Context, teamName string) (entity.
 	return args.Get(0).(entity.Team), args.Error(1)
 }
 
+func (m *mockTeamUseCase) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamUseCase) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
 var _ usecase.Team = (*mockTeamUseCase)(nil)
 
 type mockUserUseCase struct {
@@ -48,12 +61,38 @@ func (m *mockUserUseCase) SetIsActive(ctx context.Context, userID string, isActi
 	return args.Get(0).(entity.User), args.Error(1)
 }
 
-func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error) {
+	args := m.Called(ctx, userID, query)
 	if args.Get(0) == nil {
-		return nil, args.Error(1)
+		return entity.ReviewQueuePage{}, args.Error(1)
 	}
-	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+	return args.Get(0).(entity.ReviewQueuePage), args.Error(1)
+}
+
+func (m *mockUserUseCase) GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.AuthoredPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.AuthoredPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.UserPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.UserPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error) {
+	args := m.Called(ctx, settings)
+	return args.Get(0).(entity.DigestSettings), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
 }
 
 var _ usecase.User = (*mockUserUseCase)(nil)
@@ -70,6 +109,22 @@ func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prNa
 	return args.Get(0).(entity.PullRequest), args.Error(1)
 }
 
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
 func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
 	args := m.Called(ctx, prID)
 	if args.Get(0) == nil {
@@ -86,6 +141,43 @@ func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID stri
 	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
 }
 
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
 var _ usecase.PullRequest = (*mockPullRequestUseCase)(nil)
 
 func TestCreateTeamHandler_Success(t *testing.T) {
@@ -94,7 +186,7 @@ func TestCreateTeamHandler_Success(t *testing.T) {
 	userUC := new(mockUserUseCase)
 	prUC := new(mockPullRequestUseCase)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	reqBody := request.CreateTeamRequest{
 		TeamName: "test-team",
@@ -123,7 +215,7 @@ func TestCreateTeamHandler_InvalidBody(t *testing.T) {
 	userUC := new(mockUserUseCase)
 	prUC := new(mockPullRequestUseCase)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	req := httptest.NewRequest("POST", "/team/add", bytes.NewReader([]byte("invalid json")))
 	req.Header.Set("Content-Type", "application/json")
@@ -141,7 +233,7 @@ func TestGetTeamHandler_Success(t *testing.T) {
 	userUC := new(mockUserUseCase)
 	prUC := new(mockPullRequestUseCase)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	expectedTeam := entity.Team{
 		TeamName: "test-team",
@@ -167,7 +259,7 @@ func TestGetTeamHandler_MissingParam(t *testing.T) {
 	userUC := new(mockUserUseCase)
 	prUC := new(mockPullRequestUseCase)
 	
-	v1 := New(teamUC, userUC, prUC, logger.New("error"))
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
 
 	req := httptest.NewRequest("GET", "/team/get", nil)
 
@@ -178,3 +270,76 @@ func TestGetTeamHandler_MissingParam(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
 }
 
+func TestListTeamsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	expectedTeams := []entity.TeamSummary{
+		{TeamName: "backend", MemberCount: 2, ActiveCount: 1},
+	}
+
+	req := httptest.NewRequest("GET", "/team/list", nil)
+
+	teamUC.On("ListTeams", mock.Anything).Return(expectedTeams, nil)
+
+	app.Get("/team/list", v1.listTeams)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		Teams []entity.TeamSummary `json:"teams"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, expectedTeams, body.Teams)
+
+	teamUC.AssertExpectations(t)
+}
+
+func TestSetTeamChatWebhookHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+
+	v1 := New(teamUC, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	teamUC.On("SetChatWebhook", mock.Anything, "backend", "https://chat.example.com/hooks/abc").Return(nil)
+
+	app.Post("/team/setChatWebhook", v1.setTeamChatWebhook)
+
+	req := httptest.NewRequest("POST", "/team/setChatWebhook", bytes.NewReader([]byte(`{"team_name":"backend","url":"https://chat.example.com/hooks/abc"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		TeamName              string `json:"team_name"`
+		ChatWebhookConfigured bool   `json:"chat_webhook_configured"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "backend", body.TeamName)
+	assert.True(t, body.ChatWebhookConfigured)
+
+	teamUC.AssertExpectations(t)
+}
+
+func TestSetTeamChatWebhookHandler_InvalidURL(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+
+	v1 := New(teamUC, nil, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Post("/team/setChatWebhook", v1.setTeamChatWebhook)
+
+	req := httptest.NewRequest("POST", "/team/setChatWebhook", bytes.NewReader([]byte(`{"team_name":"backend","url":"not a url"}`)))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	teamUC.AssertNotCalled(t, "SetChatWebhook")
+}
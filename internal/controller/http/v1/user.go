@@ -1,10 +1,17 @@
 package v1
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
 	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/gofiber/fiber/v2"
 )
 
+var errUnknownInclude = errors.New("unknown include value")
+
 // setIsActive - POST /users/setIsActive
 func (v *V1) setIsActive(c *fiber.Ctx) error {
 	var req request.SetIsActiveRequest
@@ -26,7 +33,11 @@ func (v *V1) setIsActive(c *fiber.Ctx) error {
 		})
 	}
 
-	user, err := v.userUseCase.SetIsActive(c.Context(), req.UserID, req.IsActive)
+	if err := authorizeUserChange(c, req.UserID); err != nil {
+		return v.handleError(c, err)
+	}
+
+	user, err := v.userUseCase.SetIsActive(c.Context(), req.UserID, *req.IsActive)
 	if err != nil {
 		return v.handleError(c, err)
 	}
@@ -36,10 +47,29 @@ func (v *V1) setIsActive(c *fiber.Ctx) error {
 	})
 }
 
-// getUserReviews - GET /users/getReview
-func (v *V1) getUserReviews(c *fiber.Ctx) error {
-	userID := c.Query("user_id")
-	if userID == "" {
+// setDigestSettings - POST /users/setDigestSettings
+func (v *V1) setDigestSettings(c *fiber.Ctx) error {
+	var req request.SetDigestSettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	userID, err := resolveActor(c, req.UserID)
+	if errors.Is(err, errActorRequired) {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": fiber.Map{
 				"code":    "BAD_REQUEST",
@@ -48,14 +78,230 @@ func (v *V1) getUserReviews(c *fiber.Ctx) error {
 		})
 	}
 
-	prs, err := v.userUseCase.GetUserReviews(c.Context(), userID)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	settings, err := v.userUseCase.SetDigestSettings(c.Context(), entity.DigestSettings{
+		UserID:        userID,
+		Email:         req.Email,
+		DigestEnabled: *req.DigestEnabled,
+	})
 	if err != nil {
 		return v.handleError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
-		"user_id":       userID,
-		"pull_requests": prs,
+		"digest_settings": settings,
 	})
 }
 
+// setRole - POST /users/setRole
+func (v *V1) setRole(c *fiber.Ctx) error {
+	var req request.SetRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	role := entity.Role(req.Role)
+
+	err := v.userUseCase.SetRole(c.Context(), req.UserID, role)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"user_id": req.UserID,
+		"role":    role,
+	})
+}
+
+// listUsers - GET /users/list
+func (v *V1) listUsers(c *fiber.Ctx) error {
+	var req request.ListUsersRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	filter := entity.UserFilter{TeamName: req.TeamName}
+	if req.IsActive != "" {
+		isActive := req.IsActive == "true"
+		filter.IsActive = &isActive
+	}
+
+	page, err := v.userUseCase.ListUsers(c.Context(), filter, entity.PageRequest{Limit: req.Limit, Cursor: req.Cursor})
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(page)
+}
+
+// getUserReviews - GET /users/getReview
+func (v *V1) getUserReviews(c *fiber.Ctx) error {
+	var req request.GetUserReviewsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if req.UserID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "user_id is required",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	query, err := newReviewQueueQuery(req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	page, err := v.userUseCase.GetUserReviews(c.Context(), req.UserID, query)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	resp := fiber.Map{
+		"user_id":       req.UserID,
+		"pull_requests": page.PullRequests,
+	}
+	if page.NextCursor != "" {
+		resp["next_cursor"] = page.NextCursor
+	}
+
+	return c.JSON(resp)
+}
+
+// getAuthoredPRs - GET /users/getAuthored
+func (v *V1) getAuthoredPRs(c *fiber.Ctx) error {
+	var req request.GetAuthoredPRsRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	query := entity.AuthoredQuery{
+		Page: entity.PageRequest{
+			Limit:  req.Limit,
+			Cursor: req.Cursor,
+			Order:  entity.SortOrder(req.Order),
+		},
+	}
+	if req.Status != "ALL" {
+		query.Status = entity.PullRequestStatus(req.Status)
+	}
+
+	page, err := v.userUseCase.GetAuthoredPRs(c.Context(), req.UserID, query)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	resp := fiber.Map{
+		"user_id":       req.UserID,
+		"pull_requests": page.PullRequests,
+	}
+	if page.NextCursor != "" {
+		resp["next_cursor"] = page.NextCursor
+	}
+
+	return c.JSON(resp)
+}
+
+// newReviewQueueQuery converts review queue query parameters; status defaults to OPEN, ALL disables the filter
+func newReviewQueueQuery(req request.GetUserReviewsRequest) (entity.ReviewQueueQuery, error) {
+	query := entity.ReviewQueueQuery{
+		Status: entity.PullRequestStatusOpen,
+		Page: entity.PageRequest{
+			Limit:  req.Limit,
+			Cursor: req.Cursor,
+			Order:  entity.SortOrder(req.Order),
+		},
+	}
+
+	switch req.Status {
+	case "":
+	case "ALL":
+		query.Status = ""
+	default:
+		query.Status = entity.PullRequestStatus(req.Status)
+	}
+
+	if req.Include == "" {
+		return query, nil
+	}
+
+	for _, item := range strings.Split(req.Include, ",") {
+		switch strings.TrimSpace(item) {
+		case "reviewers":
+			query.IncludeReviewers = true
+		case "age":
+			query.IncludeAge = true
+		default:
+			return entity.ReviewQueueQuery{}, fmt.Errorf("%w: %q", errUnknownInclude, item)
+		}
+	}
+
+	return query, nil
+}
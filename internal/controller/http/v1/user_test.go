@@ -0,0 +1,380 @@
+package v1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetIsActiveHandler_Deactivate(t *testing.T) {
+	app := fiber.New()
+	userUC := new(mockUserUseCase)
+
+	v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	user := entity.User{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: false}
+	userUC.On("SetIsActive", mock.Anything, "u2", false).Return(user, nil)
+
+	app.Post("/users/setIsActive", v1.setIsActive)
+
+	req := httptest.NewRequest("POST", "/users/setIsActive", strings.NewReader(`{"user_id":"u2","is_active":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	userUC.AssertExpectations(t)
+}
+
+func TestSetIsActiveHandler_MissingIsActive(t *testing.T) {
+	app := fiber.New()
+	userUC := new(mockUserUseCase)
+
+	v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Post("/users/setIsActive", v1.setIsActive)
+
+	req := httptest.NewRequest("POST", "/users/setIsActive", strings.NewReader(`{"user_id":"u2"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	userUC.AssertNotCalled(t, "SetIsActive")
+}
+
+func TestSetIsActiveHandler_Roles(t *testing.T) {
+	tests := []struct {
+		name     string
+		signedIn string
+		role     entity.Role
+		expected int
+	}{
+		{name: "member deactivates themselves", signedIn: "u2", role: entity.RoleMember, expected: http.StatusOK},
+		{name: "member deactivates someone else", signedIn: "u3", role: entity.RoleMember, expected: http.StatusForbidden},
+		{name: "bot deactivates someone else", signedIn: "ci", role: entity.RoleBot, expected: http.StatusForbidden},
+		{name: "team lead deactivates someone else", signedIn: "u1", role: entity.RoleTeamLead, expected: http.StatusOK},
+		{name: "admin deactivates someone else", signedIn: "root", role: entity.RoleAdmin, expected: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			userUC := new(mockUserUseCase)
+
+			v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			signedInAs(app, tt.signedIn, tt.role)
+			userUC.On("SetIsActive", mock.Anything, "u2", false).Return(entity.User{UserID: "u2"}, nil).Maybe()
+
+			app.Post("/users/setIsActive", v1.setIsActive)
+
+			req := httptest.NewRequest("POST", "/users/setIsActive", strings.NewReader(`{"user_id":"u2","is_active":false}`))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", "Bearer jwt")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, resp.StatusCode)
+
+			if tt.expected == http.StatusForbidden {
+				userUC.AssertNotCalled(t, "SetIsActive")
+			}
+		})
+	}
+}
+
+func TestSetRoleHandler_Success(t *testing.T) {
+	app := fiber.New()
+	userUC := new(mockUserUseCase)
+
+	v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	userUC.On("SetRole", mock.Anything, "u1", entity.RoleTeamLead).Return(nil)
+
+	app.Post("/users/setRole", v1.setRole)
+
+	req := httptest.NewRequest("POST", "/users/setRole", strings.NewReader(`{"user_id":"u1","role":"team_lead"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	userUC.AssertExpectations(t)
+}
+
+func TestSetRoleHandler_UnknownRole(t *testing.T) {
+	app := fiber.New()
+	userUC := new(mockUserUseCase)
+
+	v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	app.Post("/users/setRole", v1.setRole)
+
+	req := httptest.NewRequest("POST", "/users/setRole", strings.NewReader(`{"user_id":"u1","role":"owner"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	userUC.AssertNotCalled(t, "SetRole")
+}
+
+func TestGetUserReviewsHandler_DefaultsToOpen(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	expectedPage := entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{
+			{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", PullRequestName: "Test PR", AuthorID: "u2", Status: entity.PullRequestStatusOpen}},
+		},
+	}
+
+	req := httptest.NewRequest("GET", "/users/getReview?user_id=u1", nil)
+
+	userUC.On("GetUserReviews", mock.Anything, "u1", entity.ReviewQueueQuery{Status: entity.PullRequestStatusOpen}).Return(expectedPage, nil)
+
+	app.Get("/users/getReview", v1.getUserReviews)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "u1", body["user_id"])
+	assert.Len(t, body["pull_requests"], 1)
+	assert.NotContains(t, body, "next_cursor")
+
+	userUC.AssertExpectations(t)
+}
+
+func TestGetUserReviewsHandler_AllStatusesWithOptions(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	expectedQuery := entity.ReviewQueueQuery{
+		IncludeReviewers: true,
+		IncludeAge:       true,
+		Page:             entity.PageRequest{Limit: 10, Cursor: "abc", Order: entity.SortOrderAsc},
+	}
+
+	req := httptest.NewRequest("GET", "/users/getReview?user_id=u1&status=ALL&include=reviewers,age&order=asc&limit=10&cursor=abc", nil)
+
+	userUC.On("GetUserReviews", mock.Anything, "u1", expectedQuery).Return(entity.ReviewQueuePage{NextCursor: "next"}, nil)
+
+	app.Get("/users/getReview", v1.getUserReviews)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "next", body["next_cursor"])
+
+	userUC.AssertExpectations(t)
+}
+
+func TestGetUserReviewsHandler_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "missing user_id", query: "status=OPEN"},
+		{name: "unknown status", query: "user_id=u1&status=DRAFT"},
+		{name: "unknown include", query: "user_id=u1&include=labels"},
+		{name: "unknown order", query: "user_id=u1&order=random"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			teamUC := new(mockTeamUseCase)
+			userUC := new(mockUserUseCase)
+			prUC := new(mockPullRequestUseCase)
+
+			v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			req := httptest.NewRequest("GET", "/users/getReview?"+tt.query, nil)
+
+			app.Get("/users/getReview", v1.getUserReviews)
+
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			userUC.AssertNotCalled(t, "GetUserReviews")
+		})
+	}
+}
+
+func TestGetAuthoredPRsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	expectedPage := entity.AuthoredPage{
+		PullRequests: []entity.AuthoredPullRequest{
+			{
+				PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", PullRequestName: "Test PR", AuthorID: "u1", Status: entity.PullRequestStatusOpen},
+				ReviewStatus:     entity.ReviewStatusPending,
+				Reviewers:        []entity.ReviewerAssignment{{UserID: "u2", Username: "Bob", IsActive: true}},
+			},
+		},
+	}
+
+	req := httptest.NewRequest("GET", "/users/getAuthored?user_id=u1&status=OPEN", nil)
+
+	userUC.On("GetAuthoredPRs", mock.Anything, "u1", entity.AuthoredQuery{Status: entity.PullRequestStatusOpen}).Return(expectedPage, nil)
+
+	app.Get("/users/getAuthored", v1.getAuthoredPRs)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		UserID       string                       `json:"user_id"`
+		PullRequests []entity.AuthoredPullRequest `json:"pull_requests"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "u1", body.UserID)
+	assert.Equal(t, expectedPage.PullRequests, body.PullRequests)
+
+	userUC.AssertExpectations(t)
+}
+
+func TestGetAuthoredPRsHandler_MissingUserID(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	req := httptest.NewRequest("GET", "/users/getAuthored", nil)
+
+	app.Get("/users/getAuthored", v1.getAuthoredPRs)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	userUC.AssertNotCalled(t, "GetAuthoredPRs")
+}
+
+func TestListUsersHandler_Success(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	isActive := false
+	expectedFilter := entity.UserFilter{TeamName: "backend", IsActive: &isActive}
+	expectedPage := entity.UserPage{
+		Users: []entity.User{{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: false}},
+	}
+
+	req := httptest.NewRequest("GET", "/users/list?team_name=backend&is_active=false&limit=5", nil)
+
+	userUC.On("ListUsers", mock.Anything, expectedFilter, entity.PageRequest{Limit: 5}).Return(expectedPage, nil)
+
+	app.Get("/users/list", v1.listUsers)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body entity.UserPage
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, expectedPage, body)
+
+	userUC.AssertExpectations(t)
+}
+
+func TestListUsersHandler_InvalidIsActive(t *testing.T) {
+	app := fiber.New()
+	teamUC := new(mockTeamUseCase)
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+
+	v1 := New(teamUC, userUC, prUC, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	req := httptest.NewRequest("GET", "/users/list?is_active=maybe", nil)
+
+	app.Get("/users/list", v1.listUsers)
+
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	userUC.AssertNotCalled(t, "ListUsers")
+}
+
+func TestSetDigestSettingsHandler_Success(t *testing.T) {
+	app := fiber.New()
+	userUC := new(mockUserUseCase)
+
+	v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+	settings := entity.DigestSettings{UserID: "u1", Email: "alice@example.com", DigestEnabled: false}
+	userUC.On("SetDigestSettings", mock.Anything, settings).Return(settings, nil)
+
+	app.Post("/users/setDigestSettings", v1.setDigestSettings)
+
+	req := httptest.NewRequest("POST", "/users/setDigestSettings", strings.NewReader(`{"user_id":"u1","email":"alice@example.com","digest_enabled":false}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		DigestSettings entity.DigestSettings `json:"digest_settings"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, settings, body.DigestSettings)
+
+	userUC.AssertExpectations(t)
+}
+
+func TestSetDigestSettingsHandler_InvalidBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing digest_enabled", body: `{"user_id":"u1","email":"alice@example.com"}`},
+		{name: "invalid email", body: `{"user_id":"u1","email":"alice","digest_enabled":true}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			userUC := new(mockUserUseCase)
+
+			v1 := New(nil, userUC, nil, nil, nil, nil, nil, nil, nil, nil, logger.New("error"))
+
+			app.Post("/users/setDigestSettings", v1.setDigestSettings)
+
+			req := httptest.NewRequest("POST", "/users/setDigestSettings", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			userUC.AssertNotCalled(t, "SetDigestSettings")
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"github.com/finstape/pr-reviews/internal/controller/http/v1/request"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/gofiber/fiber/v2"
+)
+
+// createWebhook - POST /webhooks/create
+func (v *V1) createWebhook(c *fiber.Ctx) error {
+	var req request.CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	eventTypes := make([]entity.EventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		eventTypes = append(eventTypes, entity.EventType(eventType))
+	}
+
+	webhook, err := v.webhookUseCase.CreateWebhook(c.Context(), req.URL, eventTypes)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"webhook": webhook,
+	})
+}
+
+// listWebhooks - GET /webhooks/list
+func (v *V1) listWebhooks(c *fiber.Ctx) error {
+	webhooks, err := v.webhookUseCase.ListWebhooks(c.Context())
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"webhooks": webhooks,
+	})
+}
+
+// deleteWebhook - POST /webhooks/delete
+func (v *V1) deleteWebhook(c *fiber.Ctx) error {
+	var req request.DeleteWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid request body",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	err := v.webhookUseCase.DeleteWebhook(c.Context(), req.WebhookID)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"webhook_id": req.WebhookID,
+	})
+}
+
+// listWebhookDeliveries - GET /webhooks/deliveries
+func (v *V1) listWebhookDeliveries(c *fiber.Ctx) error {
+	var req request.ListWebhookDeliveriesRequest
+	if err := c.QueryParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": "invalid query parameters",
+			},
+		})
+	}
+
+	if err := v.v.Struct(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": fiber.Map{
+				"code":    "BAD_REQUEST",
+				"message": err.Error(),
+			},
+		})
+	}
+
+	deliveries, err := v.webhookUseCase.ListDeliveries(c.Context(), req.WebhookID, entity.DeliveryStatus(req.Status), req.Limit)
+	if err != nil {
+		return v.handleError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"webhook_id": req.WebhookID,
+		"deliveries": deliveries,
+	})
+}
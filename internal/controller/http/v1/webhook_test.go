@@ -0,0 +1,175 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockWebhookUseCase struct {
+	mock.Mock
+}
+
+func (m *mockWebhookUseCase) Publish(ctx context.Context, event entity.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *mockWebhookUseCase) CreateWebhook(ctx context.Context, url string, eventTypes []entity.EventType) (entity.Webhook, error) {
+	args := m.Called(ctx, url, eventTypes)
+	return args.Get(0).(entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookUseCase) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookUseCase) DeleteWebhook(ctx context.Context, webhookID string) error {
+	args := m.Called(ctx, webhookID)
+	return args.Error(0)
+}
+
+func (m *mockWebhookUseCase) ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, status, limit)
+	return args.Get(0).([]entity.WebhookDelivery), args.Error(1)
+}
+
+func (m *mockWebhookUseCase) DeliverPending(ctx context.Context) (entity.DeliveryResult, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(entity.DeliveryResult), args.Error(1)
+}
+
+func TestCreateWebhookHandler_Success(t *testing.T) {
+	app := fiber.New()
+	webhookUC := new(mockWebhookUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, webhookUC, nil, nil, nil, nil, logger.New("error"))
+
+	created := entity.Webhook{
+		WebhookID:  "wh-1",
+		URL:        "https://example.com/hook",
+		Secret:     "secret",
+		EventTypes: []entity.EventType{entity.EventReviewerAssigned},
+		IsActive:   true,
+	}
+
+	webhookUC.On("CreateWebhook", mock.Anything, "https://example.com/hook", []entity.EventType{entity.EventReviewerAssigned}).Return(created, nil)
+
+	app.Post("/webhooks/create", v1.createWebhook)
+
+	req := httptest.NewRequest("POST", "/webhooks/create", strings.NewReader(`{"url":"https://example.com/hook","event_types":["reviewer.assigned"]}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var body struct {
+		Webhook entity.Webhook `json:"webhook"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, created, body.Webhook)
+
+	webhookUC.AssertExpectations(t)
+}
+
+func TestCreateWebhookHandler_InvalidBody(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "missing url", body: `{}`},
+		{name: "not a url", body: `{"url":"example"}`},
+		{name: "unknown event type", body: `{"url":"https://example.com/hook","event_types":["team.created"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := fiber.New()
+			webhookUC := new(mockWebhookUseCase)
+
+			v1 := New(nil, nil, nil, nil, nil, webhookUC, nil, nil, nil, nil, logger.New("error"))
+
+			app.Post("/webhooks/create", v1.createWebhook)
+
+			req := httptest.NewRequest("POST", "/webhooks/create", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			resp, err := app.Test(req)
+			assert.NoError(t, err)
+			assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+			webhookUC.AssertNotCalled(t, "CreateWebhook")
+		})
+	}
+}
+
+func TestDeleteWebhookHandler_NotFound(t *testing.T) {
+	app := fiber.New()
+	webhookUC := new(mockWebhookUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, webhookUC, nil, nil, nil, nil, logger.New("error"))
+
+	webhookUC.On("DeleteWebhook", mock.Anything, "wh-404").Return(entity.ErrNotFound)
+
+	app.Post("/webhooks/delete", v1.deleteWebhook)
+
+	req := httptest.NewRequest("POST", "/webhooks/delete", strings.NewReader(`{"webhook_id":"wh-404"}`))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestListWebhookDeliveriesHandler_Success(t *testing.T) {
+	app := fiber.New()
+	webhookUC := new(mockWebhookUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, webhookUC, nil, nil, nil, nil, logger.New("error"))
+
+	code := 503
+	deliveries := []entity.WebhookDelivery{
+		{DeliveryID: 7, WebhookID: "wh-1", EventID: "evt-1", EventType: entity.EventPRMerged, Status: entity.DeliveryStatusPending, Attempts: 1, LastStatusCode: &code},
+	}
+
+	webhookUC.On("ListDeliveries", mock.Anything, "wh-1", entity.DeliveryStatusPending, 5).Return(deliveries, nil)
+
+	app.Get("/webhooks/deliveries", v1.listWebhookDeliveries)
+
+	req := httptest.NewRequest("GET", "/webhooks/deliveries?webhook_id=wh-1&status=PENDING&limit=5", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var body struct {
+		WebhookID  string                   `json:"webhook_id"`
+		Deliveries []entity.WebhookDelivery `json:"deliveries"`
+	}
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	assert.Equal(t, "wh-1", body.WebhookID)
+	assert.Equal(t, deliveries, body.Deliveries)
+
+	webhookUC.AssertExpectations(t)
+}
+
+func TestListWebhookDeliveriesHandler_InvalidStatus(t *testing.T) {
+	app := fiber.New()
+	webhookUC := new(mockWebhookUseCase)
+
+	v1 := New(nil, nil, nil, nil, nil, webhookUC, nil, nil, nil, nil, logger.New("error"))
+
+	app.Get("/webhooks/deliveries", v1.listWebhookDeliveries)
+
+	req := httptest.NewRequest("GET", "/webhooks/deliveries?webhook_id=wh-1&status=DONE", nil)
+	resp, err := app.Test(req)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	webhookUC.AssertNotCalled(t, "ListDeliveries")
+}
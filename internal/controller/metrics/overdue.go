@@ -0,0 +1,67 @@
+// Package metrics implements Prometheus collectors backed by use cases.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const _defaultCollectTimeout = 5 * time.Second
+
+// OverdueCounter provides the number of overdue reviews per team.
+type OverdueCounter interface {
+	CountOverdueByTeam(ctx context.Context) ([]entity.OverdueCount, error)
+}
+
+// OverdueCollector exposes a gauge of overdue reviews per team, computed on every scrape.
+type OverdueCollector struct {
+	counter OverdueCounter
+	l       logger.Interface
+	desc    *prometheus.Desc
+	timeout time.Duration
+}
+
+var _ prometheus.Collector = (*OverdueCollector)(nil)
+
+// NewOverdueCollector creates a new OverdueCollector instance.
+func NewOverdueCollector(counter OverdueCounter, l logger.Interface) *OverdueCollector {
+	return &OverdueCollector{
+		counter: counter,
+		l:       l,
+		desc: prometheus.NewDesc(
+			"pr_reviews_overdue_reviews",
+			"Number of reviewer assignments on open pull requests past the team's review SLA.",
+			[]string{"team"},
+			nil,
+		),
+		timeout: _defaultCollectTimeout,
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *OverdueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements prometheus.Collector.
+func (c *OverdueCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	counts, err := c.counter.CountOverdueByTeam(ctx)
+	if err != nil {
+		c.l.Error(fmt.Errorf("metrics - OverdueCollector - CountOverdueByTeam: %w", err))
+		ch <- prometheus.NewInvalidMetric(c.desc, err)
+
+		return
+	}
+
+	for _, count := range counts {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count.Count), count.TeamName)
+	}
+}
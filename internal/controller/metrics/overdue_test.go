@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockOverdueCounter struct {
+	mock.Mock
+}
+
+func (m *mockOverdueCounter) CountOverdueByTeam(ctx context.Context) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func TestOverdueCollector_Collect(t *testing.T) {
+	counter := new(mockOverdueCounter)
+	counter.On("CountOverdueByTeam", mock.Anything).Return([]entity.OverdueCount{
+		{TeamName: "backend", Count: 3},
+		{TeamName: "frontend", Count: 0},
+	}, nil)
+
+	collector := NewOverdueCollector(counter, logger.New("error"))
+
+	expected := `
+# HELP pr_reviews_overdue_reviews Number of reviewer assignments on open pull requests past the team's review SLA.
+# TYPE pr_reviews_overdue_reviews gauge
+pr_reviews_overdue_reviews{team="backend"} 3
+pr_reviews_overdue_reviews{team="frontend"} 0
+`
+
+	err := testutil.CollectAndCompare(collector, strings.NewReader(expected), "pr_reviews_overdue_reviews")
+	assert.NoError(t, err)
+	counter.AssertExpectations(t)
+}
+
+func TestOverdueCollector_CollectError(t *testing.T) {
+	counter := new(mockOverdueCounter)
+	counter.On("CountOverdueByTeam", mock.Anything).Return(nil, errors.New("db down"))
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(NewOverdueCollector(counter, logger.New("error")))
+
+	_, err := registry.Gather()
+	assert.Error(t, err)
+	counter.AssertExpectations(t)
+}
@@ -0,0 +1,61 @@
+package entity
+
+import "time"
+
+// APIKeyTokenPrefix starts every API key token, which tells them apart from identity provider tokens
+const APIKeyTokenPrefix = "prk_"
+
+// APIKeyScope represents a permission granted to an API key
+type APIKeyScope string
+
+const (
+	// APIKeyScopeRead allows GET requests and read-only queries
+	APIKeyScopeRead APIKeyScope = "read"
+	// APIKeyScopeWrite allows every request except key management; it includes read
+	APIKeyScopeWrite APIKeyScope = "write"
+	// APIKeyScopeAdmin allows key management; it includes every other scope
+	APIKeyScopeAdmin APIKeyScope = "admin"
+)
+
+// APIKey represents a credential for the HTTP API
+type APIKey struct {
+	KeyID string `json:"key_id"`
+	Name  string `json:"name"`
+	// Token is the bearer token; it is only returned when the key is created, only its hash is stored
+	Token     string `json:"token,omitempty"`
+	TokenHash string `json:"-"`
+	// TokenPrefix is the start of the token, to tell keys apart without revealing them
+	TokenPrefix string        `json:"token_prefix"`
+	Scopes      []APIKeyScope `json:"scopes"`
+	// ExpiresAt is nil for keys that do not expire
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// HasScope reports whether the key grants the scope; admin grants every scope and write grants read
+func (k APIKey) HasScope(scope APIKeyScope) bool {
+	return scopesGrant(k.Scopes, scope)
+}
+
+// IsValidAt reports whether the key is neither revoked nor expired at the given time
+func (k APIKey) IsValidAt(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+func scopesGrant(granted []APIKeyScope, scope APIKeyScope) bool {
+	for _, g := range granted {
+		switch {
+		case g == scope, g == APIKeyScopeAdmin:
+			return true
+		case g == APIKeyScopeWrite && scope == APIKeyScopeRead:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// AuditAction represents the kind of an audited state change
+type AuditAction string
+
+const (
+	AuditTeamCreated               AuditAction = "team.created"
+	AuditTeamReviewSLAChanged      AuditAction = "team.review_sla_changed"
+	AuditTeamEscalationChanged     AuditAction = "team.escalation_threshold_changed"
+	AuditTeamChatWebhookChanged    AuditAction = "team.chat_webhook_changed"
+	AuditUserActivityChanged       AuditAction = "user.activity_changed"
+	AuditUserRoleChanged           AuditAction = "user.role_changed"
+	AuditUserDigestSettingsChanged AuditAction = "user.digest_settings_changed"
+	AuditPRCreated                 AuditAction = "pull_request.created"
+	AuditPRMerged                  AuditAction = "pull_request.merged"
+	AuditPRClosed                  AuditAction = "pull_request.closed"
+	AuditPRReopened                AuditAction = "pull_request.reopened"
+	AuditReviewerReassigned        AuditAction = "pull_request.reviewer_reassigned"
+	AuditReviewRecorded            AuditAction = "pull_request.review_recorded"
+	AuditAPIKeyCreated             AuditAction = "api_key.created"
+	AuditAPIKeyRevoked             AuditAction = "api_key.revoked"
+	AuditIdentitySet               AuditAction = "identity.set"
+	AuditIdentityDeleted           AuditAction = "identity.deleted"
+	AuditWebhookCreated            AuditAction = "webhook.created"
+	AuditWebhookDeleted            AuditAction = "webhook.deleted"
+)
+
+// AuditActions lists every audited action
+func AuditActions() []AuditAction {
+	return []AuditAction{
+		AuditTeamCreated, AuditTeamReviewSLAChanged, AuditTeamEscalationChanged, AuditTeamChatWebhookChanged,
+		AuditUserActivityChanged, AuditUserRoleChanged, AuditUserDigestSettingsChanged,
+		AuditPRCreated, AuditPRMerged, AuditPRClosed, AuditPRReopened, AuditReviewerReassigned, AuditReviewRecorded,
+		AuditAPIKeyCreated, AuditAPIKeyRevoked, AuditIdentitySet, AuditIdentityDeleted, AuditWebhookCreated, AuditWebhookDeleted,
+	}
+}
+
+// AuditEntityType represents the kind of object an audited change applies to
+type AuditEntityType string
+
+const (
+	AuditEntityTeam        AuditEntityType = "team"
+	AuditEntityUser        AuditEntityType = "user"
+	AuditEntityPullRequest AuditEntityType = "pull_request"
+	AuditEntityAPIKey      AuditEntityType = "api_key"
+	AuditEntityIdentity    AuditEntityType = "identity"
+	AuditEntityWebhook     AuditEntityType = "webhook"
+)
+
+// AuditEntry represents a recorded state change; Before and After are JSON snapshots of the changed object,
+// Before is empty for creations
+type AuditEntry struct {
+	AuditID    int64           `json:"audit_id"`
+	Action     AuditAction     `json:"action"`
+	EntityType AuditEntityType `json:"entity_type"`
+	EntityID   string          `json:"entity_id"`
+	Actor      string          `json:"actor,omitempty"`
+	RequestID  string          `json:"request_id,omitempty"`
+	Before     json.RawMessage `json:"before,omitempty"`
+	After      json.RawMessage `json:"after,omitempty"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+// AuditFilter describes criteria for listing audit entries; zero values mean "any"
+type AuditFilter struct {
+	Actor      string
+	Action     AuditAction
+	EntityType AuditEntityType
+	EntityID   string
+	RequestID  string
+	From       *time.Time
+	To         *time.Time
+}
+
+// AuditPage represents a page of audit entries, newest first, with a cursor to the next page
+type AuditPage struct {
+	Entries    []AuditEntry `json:"entries"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+// AuditCursor returns the pagination cursor pointing at the given entry
+func AuditCursor(e AuditEntry) Cursor {
+	return Cursor{Time: e.CreatedAt, ID: strconv.FormatInt(e.AuditID, 10)}
+}
+
+// UserRoleSnapshot is the audited state of a user's role
+type UserRoleSnapshot struct {
+	UserID string `json:"user_id"`
+	Role   Role   `json:"role"`
+}
+
+// TeamSettingsSnapshot is the audited state of a team's settings; the chat webhook URL is a credential,
+// so only whether one is configured is recorded
+type TeamSettingsSnapshot struct {
+	TeamName              string `json:"team_name"`
+	ReviewSLASeconds      *int   `json:"review_sla_seconds"`
+	EscalationSeconds     *int   `json:"escalation_seconds"`
+	ChatWebhookConfigured bool   `json:"chat_webhook_configured"`
+}
+
+// ReviewerSnapshot is the audited state of a reviewer's assignment to a pull request
+type ReviewerSnapshot struct {
+	PullRequestID string             `json:"pull_request_id"`
+	ReviewerID    string             `json:"reviewer_id"`
+	AssignedAt    *time.Time         `json:"assigned_at,omitempty"`
+	ReviewOutcome *ReviewOutcome     `json:"review_outcome,omitempty"`
+	ReviewedAt    *time.Time         `json:"reviewed_at,omitempty"`
+	Reason        ReassignmentReason `json:"reason,omitempty"`
+}
+
+// AuditMeta identifies who made a change and within which request
+type AuditMeta struct {
+	Actor     string
+	RequestID string
+}
+
+// AuditMetaKey is the context key under which AuditMeta is stored. HTTP handlers pass the fasthttp request
+// context, so the HTTP middleware stores it as a user value under this key rather than via WithAuditMeta.
+type AuditMetaKey struct{}
+
+// WithAuditMeta returns a copy of ctx carrying the audit metadata
+func WithAuditMeta(ctx context.Context, meta AuditMeta) context.Context {
+	return context.WithValue(ctx, AuditMetaKey{}, meta)
+}
+
+// AuditMetaFromContext returns the audit metadata carried by ctx; changes made outside of a request
+// (background jobs, admin commands) carry none
+func AuditMetaFromContext(ctx context.Context) AuditMeta {
+	meta, _ := ctx.Value(AuditMetaKey{}).(AuditMeta)
+
+	return meta
+}
@@ -0,0 +1,13 @@
+package entity
+
+// ChatMessage represents a Slack/Mattermost-compatible incoming-webhook payload
+type ChatMessage struct {
+	Text string `json:"text"`
+}
+
+// ChatNotificationResult represents the outcome of one overdue notification sweep
+type ChatNotificationResult struct {
+	Overdue  int `json:"overdue"`
+	Notified int `json:"notified"`
+	Failed   int `json:"failed"`
+}
@@ -0,0 +1,32 @@
+package entity
+
+// DigestSettings represents a user's email digest preferences
+type DigestSettings struct {
+	UserID string `json:"user_id"`
+	// Email is the digest address, empty when none is set
+	Email         string `json:"email"`
+	DigestEnabled bool   `json:"digest_enabled"`
+}
+
+// DigestRecipient represents an active user due for a review digest
+type DigestRecipient struct {
+	UserID   string
+	Username string
+	Email    string
+}
+
+// Email represents an outgoing email with alternative plain-text and HTML bodies
+type Email struct {
+	To       string
+	Subject  string
+	TextBody string
+	HTMLBody string
+}
+
+// DigestResult represents the outcome of one digest run
+type DigestResult struct {
+	Due     int `json:"due"`
+	Sent    int `json:"sent"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
@@ -4,43 +4,89 @@ import "errors"
 
 // Domain errors
 var (
-	ErrTeamExists   = errors.New("team_name already exists")
-	ErrPRExists     = errors.New("PR id already exists")
-	ErrPRMerged     = errors.New("cannot reassign on merged PR")
-	ErrNotAssigned  = errors.New("reviewer is not assigned to this PR")
-	ErrNoCandidate  = errors.New("no active replacement candidate in team")
-	ErrNotFound     = errors.New("resource not found")
+	ErrTeamExists    = errors.New("team_name already exists")
+	ErrPRExists      = errors.New("PR id already exists")
+	ErrPRMerged      = errors.New("cannot reassign on merged PR")
+	ErrPRClosed      = errors.New("PR is closed")
+	ErrNotAssigned   = errors.New("reviewer is not assigned to this PR")
+	ErrNoCandidate   = errors.New("no active replacement candidate in team")
+	ErrNotFound      = errors.New("resource not found")
+	ErrInvalidCursor = errors.New("invalid pagination cursor")
+	ErrInvalidWindow = errors.New("time window start must be before its end")
+
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+	ErrInvalidPayload   = errors.New("invalid webhook payload")
+
+	ErrUnauthorized     = errors.New("missing, invalid, expired or revoked API key or token")
+	ErrForbidden        = errors.New("API key or token lacks the required scope")
+	ErrInsufficientRole = errors.New("caller's role does not permit this action")
+	ErrInvalidExpiry    = errors.New("expires_at must be in the future")
 )
 
 // ErrorCode represents error codes for API responses
 type ErrorCode string
 
 const (
-	ErrorCodeTeamExists  ErrorCode = "TEAM_EXISTS"
-	ErrorCodePRExists    ErrorCode = "PR_EXISTS"
-	ErrorCodePRMerged   ErrorCode = "PR_MERGED"
-	ErrorCodeNotAssigned ErrorCode = "NOT_ASSIGNED"
-	ErrorCodeNoCandidate ErrorCode = "NO_CANDIDATE"
-	ErrorCodeNotFound    ErrorCode = "NOT_FOUND"
+	ErrorCodeTeamExists    ErrorCode = "TEAM_EXISTS"
+	ErrorCodePRExists      ErrorCode = "PR_EXISTS"
+	ErrorCodePRMerged      ErrorCode = "PR_MERGED"
+	ErrorCodePRClosed      ErrorCode = "PR_CLOSED"
+	ErrorCodeNotAssigned   ErrorCode = "NOT_ASSIGNED"
+	ErrorCodeNoCandidate   ErrorCode = "NO_CANDIDATE"
+	ErrorCodeNotFound      ErrorCode = "NOT_FOUND"
+	ErrorCodeInvalidCursor ErrorCode = "INVALID_CURSOR"
+	ErrorCodeInvalidWindow ErrorCode = "INVALID_WINDOW"
+
+	ErrorCodeInvalidSignature ErrorCode = "INVALID_SIGNATURE"
+	ErrorCodeInvalidPayload   ErrorCode = "INVALID_PAYLOAD"
+
+	ErrorCodeUnauthorized  ErrorCode = "UNAUTHORIZED"
+	ErrorCodeForbidden     ErrorCode = "FORBIDDEN"
+	ErrorCodeInvalidExpiry ErrorCode = "INVALID_EXPIRY"
+
+	ErrorCodeInternal ErrorCode = "INTERNAL"
 )
 
-// GetErrorCode returns the error code for a given error
+// _errorCodes maps domain errors to their API codes
+var _errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{ErrTeamExists, ErrorCodeTeamExists},
+	{ErrPRExists, ErrorCodePRExists},
+	{ErrPRMerged, ErrorCodePRMerged},
+	{ErrPRClosed, ErrorCodePRClosed},
+	{ErrNotAssigned, ErrorCodeNotAssigned},
+	{ErrNoCandidate, ErrorCodeNoCandidate},
+	{ErrNotFound, ErrorCodeNotFound},
+	{ErrInvalidCursor, ErrorCodeInvalidCursor},
+	{ErrInvalidWindow, ErrorCodeInvalidWindow},
+	{ErrInvalidSignature, ErrorCodeInvalidSignature},
+	{ErrInvalidPayload, ErrorCodeInvalidPayload},
+	{ErrUnauthorized, ErrorCodeUnauthorized},
+	{ErrForbidden, ErrorCodeForbidden},
+	{ErrInsufficientRole, ErrorCodeForbidden},
+	{ErrInvalidExpiry, ErrorCodeInvalidExpiry},
+}
+
+// GetErrorCode returns the code of the domain error err wraps, or ErrorCodeInternal for any other error
 func GetErrorCode(err error) ErrorCode {
-	switch err {
-	case ErrTeamExists:
-		return ErrorCodeTeamExists
-	case ErrPRExists:
-		return ErrorCodePRExists
-	case ErrPRMerged:
-		return ErrorCodePRMerged
-	case ErrNotAssigned:
-		return ErrorCodeNotAssigned
-	case ErrNoCandidate:
-		return ErrorCodeNoCandidate
-	case ErrNotFound:
-		return ErrorCodeNotFound
-	default:
-		return ErrorCodeNotFound
+	for _, e := range _errorCodes {
+		if errors.Is(err, e.err) {
+			return e.code
+		}
 	}
+
+	return ErrorCodeInternal
 }
 
+// GetErrorMessage returns the message of the domain error err wraps, without the context added by wrapping
+func GetErrorMessage(err error) string {
+	for _, e := range _errorCodes {
+		if errors.Is(err, e.err) {
+			return e.err.Error()
+		}
+	}
+
+	return err.Error()
+}
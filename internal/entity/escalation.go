@@ -0,0 +1,19 @@
+package entity
+
+// ReassignmentReason represents why a reviewer was replaced
+type ReassignmentReason string
+
+const (
+	ReassignmentManual    ReassignmentReason = "MANUAL"
+	ReassignmentAutomatic ReassignmentReason = "AUTOMATIC"
+)
+
+// EscalationResult represents the outcome of a stale review escalation sweep
+type EscalationResult struct {
+	// Stale is the number of assignments found past their team's escalation threshold
+	Stale int `json:"stale"`
+	// Reassigned is the number of assignments handed over to another reviewer
+	Reassigned int `json:"reassigned"`
+	// Skipped is the number of assignments left in place because no replacement was available
+	Skipped int `json:"skipped"`
+}
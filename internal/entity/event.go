@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+// EventType represents the kind of a domain event
+type EventType string
+
+const (
+	EventPRCreated          EventType = "pull_request.created"
+	EventPRMerged           EventType = "pull_request.merged"
+	EventPRReopened         EventType = "pull_request.reopened"
+	EventReviewerAssigned   EventType = "reviewer.assigned"
+	EventReviewerReassigned EventType = "reviewer.reassigned"
+	EventReviewSubmitted    EventType = "review.submitted"
+)
+
+// EventTypes lists every event type the service emits
+func EventTypes() []EventType {
+	return []EventType{EventPRCreated, EventPRMerged, EventPRReopened, EventReviewerAssigned, EventReviewerReassigned, EventReviewSubmitted}
+}
+
+// Event represents a domain event sent to subscribers
+type Event struct {
+	EventID    string      `json:"event_id"`
+	Type       EventType   `json:"type"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// PullRequestEventData is the payload of pull request lifecycle events
+type PullRequestEventData struct {
+	PullRequest PullRequest `json:"pull_request"`
+}
+
+// ReviewerAssignedEventData is the payload of reviewer.assigned events
+type ReviewerAssignedEventData struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+// ReviewerReassignedEventData is the payload of reviewer.reassigned events
+type ReviewerReassignedEventData struct {
+	PullRequestID string             `json:"pull_request_id"`
+	OldReviewerID string             `json:"old_reviewer_id"`
+	NewReviewerID string             `json:"new_reviewer_id"`
+	Reason        ReassignmentReason `json:"reason"`
+}
+
+// ReviewSubmittedEventData is the payload of review.submitted events
+type ReviewSubmittedEventData struct {
+	PullRequestID string        `json:"pull_request_id"`
+	ReviewerID    string        `json:"reviewer_id"`
+	Outcome       ReviewOutcome `json:"outcome"`
+}
@@ -0,0 +1,54 @@
+package entity
+
+import "time"
+
+// HistoryEventType represents the kind of an entry in a pull request's timeline
+type HistoryEventType string
+
+const (
+	HistoryCreated            HistoryEventType = "created"
+	HistoryReviewerAssigned   HistoryEventType = "reviewer_assigned"
+	HistoryReviewerUnassigned HistoryEventType = "reviewer_unassigned"
+	HistoryReviewSubmitted    HistoryEventType = "review_submitted"
+	HistoryMerged             HistoryEventType = "merged"
+	HistoryClosed             HistoryEventType = "closed"
+)
+
+// HistoryEvent represents something that happened to a pull request. UserID is the author for created and
+// the reviewer for reviewer and review events; ReplacedBy and Reason are set when a reviewer was replaced.
+type HistoryEvent struct {
+	Type          HistoryEventType   `json:"type"`
+	OccurredAt    time.Time          `json:"occurred_at"`
+	UserID        string             `json:"user_id,omitempty"`
+	ReplacedBy    string             `json:"replaced_by,omitempty"`
+	Reason        ReassignmentReason `json:"reason,omitempty"`
+	ReviewOutcome *ReviewOutcome     `json:"review_outcome,omitempty"`
+}
+
+// PullRequestHistory represents the ordered timeline of a pull request
+type PullRequestHistory struct {
+	PullRequestID string            `json:"pull_request_id"`
+	Status        PullRequestStatus `json:"status"`
+	Events        []HistoryEvent    `json:"events"`
+}
+
+// AssignmentRecord represents a reviewer's assignment to a pull request, current or replaced; ReplacedBy and
+// Reason are set when the assignment ended in a logged reassignment. Only the latest review submitted
+// within the assignment is kept.
+type AssignmentRecord struct {
+	ReviewerID    string
+	AssignedAt    *time.Time
+	UnassignedAt  *time.Time
+	ReplacedBy    string
+	Reason        ReassignmentReason
+	ReviewOutcome *ReviewOutcome
+	ReviewedAt    *time.Time
+}
+
+// PullRequestRecord represents everything stored about a pull request that its history is built from,
+// with assignments in the order they were made
+type PullRequestRecord struct {
+	PullRequest PullRequest
+	ClosedAt    *time.Time
+	Assignments []AssignmentRecord
+}
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// IdentityProvider represents a code host or the OIDC identity provider whose accounts are mapped to users
+type IdentityProvider string
+
+const (
+	IdentityProviderGitHub IdentityProvider = "github"
+	IdentityProviderGitLab IdentityProvider = "gitlab"
+	// IdentityProviderOIDC maps values of the configured token claim (OIDC_USER_CLAIM) to users
+	IdentityProviderOIDC IdentityProvider = "oidc"
+)
+
+// CodeHostRef locates a pull request on a code host
+type CodeHostRef struct {
+	Provider IdentityProvider
+	// Repository is "<owner>/<repo>" on GitHub and the project path with namespace on GitLab
+	Repository string
+	// Number is the pull request number on GitHub and the merge request IID on GitLab
+	Number int
+}
+
+// ID returns the pull request ID used for PRs ingested from the code host:
+// "<owner>/<repo>#<number>" for GitHub and "<namespace>/<project>!<iid>" for GitLab
+func (r CodeHostRef) ID() string {
+	separator := "#"
+	if r.Provider == IdentityProviderGitLab {
+		separator = "!"
+	}
+
+	return fmt.Sprintf("%s%s%d", r.Repository, separator, r.Number)
+}
+
+// ParseCodeHostRef reverses CodeHostRef.ID; ok is false for PRs that did not come from a code host
+func ParseCodeHostRef(prID string) (ref CodeHostRef, ok bool) {
+	i := strings.LastIndexAny(prID, "#!")
+	if i <= 0 {
+		return CodeHostRef{}, false
+	}
+
+	number, err := strconv.Atoi(prID[i+1:])
+	if err != nil || number <= 0 {
+		return CodeHostRef{}, false
+	}
+
+	repository := prID[:i]
+	if !strings.Contains(repository, "/") || strings.HasPrefix(repository, "/") || strings.HasSuffix(repository, "/") {
+		return CodeHostRef{}, false
+	}
+
+	provider := IdentityProviderGitHub
+	if prID[i] == '!' {
+		provider = IdentityProviderGitLab
+	}
+
+	return CodeHostRef{Provider: provider, Repository: repository, Number: number}, true
+}
+
+// UserIdentity maps a code host login to a user
+type UserIdentity struct {
+	Provider  IdentityProvider `json:"provider"`
+	Login     string           `json:"login"`
+	UserID    string           `json:"user_id"`
+	CreatedAt *time.Time       `json:"created_at,omitempty"`
+}
+
+// ReviewOutcome represents the verdict of a review submitted on the code host
+type ReviewOutcome string
+
+const (
+	ReviewOutcomeApproved         ReviewOutcome = "APPROVED"
+	ReviewOutcomeChangesRequested ReviewOutcome = "CHANGES_REQUESTED"
+	ReviewOutcomeCommented        ReviewOutcome = "COMMENTED"
+)
+
+// IntegrationStatus represents how an incoming code host event was handled
+type IntegrationStatus string
+
+const (
+	IntegrationStatusProcessed IntegrationStatus = "processed"
+	IntegrationStatusIgnored   IntegrationStatus = "ignored"
+)
+
+// IntegrationResult describes the outcome of an incoming code host event
+type IntegrationResult struct {
+	Event         string            `json:"event"`
+	Action        string            `json:"action,omitempty"`
+	Status        IntegrationStatus `json:"status"`
+	PullRequestID string            `json:"pull_request_id,omitempty"`
+	// Reason explains why an event was ignored
+	Reason string `json:"reason,omitempty"`
+}
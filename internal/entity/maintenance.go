@@ -0,0 +1,28 @@
+package entity
+
+import "time"
+
+// Snapshot represents teams and pull requests exported from the service or loaded into it
+type Snapshot struct {
+	ExportedAt   *time.Time    `json:"exported_at,omitempty"`
+	Teams        []Team        `json:"teams"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+// SeedResult represents the outcome of loading a snapshot
+type SeedResult struct {
+	TeamsCreated int `json:"teams_created"`
+	// TeamsSkipped is the number of teams that already existed and were left unchanged
+	TeamsSkipped        int `json:"teams_skipped"`
+	PullRequestsCreated int `json:"pull_requests_created"`
+	// PullRequestsSkipped is the number of pull requests that already existed and were left unchanged
+	PullRequestsSkipped int `json:"pull_requests_skipped"`
+}
+
+// UserReassignment represents the outcome of moving one open review off a user
+type UserReassignment struct {
+	PullRequestID string `json:"pull_request_id"`
+	NewReviewerID string `json:"new_reviewer_id,omitempty"`
+	// Skipped explains why the review stayed with the user, e.g. nobody else in the team is active
+	Skipped string `json:"skipped,omitempty"`
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// OutboxEvent represents a domain event stored in the outbox until it is relayed to the sinks
+type OutboxEvent struct {
+	OutboxID  int64
+	Type      EventType
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
+
+// Event converts the stored row into the event handed to sinks.
+// The event id is derived from the outbox id, so it stays the same when a relay is retried.
+func (e OutboxEvent) Event() Event {
+	return Event{
+		EventID:    "evt-" + strconv.FormatInt(e.OutboxID, 10),
+		Type:       e.Type,
+		OccurredAt: e.CreatedAt,
+		Data:       json.RawMessage(e.Payload),
+	}
+}
+
+// RelayResult represents the outcome of an outbox relay pass
+type RelayResult struct {
+	Published int `json:"published"`
+	Failed    int `json:"failed"`
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Pagination limits
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 100
+)
+
+// SortOrder represents the ordering direction of a listing
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+// PageRequest represents cursor pagination parameters; an empty Order means SortOrderDesc
+type PageRequest struct {
+	Limit  int
+	Cursor string
+	Order  SortOrder
+}
+
+// NormalizedLimit returns the page limit clamped to [1, MaxPageLimit], falling back to DefaultPageLimit
+func (p PageRequest) NormalizedLimit() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+
+	if p.Limit > MaxPageLimit {
+		return MaxPageLimit
+	}
+
+	return p.Limit
+}
+
+// NormalizedOrder returns the sort order, falling back to SortOrderDesc
+func (p PageRequest) NormalizedOrder() SortOrder {
+	if p.Order == SortOrderAsc {
+		return SortOrderAsc
+	}
+
+	return SortOrderDesc
+}
+
+// PageScope identifies the listing a cursor was issued for: its sort order and a digest of its filter.
+// Resuming another listing from the cursor would skip or repeat items, so such a cursor is rejected.
+type PageScope struct {
+	Order  SortOrder `json:"o,omitempty"`
+	Filter string    `json:"f,omitempty"`
+}
+
+// NewPageScope returns the scope of a listing; listings with a fixed order pass an empty order
+func NewPageScope(order SortOrder, filter interface{}) PageScope {
+	data, _ := json.Marshal(filter) //nolint:errchkjson // filters are plain structs
+	sum := sha256.Sum256(data)
+
+	return PageScope{Order: order, Filter: base64.RawURLEncoding.EncodeToString(sum[:8])}
+}
+
+// Cursor represents a keyset pagination position (sort timestamp and ID of the last returned item)
+// within a listing
+type Cursor struct {
+	Time time.Time `json:"t"`
+	ID   string    `json:"id"`
+	PageScope
+}
+
+// Encode returns an opaque string representation of the cursor
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c) //nolint:errchkjson // struct of time and string always marshals
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses an opaque cursor string, returning nil for an empty string; a cursor issued
+// for a listing with another scope yields ErrInvalidCursor
+func DecodeCursor(s string, scope PageScope) (*Cursor, error) {
+	if s == "" {
+		return nil, nil //nolint:nilnil // empty cursor means first page
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c Cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.ID == "" || c.PageScope != scope {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
+
+// TrimPage trims items fetched with limit+1 to the page size and returns the cursor to the next page
+// of the listing with the scope, or an empty cursor if there are no more items
+func TrimPage[T any](items []T, limit int, scope PageScope, cursorOf func(T) Cursor) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+
+	items = items[:limit]
+
+	cursor := cursorOf(items[limit-1])
+	cursor.PageScope = scope
+
+	return items, cursor.Encode()
+}
+
+// PullRequestCursor returns the pagination cursor pointing at the given PR
+func PullRequestCursor(pr PullRequest) Cursor {
+	c := Cursor{ID: pr.PullRequestID}
+	if pr.CreatedAt != nil {
+		c.Time = *pr.CreatedAt
+	}
+
+	return c
+}
@@ -0,0 +1,43 @@
+package entity
+
+// Principal represents the caller of an authenticated request
+type Principal struct {
+	// UserID is the user the caller acts as; it is empty for API keys, which are not bound to a user
+	UserID string
+	// KeyID is set when the caller authenticated with an API key
+	KeyID  string
+	Role   Role
+	Scopes []APIKeyScope
+}
+
+// HasScope reports whether the caller was granted the scope
+func (p Principal) HasScope(scope APIKeyScope) bool {
+	return scopesGrant(p.Scopes, scope)
+}
+
+// Actor names the caller in the audit log: the user ID, or "apikey:" followed by the key ID for API keys
+func (p Principal) Actor() string {
+	if p.UserID != "" {
+		return p.UserID
+	}
+
+	if p.KeyID != "" {
+		return "apikey:" + p.KeyID
+	}
+
+	return ""
+}
+
+// IdentityClaims represents the identity asserted by a verified identity provider token
+type IdentityClaims struct {
+	Subject string
+	Email   string
+}
+
+// UserClaim names the identity provider token claim that identifies the user
+type UserClaim string
+
+const (
+	UserClaimSubject UserClaim = "sub"
+	UserClaimEmail   UserClaim = "email"
+)
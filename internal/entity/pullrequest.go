@@ -8,6 +8,7 @@ type PullRequestStatus string
 const (
 	PullRequestStatusOpen   PullRequestStatus = "OPEN"
 	PullRequestStatusMerged PullRequestStatus = "MERGED"
+	PullRequestStatusClosed PullRequestStatus = "CLOSED"
 )
 
 // PullRequest represents a pull request
@@ -29,3 +30,34 @@ type PullRequestShort struct {
 	Status          PullRequestStatus `json:"status"`
 }
 
+// PullRequestExpand lists related objects that can be embedded into a pull request
+type PullRequestExpand struct {
+	Author    bool
+	Reviewers bool
+}
+
+// PullRequestDetail represents a pull request with optionally embedded author and reviewers
+type PullRequestDetail struct {
+	PullRequest
+	Author    *User  `json:"author,omitempty"`
+	Reviewers []User `json:"reviewers,omitempty"`
+}
+
+// PullRequestFilter describes criteria for listing pull requests; zero values mean "any"
+type PullRequestFilter struct {
+	Status       PullRequestStatus
+	AuthorID     string
+	ReviewerID   string
+	TeamName     string
+	NameContains string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	MergedFrom   *time.Time
+	MergedTo     *time.Time
+}
+
+// PullRequestPage represents a page of pull requests with a cursor to the next page
+type PullRequestPage struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+	NextCursor   string        `json:"next_cursor,omitempty"`
+}
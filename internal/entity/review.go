@@ -0,0 +1,69 @@
+package entity
+
+import "time"
+
+// ReviewQueueQuery describes filters, extra fields and pagination for a reviewer's queue
+type ReviewQueueQuery struct {
+	// Status restricts PRs to the given status; empty means any status
+	Status           PullRequestStatus
+	IncludeReviewers bool
+	IncludeAge       bool
+	Page             PageRequest
+}
+
+// ReviewQueueItem represents a PR in a reviewer's queue with optional extra fields
+type ReviewQueueItem struct {
+	PullRequestShort
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	AssignedReviewers []string   `json:"assigned_reviewers,omitempty"`
+	AgeSeconds        *int64     `json:"age_seconds,omitempty"`
+}
+
+// ReviewQueuePage represents a page of a reviewer's queue with a cursor to the next page
+type ReviewQueuePage struct {
+	PullRequests []ReviewQueueItem `json:"pull_requests"`
+	NextCursor   string            `json:"next_cursor,omitempty"`
+}
+
+// ReviewStatus represents the review progress of a pull request from the author's point of view
+type ReviewStatus string
+
+const (
+	ReviewStatusUnassigned ReviewStatus = "UNASSIGNED"
+	ReviewStatusPending    ReviewStatus = "PENDING"
+	ReviewStatusMerged     ReviewStatus = "MERGED"
+	ReviewStatusClosed     ReviewStatus = "CLOSED"
+)
+
+// ReviewerAssignment represents a reviewer currently assigned to a pull request
+type ReviewerAssignment struct {
+	UserID     string     `json:"user_id"`
+	Username   string     `json:"username"`
+	IsActive   bool       `json:"is_active"`
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+	// ReviewOutcome and ReviewedAt are set once the reviewer has submitted a review on the code host
+	ReviewOutcome *ReviewOutcome `json:"review_outcome,omitempty"`
+	ReviewedAt    *time.Time     `json:"reviewed_at,omitempty"`
+}
+
+// AuthoredPullRequest represents a PR authored by a user with its current reviewers
+type AuthoredPullRequest struct {
+	PullRequestShort
+	CreatedAt    *time.Time           `json:"createdAt,omitempty"`
+	MergedAt     *time.Time           `json:"mergedAt,omitempty"`
+	ReviewStatus ReviewStatus         `json:"review_status"`
+	Reviewers    []ReviewerAssignment `json:"reviewers"`
+}
+
+// AuthoredQuery describes filters and pagination for a user's authored PRs
+type AuthoredQuery struct {
+	// Status restricts PRs to the given status; empty means any status
+	Status PullRequestStatus
+	Page   PageRequest
+}
+
+// AuthoredPage represents a page of authored PRs with a cursor to the next page
+type AuthoredPage struct {
+	PullRequests []AuthoredPullRequest `json:"pull_requests"`
+	NextCursor   string                `json:"next_cursor,omitempty"`
+}
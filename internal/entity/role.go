@@ -0,0 +1,23 @@
+package entity
+
+// Role represents what a user may change
+type Role string
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleTeamLead Role = "team_lead"
+	RoleMember   Role = "member"
+	// RoleBot is a service account; API keys without the admin scope act as bots
+	RoleBot Role = "bot"
+)
+
+// CanManage reports whether the role may create and configure teams, change other users' activity and merge PRs
+func (r Role) CanManage() bool {
+	return r == RoleAdmin || r == RoleTeamLead
+}
+
+// CanActForOthers reports whether the role may name another user as the author, reviewer or digest recipient;
+// members only act as themselves
+func (r Role) CanActForOthers() bool {
+	return r == RoleAdmin || r == RoleTeamLead || r == RoleBot
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// OverdueReview represents a reviewer assignment on an open PR that has exceeded its team's SLA
+type OverdueReview struct {
+	PullRequestShort
+	ReviewerID     string    `json:"reviewer_id"`
+	TeamName       string    `json:"team_name"`
+	AssignedAt     time.Time `json:"assigned_at"`
+	SLASeconds     int64     `json:"sla_seconds"`
+	OverdueSeconds int64     `json:"overdue_seconds"`
+}
+
+// OverdueCount represents the number of overdue reviews of a team
+type OverdueCount struct {
+	TeamName string `json:"team_name"`
+	Count    int    `json:"count"`
+}
@@ -0,0 +1,49 @@
+package entity
+
+import "time"
+
+// TimeWindow represents a half-open time interval [From, To)
+type TimeWindow struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+// ReviewStats represents aggregated review metrics over a time window
+type ReviewStats struct {
+	// Assignments is the number of reviewer assignments made within the window
+	Assignments int `json:"assignments"`
+	// OpenReviews is the number of assignments on currently open PRs
+	OpenReviews int `json:"open_reviews"`
+	// ReassignmentsAway is the number of times a reviewer was replaced within the window
+	ReassignmentsAway int `json:"reassignments_away"`
+	// MedianTimeToMergeSeconds is the median time from assignment to merge for PRs merged within the window
+	MedianTimeToMergeSeconds *float64 `json:"median_time_to_merge_seconds"`
+}
+
+// ReviewerStats represents review metrics of a single reviewer
+type ReviewerStats struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	TeamName string `json:"team_name"`
+	ReviewStats
+}
+
+// TeamStats represents review metrics aggregated over a team's reviewers
+type TeamStats struct {
+	TeamName string `json:"team_name"`
+	ReviewStats
+}
+
+// ReviewerStatsReport represents reviewer metrics together with the window they cover
+type ReviewerStatsReport struct {
+	From      time.Time       `json:"from"`
+	To        time.Time       `json:"to"`
+	Reviewers []ReviewerStats `json:"reviewers"`
+}
+
+// TeamStatsReport represents team metrics together with the window they cover
+type TeamStatsReport struct {
+	From  time.Time   `json:"from"`
+	To    time.Time   `json:"to"`
+	Teams []TeamStats `json:"teams"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import "slices"
+
+// StreamEvent is a domain event annotated with the users and teams it concerns, as sent to event stream subscribers
+type StreamEvent struct {
+	Event
+	UserIDs   []string `json:"user_ids"`
+	TeamNames []string `json:"team_names"`
+}
+
+// StreamFilter restricts an event stream to events concerning a team and/or a user; empty fields match any event
+type StreamFilter struct {
+	TeamName string
+	UserID   string
+}
+
+// Matches reports whether the event passes the filter
+func (f StreamFilter) Matches(event StreamEvent) bool {
+	if f.TeamName != "" && !slices.Contains(event.TeamNames, f.TeamName) {
+		return false
+	}
+
+	if f.UserID != "" && !slices.Contains(event.UserIDs, f.UserID) {
+		return false
+	}
+
+	return true
+}
@@ -13,3 +13,15 @@ type Team struct {
 	Members  []TeamMember `json:"members"`
 }
 
+// TeamSummary represents a team with member statistics
+type TeamSummary struct {
+	TeamName    string `json:"team_name"`
+	MemberCount int    `json:"member_count"`
+	ActiveCount int    `json:"active_count"`
+	// ReviewSLASeconds is the team's review SLA, nil when the service default applies
+	ReviewSLASeconds *int `json:"review_sla_seconds"`
+	// EscalationSeconds is the age after which stale reviews are reassigned, nil when the service default applies
+	EscalationSeconds *int `json:"escalation_seconds"`
+	// ChatWebhookConfigured reports whether the team has a chat channel for notifications
+	ChatWebhookConfigured bool `json:"chat_webhook_configured"`
+}
@@ -8,3 +8,19 @@ type User struct {
 	IsActive bool   `json:"is_active"`
 }
 
+// UserFilter describes criteria for listing users; zero values mean "any"
+type UserFilter struct {
+	TeamName string
+	IsActive *bool
+}
+
+// UserPage represents a page of users with a cursor to the next page
+type UserPage struct {
+	Users      []User `json:"users"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// UserCursor returns the pagination cursor pointing at the given user
+func UserCursor(u User) Cursor {
+	return Cursor{ID: u.UserID}
+}
@@ -0,0 +1,63 @@
+package entity
+
+import "time"
+
+// DeliveryStatus represents the state of a webhook delivery
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   DeliveryStatus = "PENDING"
+	DeliveryStatusSucceeded DeliveryStatus = "SUCCEEDED"
+	DeliveryStatusFailed    DeliveryStatus = "FAILED"
+)
+
+// Webhook represents a subscriber URL for outbound events
+type Webhook struct {
+	WebhookID string `json:"webhook_id"`
+	URL       string `json:"url"`
+	// Secret signs deliveries; it is only returned when the webhook is created
+	Secret string `json:"secret,omitempty"`
+	// EventTypes limits deliveries to the listed types; empty means all events
+	EventTypes []EventType `json:"event_types"`
+	IsActive   bool        `json:"is_active"`
+	CreatedAt  *time.Time  `json:"created_at,omitempty"`
+}
+
+// WebhookDelivery represents one event sent to one webhook
+type WebhookDelivery struct {
+	DeliveryID     int64          `json:"delivery_id"`
+	WebhookID      string         `json:"webhook_id"`
+	EventID        string         `json:"event_id"`
+	EventType      EventType      `json:"event_type"`
+	Status         DeliveryStatus `json:"status"`
+	Attempts       int            `json:"attempts"`
+	LastStatusCode *int           `json:"last_status_code,omitempty"`
+	LastError      *string        `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time     `json:"next_attempt_at,omitempty"`
+	CreatedAt      *time.Time     `json:"created_at,omitempty"`
+	DeliveredAt    *time.Time     `json:"delivered_at,omitempty"`
+}
+
+// WebhookDeliveryTask represents a claimed delivery together with what is needed to send it
+type WebhookDeliveryTask struct {
+	WebhookDelivery
+	URL     string
+	Secret  string
+	Payload []byte
+}
+
+// WebhookRequest represents a signed outbound webhook call
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	EventType  EventType
+	DeliveryID int64
+	Payload    []byte
+}
+
+// DeliveryResult represents the outcome of a delivery pass
+type DeliveryResult struct {
+	Succeeded int `json:"succeeded"`
+	Retrying  int `json:"retrying"`
+	Failed    int `json:"failed"`
+}
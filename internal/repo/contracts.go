@@ -3,6 +3,7 @@ package repo
 
 import (
 	"context"
+	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
 )
@@ -15,26 +16,131 @@ type (
 		CreateTeam(ctx context.Context, team entity.Team) error
 		GetTeam(ctx context.Context, teamName string) (entity.Team, error)
 		TeamExists(ctx context.Context, teamName string) (bool, error)
+		ListTeams(ctx context.Context) ([]entity.TeamSummary, error)
+		SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error
+		SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error
+		SetChatWebhookURL(ctx context.Context, teamName string, url *string) error
+		GetChatWebhookURL(ctx context.Context, teamName string) (string, error)
 	}
 
 	// UserRepo defines user repository interface.
 	UserRepo interface {
 		CreateOrUpdateUser(ctx context.Context, user entity.User) error
 		GetUser(ctx context.Context, userID string) (entity.User, error)
+		GetUserRole(ctx context.Context, userID string) (entity.Role, error)
+		SetUserRole(ctx context.Context, userID string, role entity.Role) error
+		GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error)
+		GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error)
 		SetIsActive(ctx context.Context, userID string, isActive bool) error
 		GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error)
-		GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error)
+		ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error)
+		SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error
+		GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error)
+		MarkDigestSent(ctx context.Context, userID string, day time.Time) error
 	}
 
 	// PullRequestRepo defines pull request repository interface.
 	PullRequestRepo interface {
 		CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error
 		GetPR(ctx context.Context, prID string) (entity.PullRequest, error)
+		GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error)
 		PRExists(ctx context.Context, prID string) (bool, error)
 		UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error
 		GetPRReviewers(ctx context.Context, prID string) ([]string, error)
-		ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error
+		ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error
 		GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error)
+		ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error)
+		GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error)
+		GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error)
+		GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error)
+		CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error)
+		GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error)
+		GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error)
+		MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error
+		RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error
+	}
+
+	// StatsRepo defines aggregated statistics repository interface.
+	StatsRepo interface {
+		GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) ([]entity.ReviewerStats, error)
+		GetTeamStats(ctx context.Context, window entity.TimeWindow) ([]entity.TeamStats, error)
+	}
+
+	// LockRepo defines cross-instance locking interface.
+	LockRepo interface {
+		WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)
+	}
+
+	// WebhookRepo defines webhook subscription and delivery repository interface.
+	WebhookRepo interface {
+		CreateWebhook(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error)
+		ListWebhooks(ctx context.Context) ([]entity.Webhook, error)
+		DeleteWebhook(ctx context.Context, webhookID string) error
+		EnqueueDeliveries(ctx context.Context, eventID string, eventType entity.EventType, payload []byte) (int64, error)
+		ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.WebhookDeliveryTask, error)
+		UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery, retryIn time.Duration) error
+		ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error)
+	}
+
+	// OutboxRepo defines transactional outbox repository interface.
+	OutboxRepo interface {
+		GetUnpublishedEvents(ctx context.Context, limit int) ([]entity.OutboxEvent, error)
+		MarkPublished(ctx context.Context, outboxID int64) error
+		MarkFailed(ctx context.Context, outboxID int64, lastError string) error
+	}
+
+	// AuditRepo defines audit log repository interface; entries are written by the other repositories
+	// together with the changes they describe.
+	AuditRepo interface {
+		ListEntries(ctx context.Context, filter entity.AuditFilter, after *entity.Cursor, limit int) ([]entity.AuditEntry, error)
+	}
+
+	// IdentityRepo defines code host identity mapping repository interface.
+	IdentityRepo interface {
+		SetIdentity(ctx context.Context, identity entity.UserIdentity) error
+		GetUserIDByLogin(ctx context.Context, provider entity.IdentityProvider, login string) (string, error)
+		GetLoginByUserID(ctx context.Context, provider entity.IdentityProvider, userID string) (string, error)
+		ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error)
+		DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error
+	}
+
+	// TokenVerifier defines identity provider token verification interface.
+	TokenVerifier interface {
+		Verify(ctx context.Context, token string) (entity.IdentityClaims, error)
+	}
+
+	// APIKeyRepo defines API key repository interface.
+	APIKeyRepo interface {
+		CreateAPIKey(ctx context.Context, key entity.APIKey) (entity.APIKey, error)
+		GetAPIKeyByHash(ctx context.Context, tokenHash string) (entity.APIKey, error)
+		ListAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+		RevokeAPIKey(ctx context.Context, keyID string) error
+	}
+
+	// CodeHostClient defines code host API interface for requesting reviews on pull requests.
+	CodeHostClient interface {
+		RequestReviewers(ctx context.Context, ref entity.CodeHostRef, add []string, remove []string) error
+	}
+
+	// ChatSender defines chat incoming-webhook transport interface.
+	ChatSender interface {
+		Send(ctx context.Context, url string, message entity.ChatMessage) error
+	}
+
+	// EventBusRepo defines the interface for broadcasting stream events to every service instance.
+	EventBusRepo interface {
+		Notify(ctx context.Context, event entity.StreamEvent) error
+		Listen(ctx context.Context, handle func(event entity.StreamEvent)) error
+	}
+
+	// MailSender defines outgoing email transport interface.
+	MailSender interface {
+		Send(ctx context.Context, email entity.Email) error
+	}
+
+	// WebhookSender defines outbound webhook transport interface.
+	WebhookSender interface {
+		Send(ctx context.Context, request entity.WebhookRequest) (int, error)
 	}
 )
 
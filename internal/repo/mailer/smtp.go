@@ -0,0 +1,200 @@
+// Package mailer implements repositories that deliver email.
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+const _defaultTimeout = 10 * time.Second
+
+var errInvalidHeader = errors.New("header value contains a line break")
+
+// SMTPSender delivers email through an SMTP relay, upgrading to TLS when the server offers STARTTLS.
+type SMTPSender struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+	timeout  time.Duration
+	now      func() time.Time
+}
+
+// NewSMTPSender creates a new SMTPSender for the relay at host:port sending as from (e.g. "PR Reviews <noreply@example.com>");
+// an empty username disables authentication and a non-positive timeout falls back to 10 seconds.
+func NewSMTPSender(host string, port int, username, password, from string, timeout time.Duration) *SMTPSender {
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	return &SMTPSender{
+		addr:     net.JoinHostPort(host, strconv.Itoa(port)),
+		host:     host,
+		username: username,
+		password: password,
+		from:     from,
+		timeout:  timeout,
+		now:      time.Now,
+	}
+}
+
+// Send delivers the email as a multipart/alternative message with plain-text and HTML bodies.
+func (s *SMTPSender) Send(ctx context.Context, email entity.Email) error {
+	if strings.ContainsAny(email.To+email.Subject, "\r\n") {
+		return fmt.Errorf("SMTPSender - Send: %w", errInvalidHeader)
+	}
+
+	from, err := mail.ParseAddress(s.from)
+	if err != nil {
+		return fmt.Errorf("SMTPSender - Send - ParseAddress from: %w", err)
+	}
+
+	message, err := s.buildMessage(from, email)
+	if err != nil {
+		return fmt.Errorf("SMTPSender - Send - buildMessage: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("SMTPSender - Send - Dial: %w", err)
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return fmt.Errorf("SMTPSender - Send - SetDeadline: %w", err)
+	}
+
+	client, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return fmt.Errorf("SMTPSender - Send - NewClient: %w", err)
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err = client.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return fmt.Errorf("SMTPSender - Send - StartTLS: %w", err)
+		}
+	}
+
+	if s.username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("SMTPSender - Send - Auth: %w", err)
+		}
+	}
+
+	if err = client.Mail(from.Address); err != nil {
+		return fmt.Errorf("SMTPSender - Send - Mail: %w", err)
+	}
+
+	if err = client.Rcpt(email.To); err != nil {
+		return fmt.Errorf("SMTPSender - Send - Rcpt: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("SMTPSender - Send - Data: %w", err)
+	}
+
+	if _, err = w.Write(message); err != nil {
+		return fmt.Errorf("SMTPSender - Send - Write: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("SMTPSender - Send - Close: %w", err)
+	}
+
+	if err = client.Quit(); err != nil {
+		return fmt.Errorf("SMTPSender - Send - Quit: %w", err)
+	}
+
+	return nil
+}
+
+// buildMessage renders the headers and the quoted-printable plain-text and HTML parts
+func (s *SMTPSender) buildMessage(from *mail.Address, email entity.Email) ([]byte, error) {
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=utf-8", content: email.TextBody},
+		{contentType: "text/html; charset=utf-8", content: email.HTMLBody},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("CreatePart: %w", err)
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err = qp.Write([]byte(part.content)); err != nil {
+			return nil, fmt.Errorf("Write part: %w", err)
+		}
+
+		if err = qp.Close(); err != nil {
+			return nil, fmt.Errorf("Close part: %w", err)
+		}
+	}
+
+	if err := parts.Close(); err != nil {
+		return nil, fmt.Errorf("Close: %w", err)
+	}
+
+	var message bytes.Buffer
+	for _, header := range [][2]string{
+		{"From", from.String()},
+		{"To", email.To},
+		{"Subject", mime.QEncoding.Encode("utf-8", email.Subject)},
+		{"Date", s.now().Format(time.RFC1123Z)},
+		{"Message-ID", messageID(from.Address)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "multipart/alternative; boundary=" + parts.Boundary()},
+	} {
+		fmt.Fprintf(&message, "%s: %s\r\n", header[0], header[1])
+	}
+
+	message.WriteString("\r\n")
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+// messageID returns a unique Message-ID in the domain of the sender address
+func messageID(address string) string {
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+
+	domain := "localhost"
+	if at := strings.LastIndex(address, "@"); at >= 0 {
+		domain = address[at+1:]
+	}
+
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">"
+}
@@ -0,0 +1,205 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSMTP is a minimal SMTP stand-in that accepts every message and records the envelope and data
+type fakeSMTP struct {
+	listener net.Listener
+	rejectTo string
+
+	mu   sync.Mutex
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &fakeSMTP{listener: listener}
+	go server.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+
+	return server
+}
+
+func (s *fakeSMTP) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+
+	reply("220 localhost ESMTP fake")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		command := strings.TrimRight(line, "\r\n")
+		switch verb := strings.ToUpper(strings.SplitN(command, " ", 2)[0]); verb {
+		case "EHLO", "HELO":
+			reply("250 localhost")
+		case "MAIL":
+			s.mu.Lock()
+			s.from = strings.TrimPrefix(command, "MAIL FROM:")
+			s.mu.Unlock()
+			reply("250 OK")
+		case "RCPT":
+			to := strings.TrimPrefix(command, "RCPT TO:")
+			if s.rejectTo != "" && strings.Contains(to, s.rejectTo) {
+				reply("550 no such user")
+
+				continue
+			}
+
+			s.mu.Lock()
+			s.to = append(s.to, to)
+			s.mu.Unlock()
+			reply("250 OK")
+		case "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+
+			var data strings.Builder
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+
+				if dataLine == ".\r\n" {
+					break
+				}
+
+				data.WriteString(dataLine)
+			}
+
+			s.mu.Lock()
+			s.data = data.String()
+			s.mu.Unlock()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSMTPSender_SendMultipartMessage(t *testing.T) {
+	server := newFakeSMTP(t)
+	sender := NewSMTPSender("127.0.0.1", server.port(), "", "", "PR Reviews <noreply@example.com>", time.Second)
+
+	err := sender.Send(context.Background(), entity.Email{
+		To:       "alice@example.com",
+		Subject:  "Ревью: 2 PR ждут вас",
+		TextBody: "Hello, Alice\n",
+		HTMLBody: "<p>Hello, Alice</p>",
+	})
+	require.NoError(t, err)
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	assert.Equal(t, "<noreply@example.com>", server.from)
+	assert.Equal(t, []string{"<alice@example.com>"}, server.to)
+
+	message, err := mail.ReadMessage(strings.NewReader(server.data))
+	require.NoError(t, err)
+	assert.Equal(t, `"PR Reviews" <noreply@example.com>`, message.Header.Get("From"))
+	assert.Equal(t, "alice@example.com", message.Header.Get("To"))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Ревью: 2 PR ждут вас", subject)
+
+	mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	bodies := map[string]string{}
+	parts := multipart.NewReader(message.Body, params["boundary"])
+	for {
+		part, err := parts.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		// NextPart decodes quoted-printable transparently
+		content, err := io.ReadAll(part)
+		require.NoError(t, err)
+		bodies[part.Header.Get("Content-Type")] = string(content)
+	}
+
+	assert.Equal(t, map[string]string{
+		"text/plain; charset=utf-8": "Hello, Alice\r\n",
+		"text/html; charset=utf-8":  "<p>Hello, Alice</p>",
+	}, bodies)
+}
+
+func TestSMTPSender_SendRejectedRecipient(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.rejectTo = "ghost@example.com"
+	sender := NewSMTPSender("127.0.0.1", server.port(), "", "", "noreply@example.com", time.Second)
+
+	err := sender.Send(context.Background(), entity.Email{To: "ghost@example.com", Subject: "Digest"})
+
+	assert.Error(t, err)
+}
+
+func TestSMTPSender_SendRejectsHeaderInjection(t *testing.T) {
+	sender := NewSMTPSender("127.0.0.1", 1, "", "", "noreply@example.com", time.Second)
+
+	err := sender.Send(context.Background(), entity.Email{To: "alice@example.com\r\nBcc: eve@example.com", Subject: "Digest"})
+
+	assert.ErrorIs(t, err, errInvalidHeader)
+}
+
+func TestSMTPSender_SendUnreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	_ = listener.Close()
+
+	err = NewSMTPSender("127.0.0.1", port, "", "", "noreply@example.com", time.Second).
+		Send(context.Background(), entity.Email{To: "alice@example.com"})
+
+	assert.Error(t, err)
+}
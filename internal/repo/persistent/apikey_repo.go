@@ -0,0 +1,215 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// APIKeyRepo handles API keys.
+type APIKeyRepo struct {
+	*postgres.Postgres
+}
+
+// NewAPIKeyRepo creates a new APIKeyRepo instance.
+func NewAPIKeyRepo(pg *postgres.Postgres) *APIKeyRepo {
+	return &APIKeyRepo{pg}
+}
+
+// CreateAPIKey stores an API key and records it in the audit log; the token itself is kept only as its hash
+func (r *APIKeyRepo) CreateAPIKey(ctx context.Context, key entity.APIKey) (entity.APIKey, error) {
+	// TIMESTAMP columns hold UTC wall-clock times
+	var expiresAt *time.Time
+	if key.ExpiresAt != nil {
+		utc := key.ExpiresAt.UTC()
+		expiresAt = &utc
+	}
+
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - CreateAPIKey - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Insert("api_keys").
+		Columns("key_id", "name", "token_hash", "token_prefix", "scopes", "expires_at").
+		Values(key.KeyID, key.Name, key.TokenHash, key.TokenPrefix, scopesToStrings(key.Scopes), expiresAt).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - CreateAPIKey - BuildInsert: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&key.CreatedAt)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - CreateAPIKey - QueryRow: %w", err)
+	}
+
+	key.ExpiresAt = expiresAt
+
+	// The token must not end up in the log
+	after := key
+	after.Token = ""
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditAPIKeyCreated, entity.AuditEntityAPIKey, key.KeyID, nil, after)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - CreateAPIKey - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - CreateAPIKey - Commit: %w", err)
+	}
+
+	return key, nil
+}
+
+// GetAPIKeyByHash retrieves the API key whose token has the given hash, including revoked and expired keys
+func (r *APIKeyRepo) GetAPIKeyByHash(ctx context.Context, tokenHash string) (entity.APIKey, error) {
+	sql, args, err := r.Builder.
+		Select("key_id", "name", "token_prefix", "scopes", "expires_at", "created_at", "revoked_at").
+		From("api_keys").
+		Where("token_hash = ?", tokenHash).
+		ToSql()
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - GetAPIKeyByHash - BuildSelect: %w", err)
+	}
+
+	key, err := scanAPIKey(r.Pool.QueryRow(ctx, sql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.APIKey{}, entity.ErrNotFound
+	}
+
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyRepo - GetAPIKeyByHash - Scan: %w", err)
+	}
+
+	return key, nil
+}
+
+// ListAPIKeys retrieves all API keys, newest first
+func (r *APIKeyRepo) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	sql, args, err := r.Builder.
+		Select("key_id", "name", "token_prefix", "scopes", "expires_at", "created_at", "revoked_at").
+		From("api_keys").
+		OrderBy("created_at DESC", "key_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("APIKeyRepo - ListAPIKeys - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("APIKeyRepo - ListAPIKeys - Query: %w", err)
+	}
+	defer rows.Close()
+
+	keys := make([]entity.APIKey, 0)
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("APIKeyRepo - ListAPIKeys - Scan: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("APIKeyRepo - ListAPIKeys - RowsErr: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey marks an API key as revoked and records it in the audit log; revoking it again keeps
+// the original revocation time and is not recorded
+func (r *APIKeyRepo) RevokeAPIKey(ctx context.Context, keyID string) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Select("key_id", "name", "token_prefix", "scopes", "expires_at", "created_at", "revoked_at").
+		From("api_keys").
+		Where("key_id = ?", keyID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - BuildSelect: %w", err)
+	}
+
+	before, err := scanAPIKey(tx.QueryRow(ctx, sql, args...))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - Scan: %w", err)
+	}
+
+	if before.RevokedAt != nil {
+		return nil
+	}
+
+	sql, args, err = r.Builder.
+		Update("api_keys").
+		Set("revoked_at", squirrel.Expr("LOCALTIMESTAMP")).
+		Where("key_id = ?", keyID).
+		Suffix("RETURNING revoked_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - BuildUpdate: %w", err)
+	}
+
+	after := before
+	err = tx.QueryRow(ctx, sql, args...).Scan(&after.RevokedAt)
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - Update: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditAPIKeyRevoked, entity.AuditEntityAPIKey, keyID, before, after)
+	if err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("APIKeyRepo - RevokeAPIKey - Commit: %w", err)
+	}
+
+	return nil
+}
+
+func scanAPIKey(row pgx.Row) (entity.APIKey, error) {
+	var (
+		key    entity.APIKey
+		scopes []string
+	)
+
+	err := row.Scan(&key.KeyID, &key.Name, &key.TokenPrefix, &scopes, &key.ExpiresAt, &key.CreatedAt, &key.RevokedAt)
+	if err != nil {
+		return entity.APIKey{}, err
+	}
+
+	key.Scopes = make([]entity.APIKeyScope, 0, len(scopes))
+	for _, scope := range scopes {
+		key.Scopes = append(key.Scopes, entity.APIKeyScope(scope))
+	}
+
+	return key, nil
+}
+
+func scopesToStrings(scopes []entity.APIKeyScope) []string {
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		result = append(result, string(scope))
+	}
+
+	return result
+}
@@ -0,0 +1,181 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuditRepo handles the append-only audit log of state changes.
+type AuditRepo struct {
+	*postgres.Postgres
+}
+
+// NewAuditRepo creates a new AuditRepo instance.
+func NewAuditRepo(pg *postgres.Postgres) *AuditRepo {
+	return &AuditRepo{pg}
+}
+
+// ListEntries retrieves up to limit audit entries matching the filter, newest first, starting after the cursor
+func (r *AuditRepo) ListEntries(ctx context.Context, filter entity.AuditFilter, after *entity.Cursor, limit int) ([]entity.AuditEntry, error) {
+	builder := r.Builder.
+		Select("audit_id", "action", "entity_type", "entity_id", "actor", "request_id", "before::text", "after::text", "created_at").
+		From("audit_log").
+		OrderBy("audit_id DESC").
+		Limit(uint64(limit)) //nolint:gosec // limit is normalized to a positive value by the caller
+
+	if filter.Actor != "" {
+		builder = builder.Where(squirrel.Eq{"actor": filter.Actor})
+	}
+
+	if filter.Action != "" {
+		builder = builder.Where(squirrel.Eq{"action": filter.Action})
+	}
+
+	if filter.EntityType != "" {
+		builder = builder.Where(squirrel.Eq{"entity_type": filter.EntityType})
+	}
+
+	if filter.EntityID != "" {
+		builder = builder.Where(squirrel.Eq{"entity_id": filter.EntityID})
+	}
+
+	if filter.RequestID != "" {
+		builder = builder.Where(squirrel.Eq{"request_id": filter.RequestID})
+	}
+
+	if filter.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": *filter.From})
+	}
+
+	if filter.To != nil {
+		builder = builder.Where(squirrel.Lt{"created_at": *filter.To})
+	}
+
+	if after != nil {
+		auditID, err := strconv.ParseInt(after.ID, 10, 64)
+		if err != nil {
+			return nil, entity.ErrInvalidCursor
+		}
+
+		builder = builder.Where(squirrel.Lt{"audit_id": auditID})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepo - ListEntries - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("AuditRepo - ListEntries - Query: %w", err)
+	}
+	defer rows.Close()
+
+	entries := make([]entity.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			entry                            entity.AuditEntry
+			actor, requestID, before, result *string
+		)
+		if err := rows.Scan(&entry.AuditID, &entry.Action, &entry.EntityType, &entry.EntityID,
+			&actor, &requestID, &before, &result, &entry.CreatedAt); err != nil {
+			return nil, fmt.Errorf("AuditRepo - ListEntries - Scan: %w", err)
+		}
+
+		if actor != nil {
+			entry.Actor = *actor
+		}
+
+		if requestID != nil {
+			entry.RequestID = *requestID
+		}
+
+		if before != nil {
+			entry.Before = json.RawMessage(*before)
+		}
+
+		if result != nil {
+			entry.After = json.RawMessage(*result)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("AuditRepo - ListEntries - RowsErr: %w", err)
+	}
+
+	return entries, nil
+}
+
+// insertAuditEntry records a state change in the audit log as part of the caller's transaction, so the entry
+// exists if and only if the change is committed. The actor and request ID are taken from ctx; a nil snapshot
+// is stored as NULL.
+func insertAuditEntry(
+	ctx context.Context,
+	tx pgx.Tx,
+	builder squirrel.StatementBuilderType,
+	action entity.AuditAction,
+	entityType entity.AuditEntityType,
+	entityID string,
+	before interface{},
+	after interface{},
+) error {
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return fmt.Errorf("Marshal before: %w", err)
+	}
+
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return fmt.Errorf("Marshal after: %w", err)
+	}
+
+	meta := entity.AuditMetaFromContext(ctx)
+
+	sql, args, err := builder.
+		Insert("audit_log").
+		Columns("action", "entity_type", "entity_id", "actor", "request_id", "before", "after").
+		Values(action, entityType, entityID, nullIfEmpty(meta.Actor), nullIfEmpty(meta.RequestID), beforeJSON, afterJSON).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BuildInsert: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("Exec: %w", err)
+	}
+
+	return nil
+}
+
+func auditSnapshot(v interface{}) (*string, error) {
+	if v == nil {
+		return nil, nil //nolint:nilnil // no snapshot is stored as NULL
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	s := string(data)
+
+	return &s, nil
+}
+
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
@@ -0,0 +1,82 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+)
+
+// _eventChannel is the PostgreSQL notification channel stream events are broadcast on
+const _eventChannel = "pr_review_events"
+
+// EventBusRepo broadcasts stream events to every service instance using PostgreSQL LISTEN/NOTIFY.
+type EventBusRepo struct {
+	*postgres.Postgres
+}
+
+// NewEventBusRepo creates a new EventBusRepo instance.
+func NewEventBusRepo(pg *postgres.Postgres) *EventBusRepo {
+	return &EventBusRepo{pg}
+}
+
+// Notify broadcasts the event to the instances listening right now; nothing is stored for the others
+func (r *EventBusRepo) Notify(ctx context.Context, event entity.StreamEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("EventBusRepo - Notify - Marshal: %w", err)
+	}
+
+	_, err = r.Pool.Exec(ctx, "SELECT pg_notify($1, $2)", _eventChannel, string(payload))
+	if err != nil {
+		return fmt.Errorf("EventBusRepo - Notify - pg_notify: %w", err)
+	}
+
+	return nil
+}
+
+// Listen hands every broadcast event to handle until ctx is done, which is not an error.
+// It holds a dedicated connection outside the pool for as long as it runs.
+func (r *EventBusRepo) Listen(ctx context.Context, handle func(event entity.StreamEvent)) error {
+	pooled, err := r.Pool.Acquire(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return fmt.Errorf("EventBusRepo - Listen - Acquire: %w", err)
+	}
+
+	// A listening session must not be reused by other queries, so take it out of the pool for good
+	conn := pooled.Hijack()
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	_, err = conn.Exec(ctx, "LISTEN "+_eventChannel)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return fmt.Errorf("EventBusRepo - Listen - LISTEN: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("EventBusRepo - Listen - WaitForNotification: %w", err)
+		}
+
+		var event entity.StreamEvent
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			return fmt.Errorf("EventBusRepo - Listen - Unmarshal: %w", err)
+		}
+
+		handle(event)
+	}
+}
@@ -0,0 +1,263 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// IdentityRepo handles the mapping between code host accounts and users.
+type IdentityRepo struct {
+	*postgres.Postgres
+}
+
+// NewIdentityRepo creates a new IdentityRepo instance.
+func NewIdentityRepo(pg *postgres.Postgres) *IdentityRepo {
+	return &IdentityRepo{pg}
+}
+
+// SetIdentity maps a login to a user, replacing any other login the user had on the same provider, and records
+// the changed mappings in the audit log
+func (r *IdentityRepo) SetIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Delete("user_identities").
+		Where("provider = ?", identity.Provider).
+		Where("user_id = ?", identity.UserID).
+		Where("login <> ?", identity.Login).
+		Suffix("RETURNING provider, login, user_id, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - BuildDelete: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - Query delete: %w", err)
+	}
+
+	replaced := make([]entity.UserIdentity, 0)
+	for rows.Next() {
+		var old entity.UserIdentity
+		if err := rows.Scan(&old.Provider, &old.Login, &old.UserID, &old.CreatedAt); err != nil {
+			rows.Close()
+
+			return fmt.Errorf("IdentityRepo - SetIdentity - Scan deleted: %w", err)
+		}
+		replaced = append(replaced, old)
+	}
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - RowsErr: %w", err)
+	}
+
+	for _, old := range replaced {
+		err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditIdentityDeleted, entity.AuditEntityIdentity, identityAuditID(old.Provider, old.Login), old, nil)
+		if err != nil {
+			return fmt.Errorf("IdentityRepo - SetIdentity - insertAuditEntry: %w", err)
+		}
+	}
+
+	sql, args, err = r.Builder.
+		Select("provider", "login", "user_id", "created_at").
+		From("user_identities").
+		Where("provider = ?", identity.Provider).
+		Where("login = ?", identity.Login).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - BuildSelect: %w", err)
+	}
+
+	var (
+		current entity.UserIdentity
+		before  interface{}
+	)
+	err = tx.QueryRow(ctx, sql, args...).Scan(&current.Provider, &current.Login, &current.UserID, &current.CreatedAt)
+
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return fmt.Errorf("IdentityRepo - SetIdentity - Scan: %w", err)
+	case current.UserID == identity.UserID:
+		// Already mapped to the user
+		if err = tx.Commit(ctx); err != nil {
+			return fmt.Errorf("IdentityRepo - SetIdentity - Commit: %w", err)
+		}
+
+		return nil
+	default:
+		before = current
+	}
+
+	sql, args, err = r.Builder.
+		Insert("user_identities").
+		Columns("provider", "login", "user_id").
+		Values(identity.Provider, identity.Login, identity.UserID).
+		Suffix("ON CONFLICT (provider, login) DO UPDATE SET user_id = EXCLUDED.user_id RETURNING provider, login, user_id, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - BuildInsert: %w", err)
+	}
+
+	var after entity.UserIdentity
+	err = tx.QueryRow(ctx, sql, args...).Scan(&after.Provider, &after.Login, &after.UserID, &after.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - Insert: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditIdentitySet, entity.AuditEntityIdentity, identityAuditID(after.Provider, after.Login), before, after)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("IdentityRepo - SetIdentity - Commit: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserIDByLogin resolves a code host login to a user ID
+func (r *IdentityRepo) GetUserIDByLogin(ctx context.Context, provider entity.IdentityProvider, login string) (string, error) {
+	sql, args, err := r.Builder.
+		Select("user_id").
+		From("user_identities").
+		Where("provider = ?", provider).
+		Where("login = ?", login).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("IdentityRepo - GetUserIDByLogin - BuildSelect: %w", err)
+	}
+
+	var userID string
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("IdentityRepo - GetUserIDByLogin - Scan: %w", err)
+	}
+
+	return userID, nil
+}
+
+// GetLoginByUserID retrieves the login a user is mapped to on a provider
+func (r *IdentityRepo) GetLoginByUserID(ctx context.Context, provider entity.IdentityProvider, userID string) (string, error) {
+	sql, args, err := r.Builder.
+		Select("login").
+		From("user_identities").
+		Where("provider = ?", provider).
+		Where("user_id = ?", userID).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("IdentityRepo - GetLoginByUserID - BuildSelect: %w", err)
+	}
+
+	var login string
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(&login)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("IdentityRepo - GetLoginByUserID - Scan: %w", err)
+	}
+
+	return login, nil
+}
+
+// ListIdentities retrieves identity mappings ordered by provider and login, optionally for one provider
+func (r *IdentityRepo) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	builder := r.Builder.
+		Select("provider", "login", "user_id", "created_at").
+		From("user_identities").
+		OrderBy("provider", "login")
+
+	if provider != "" {
+		builder = builder.Where(squirrel.Eq{"provider": provider})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("IdentityRepo - ListIdentities - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("IdentityRepo - ListIdentities - Query: %w", err)
+	}
+	defer rows.Close()
+
+	identities := make([]entity.UserIdentity, 0)
+	for rows.Next() {
+		var identity entity.UserIdentity
+		if err := rows.Scan(&identity.Provider, &identity.Login, &identity.UserID, &identity.CreatedAt); err != nil {
+			return nil, fmt.Errorf("IdentityRepo - ListIdentities - Scan: %w", err)
+		}
+		identities = append(identities, identity)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("IdentityRepo - ListIdentities - RowsErr: %w", err)
+	}
+
+	return identities, nil
+}
+
+// DeleteIdentity removes a login mapping and records it in the audit log
+func (r *IdentityRepo) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - DeleteIdentity - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Delete("user_identities").
+		Where("provider = ?", provider).
+		Where("login = ?", login).
+		Suffix("RETURNING provider, login, user_id, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - DeleteIdentity - BuildDelete: %w", err)
+	}
+
+	var before entity.UserIdentity
+	err = tx.QueryRow(ctx, sql, args...).Scan(&before.Provider, &before.Login, &before.UserID, &before.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - DeleteIdentity - Delete: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditIdentityDeleted, entity.AuditEntityIdentity, identityAuditID(provider, login), before, nil)
+	if err != nil {
+		return fmt.Errorf("IdentityRepo - DeleteIdentity - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("IdentityRepo - DeleteIdentity - Commit: %w", err)
+	}
+
+	return nil
+}
+
+// identityAuditID identifies a login mapping in the audit log, e.g. "github:octocat"
+func identityAuditID(provider entity.IdentityProvider, login string) string {
+	return string(provider) + ":" + login
+}
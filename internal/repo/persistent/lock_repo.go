@@ -0,0 +1,51 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/pkg/postgres"
+)
+
+// LockRepo coordinates work across service instances using PostgreSQL session-level advisory locks.
+type LockRepo struct {
+	*postgres.Postgres
+}
+
+// NewLockRepo creates a new LockRepo instance.
+func NewLockRepo(pg *postgres.Postgres) *LockRepo {
+	return &LockRepo{pg}
+}
+
+// WithTryLock runs fn while holding the advisory lock identified by key.
+// It returns false without running fn when another instance holds the lock.
+func (r *LockRepo) WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (acquired bool, err error) {
+	// Advisory locks belong to a session, so acquire and release them on the same connection
+	conn, err := r.Pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("LockRepo - WithTryLock - Acquire: %w", err)
+	}
+	defer conn.Release()
+
+	err = conn.QueryRow(ctx, "SELECT pg_try_advisory_lock($1)", key).Scan(&acquired)
+	if err != nil {
+		return false, fmt.Errorf("LockRepo - WithTryLock - pg_try_advisory_lock: %w", err)
+	}
+
+	if !acquired {
+		return false, nil
+	}
+
+	defer func() {
+		// Unlock even if ctx has been canceled by then
+		_, unlockErr := conn.Exec(context.WithoutCancel(ctx), "SELECT pg_advisory_unlock($1)", key)
+		if unlockErr != nil {
+			// Drop the connection so the server releases the lock together with the session
+			_ = conn.Conn().Close(context.WithoutCancel(ctx))
+			err = errors.Join(err, fmt.Errorf("LockRepo - WithTryLock - pg_advisory_unlock: %w", unlockErr))
+		}
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,127 @@
+package persistent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// OutboxRepo handles domain events waiting in the transactional outbox.
+type OutboxRepo struct {
+	*postgres.Postgres
+}
+
+// NewOutboxRepo creates a new OutboxRepo instance.
+func NewOutboxRepo(pg *postgres.Postgres) *OutboxRepo {
+	return &OutboxRepo{pg}
+}
+
+// GetUnpublishedEvents retrieves up to limit events that have not been relayed yet, oldest first
+func (r *OutboxRepo) GetUnpublishedEvents(ctx context.Context, limit int) ([]entity.OutboxEvent, error) {
+	sql, args, err := r.Builder.
+		Select("outbox_id", "event_type", "payload::text", "attempts", "created_at").
+		From("outbox_events").
+		Where("published_at IS NULL").
+		OrderBy("outbox_id").
+		Limit(uint64(limit)). //nolint:gosec // limit is a positive batch size
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("OutboxRepo - GetUnpublishedEvents - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("OutboxRepo - GetUnpublishedEvents - Query: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]entity.OutboxEvent, 0)
+	for rows.Next() {
+		var (
+			event   entity.OutboxEvent
+			payload string
+		)
+		if err := rows.Scan(&event.OutboxID, &event.Type, &payload, &event.Attempts, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("OutboxRepo - GetUnpublishedEvents - Scan: %w", err)
+		}
+		event.Payload = []byte(payload)
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("OutboxRepo - GetUnpublishedEvents - RowsErr: %w", err)
+	}
+
+	return events, nil
+}
+
+// MarkPublished records that the event has been handed to every sink
+func (r *OutboxRepo) MarkPublished(ctx context.Context, outboxID int64) error {
+	sql, args, err := r.Builder.
+		Update("outbox_events").
+		Set("published_at", squirrel.Expr("LOCALTIMESTAMP")).
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("last_error", nil).
+		Where("outbox_id = ?", outboxID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("OutboxRepo - MarkPublished - BuildUpdate: %w", err)
+	}
+
+	_, err = r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("OutboxRepo - MarkPublished - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// MarkFailed records a failed relay attempt; the event stays in the outbox and is retried on the next pass
+func (r *OutboxRepo) MarkFailed(ctx context.Context, outboxID int64, lastError string) error {
+	sql, args, err := r.Builder.
+		Update("outbox_events").
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("last_error", lastError).
+		Where("outbox_id = ?", outboxID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("OutboxRepo - MarkFailed - BuildUpdate: %w", err)
+	}
+
+	_, err = r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("OutboxRepo - MarkFailed - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// insertOutboxEvent writes a domain event into the outbox as part of the caller's transaction,
+// so the event exists if and only if the change it describes is committed
+func insertOutboxEvent(ctx context.Context, tx pgx.Tx, builder squirrel.StatementBuilderType, eventType entity.EventType, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("Marshal: %w", err)
+	}
+
+	sql, args, err := builder.
+		Insert("outbox_events").
+		Columns("event_type", "payload").
+		Values(eventType, string(payload)).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BuildInsert: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("Exec: %w", err)
+	}
+
+	return nil
+}
@@ -2,11 +2,15 @@ package persistent
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
 )
 
 // PullRequestRepo handles pull request data persistence.
@@ -14,6 +18,11 @@ type PullRequestRepo struct {
 	*postgres.Postgres
 }
 
+// querier is implemented by both the connection pool and a transaction
+type querier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
 // NewPullRequestRepo creates a new PullRequestRepo instance.
 func NewPullRequestRepo(pg *postgres.Postgres) *PullRequestRepo {
 	return &PullRequestRepo{pg}
@@ -67,6 +76,31 @@ func (r *PullRequestRepo) CreatePR(ctx context.Context, pr entity.PullRequest, r
 		}
 	}
 
+	// Record events
+	pr.CreatedAt = createdAt
+	pr.AssignedReviewers = reviewerIDs
+
+	err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventPRCreated, entity.PullRequestEventData{PullRequest: pr})
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - CreatePR - insertOutboxEvent created: %w", err)
+	}
+
+	for _, reviewerID := range reviewerIDs {
+		err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{
+			PullRequestID: pr.PullRequestID,
+			ReviewerID:    reviewerID,
+		})
+		if err != nil {
+			return fmt.Errorf("PullRequestRepo - CreatePR - insertOutboxEvent assigned: %w", err)
+		}
+	}
+
+	// Record audit entry
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditPRCreated, entity.AuditEntityPullRequest, pr.PullRequestID, nil, pr)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - CreatePR - insertAuditEntry: %w", err)
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("PullRequestRepo - CreatePR - Commit: %w", err)
 	}
@@ -96,6 +130,10 @@ func (r *PullRequestRepo) GetPR(ctx context.Context, prID string) (entity.PullRe
 		&createdAt,
 		&mergedAt,
 	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.PullRequest{}, entity.ErrNotFound
+	}
+
 	if err != nil {
 		return entity.PullRequest{}, fmt.Errorf("PullRequestRepo - GetPR - Scan: %w", err)
 	}
@@ -114,6 +152,95 @@ func (r *PullRequestRepo) GetPR(ctx context.Context, prID string) (entity.PullRe
 	return pr, nil
 }
 
+// GetPRRecord retrieves a PR with all its reviewer assignments, including replaced ones, ordered by assignment time
+func (r *PullRequestRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	sql, args, err := r.Builder.
+		Select("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "closed_at").
+		From("pull_requests").
+		Where("pull_request_id = ?", prID).
+		ToSql()
+	if err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - BuildSelect: %w", err)
+	}
+
+	record := entity.PullRequestRecord{Assignments: []entity.AssignmentRecord{}}
+	pr := &record.PullRequest
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(
+		&pr.PullRequestID,
+		&pr.PullRequestName,
+		&pr.AuthorID,
+		&pr.Status,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+		&record.ClosedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.PullRequestRecord{}, entity.ErrNotFound
+	}
+
+	if err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - Scan: %w", err)
+	}
+
+	pr.AssignedReviewers, err = r.getPRReviewers(ctx, r.Pool, prID)
+	if err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - getPRReviewers: %w", err)
+	}
+
+	sql, args, err = r.Builder.
+		Select("prr.reviewer_id", "prr.created_at", "prr.unassigned_at", "ra.new_reviewer_id", "ra.reason",
+			"prr.review_outcome", "prr.reviewed_at").
+		From("pr_reviewers prr").
+		LeftJoin("pr_reassignments ra ON ra.assignment_id = prr.assignment_id").
+		Where("prr.pull_request_id = ?", prID).
+		OrderBy("prr.created_at", "prr.assignment_id").
+		ToSql()
+	if err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - BuildSelect assignments: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - Query assignments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			assignment entity.AssignmentRecord
+			replacedBy *string
+			reason     *entity.ReassignmentReason
+		)
+		if err := rows.Scan(
+			&assignment.ReviewerID,
+			&assignment.AssignedAt,
+			&assignment.UnassignedAt,
+			&replacedBy,
+			&reason,
+			&assignment.ReviewOutcome,
+			&assignment.ReviewedAt,
+		); err != nil {
+			return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - Scan assignment: %w", err)
+		}
+
+		if replacedBy != nil {
+			assignment.ReplacedBy = *replacedBy
+		}
+
+		if reason != nil {
+			assignment.Reason = *reason
+		}
+
+		record.Assignments = append(record.Assignments, assignment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return entity.PullRequestRecord{}, fmt.Errorf("PullRequestRepo - GetPRRecord - RowsErr: %w", err)
+	}
+
+	return record, nil
+}
+
 // PRExists checks if a PR exists
 func (r *PullRequestRepo) PRExists(ctx context.Context, prID string) (bool, error) {
 	sql, args, err := r.Builder.
@@ -134,29 +261,118 @@ func (r *PullRequestRepo) PRExists(ctx context.Context, prID string) (bool, erro
 	return exists == 1, nil
 }
 
-// UpdatePRStatus updates PR status
+// UpdatePRStatus updates PR status to MERGED, CLOSED or back to OPEN; a transition is recorded in the audit log,
+// and transitions to MERGED and OPEN also record a pull_request.merged or pull_request.reopened event.
+// Other statuses are rejected.
 func (r *PullRequestRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	var action entity.AuditAction
+	switch status {
+	case entity.PullRequestStatusMerged:
+		action = entity.AuditPRMerged
+	case entity.PullRequestStatusClosed:
+		action = entity.AuditPRClosed
+	case entity.PullRequestStatusOpen:
+		action = entity.AuditPRReopened
+	default:
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - unsupported status %q", status)
+	}
+
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock the PR to learn whether this update is a transition
+	sql, args, err := r.Builder.
+		Select("pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at").
+		From("pull_requests").
+		Where("pull_request_id = ?", prID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - BuildSelect: %w", err)
+	}
+
+	var before entity.PullRequest
+	err = tx.QueryRow(ctx, sql, args...).Scan(
+		&before.PullRequestID,
+		&before.PullRequestName,
+		&before.AuthorID,
+		&before.Status,
+		&before.CreatedAt,
+		&before.MergedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - Scan status: %w", err)
+	}
+
 	builder := r.Builder.
 		Update("pull_requests").
 		Set("status", status).
-		Where("pull_request_id = ?", prID)
+		Where("pull_request_id = ?", prID).
+		Suffix("RETURNING pull_request_id, pull_request_name, author_id, status, created_at, merged_at")
 
 	if mergedAt != nil {
 		builder = builder.Set("merged_at", *mergedAt)
 	}
 
-	sql, args, err := builder.ToSql()
+	switch {
+	case status == entity.PullRequestStatusClosed && before.Status != entity.PullRequestStatusClosed:
+		builder = builder.Set("closed_at", squirrel.Expr("LOCALTIMESTAMP"))
+	case status == entity.PullRequestStatusOpen:
+		builder = builder.Set("closed_at", nil)
+	}
+
+	sql, args, err = builder.ToSql()
 	if err != nil {
 		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - BuildUpdate: %w", err)
 	}
 
-	result, err := r.Pool.Exec(ctx, sql, args...)
+	var pr entity.PullRequest
+	err = tx.QueryRow(ctx, sql, args...).Scan(
+		&pr.PullRequestID,
+		&pr.PullRequestName,
+		&pr.AuthorID,
+		&pr.Status,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+	)
 	if err != nil {
-		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - Exec: %w", err)
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - Update: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return entity.ErrNotFound
+	if status != before.Status {
+		pr.AssignedReviewers, err = r.getPRReviewers(ctx, tx, prID)
+		if err != nil {
+			return fmt.Errorf("PullRequestRepo - UpdatePRStatus - getPRReviewers: %w", err)
+		}
+
+		before.AssignedReviewers = pr.AssignedReviewers
+
+		switch status {
+		case entity.PullRequestStatusMerged:
+			err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventPRMerged, entity.PullRequestEventData{PullRequest: pr})
+		case entity.PullRequestStatusOpen:
+			err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventPRReopened, entity.PullRequestEventData{PullRequest: pr})
+		}
+
+		if err != nil {
+			return fmt.Errorf("PullRequestRepo - UpdatePRStatus - insertOutboxEvent: %w", err)
+		}
+
+		err = insertAuditEntry(ctx, tx, r.Builder, action, entity.AuditEntityPullRequest, prID, before, pr)
+		if err != nil {
+			return fmt.Errorf("PullRequestRepo - UpdatePRStatus - insertAuditEntry: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("PullRequestRepo - UpdatePRStatus - Commit: %w", err)
 	}
 
 	return nil
@@ -164,17 +380,23 @@ func (r *PullRequestRepo) UpdatePRStatus(ctx context.Context, prID string, statu
 
 // GetPRReviewers retrieves reviewer IDs for a PR
 func (r *PullRequestRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	return r.getPRReviewers(ctx, r.Pool, prID)
+}
+
+// getPRReviewers retrieves reviewer IDs for a PR through the pool or within a transaction
+func (r *PullRequestRepo) getPRReviewers(ctx context.Context, q querier, prID string) ([]string, error) {
 	sql, args, err := r.Builder.
 		Select("reviewer_id").
 		From("pr_reviewers").
 		Where("pull_request_id = ?", prID).
+		Where("unassigned_at IS NULL").
 		OrderBy("reviewer_id").
 		ToSql()
 	if err != nil {
 		return nil, fmt.Errorf("PullRequestRepo - GetPRReviewers - BuildSelect: %w", err)
 	}
 
-	rows, err := r.Pool.Query(ctx, sql, args...)
+	rows, err := q.Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("PullRequestRepo - GetPRReviewers - Query: %w", err)
 	}
@@ -196,31 +418,37 @@ func (r *PullRequestRepo) GetPRReviewers(ctx context.Context, prID string) ([]st
 	return reviewers, nil
 }
 
-// ReassignReviewer replaces one reviewer with another
-func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error {
+// ReassignReviewer replaces one reviewer with another and records the reassignment with its reason;
+// the replaced assignment, including any submitted review, is kept as unassigned
+func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
 	tx, err := r.Pool.Begin(ctx)
 	if err != nil {
 		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Begin: %w", err)
 	}
 	defer tx.Rollback(ctx)
 
-	// Delete old reviewer
+	// Unassign old reviewer
 	sql, args, err := r.Builder.
-		Delete("pr_reviewers").
+		Update("pr_reviewers").
+		Set("unassigned_at", squirrel.Expr("LOCALTIMESTAMP")).
 		Where("pull_request_id = ?", prID).
 		Where("reviewer_id = ?", oldReviewerID).
+		Where("unassigned_at IS NULL").
+		Suffix("RETURNING assignment_id, created_at, review_outcome, reviewed_at").
 		ToSql()
 	if err != nil {
-		return fmt.Errorf("PullRequestRepo - ReassignReviewer - BuildDelete: %w", err)
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - BuildUpdate: %w", err)
 	}
 
-	result, err := tx.Exec(ctx, sql, args...)
-	if err != nil {
-		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Exec delete: %w", err)
+	var assignmentID int64
+	replaced := entity.ReviewerSnapshot{PullRequestID: prID, ReviewerID: oldReviewerID}
+	err = tx.QueryRow(ctx, sql, args...).Scan(&assignmentID, &replaced.AssignedAt, &replaced.ReviewOutcome, &replaced.ReviewedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotAssigned
 	}
 
-	if result.RowsAffected() == 0 {
-		return entity.ErrNotAssigned
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Exec update: %w", err)
 	}
 
 	// Insert new reviewer
@@ -228,16 +456,50 @@ func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID string, old
 		Insert("pr_reviewers").
 		Columns("pull_request_id", "reviewer_id").
 		Values(prID, newReviewerID).
+		Suffix("RETURNING created_at").
 		ToSql()
 	if err != nil {
 		return fmt.Errorf("PullRequestRepo - ReassignReviewer - BuildInsert: %w", err)
 	}
 
-	_, err = tx.Exec(ctx, sql, args...)
+	assigned := entity.ReviewerSnapshot{PullRequestID: prID, ReviewerID: newReviewerID, Reason: reason}
+	err = tx.QueryRow(ctx, sql, args...).Scan(&assigned.AssignedAt)
 	if err != nil {
 		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Exec insert: %w", err)
 	}
 
+	// Log reassignment
+	sql, args, err = r.Builder.
+		Insert("pr_reassignments").
+		Columns("assignment_id", "pull_request_id", "old_reviewer_id", "new_reviewer_id", "assigned_at", "reason").
+		Values(assignmentID, prID, oldReviewerID, newReviewerID, replaced.AssignedAt, reason).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - BuildInsert reassignment: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Exec insert reassignment: %w", err)
+	}
+
+	// Record event
+	err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventReviewerReassigned, entity.ReviewerReassignedEventData{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+		Reason:        reason,
+	})
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - insertOutboxEvent: %w", err)
+	}
+
+	// Record audit entry
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditReviewerReassigned, entity.AuditEntityPullRequest, prID, replaced, assigned)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - ReassignReviewer - insertAuditEntry: %w", err)
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("PullRequestRepo - ReassignReviewer - Commit: %w", err)
 	}
@@ -245,6 +507,79 @@ func (r *PullRequestRepo) ReassignReviewer(ctx context.Context, prID string, old
 	return nil
 }
 
+// RecordReview stores the outcome of a review submitted by an assigned reviewer, records it in the audit log
+// and records a review.submitted event
+func (r *PullRequestRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Select("created_at", "review_outcome", "reviewed_at").
+		From("pr_reviewers").
+		Where("pull_request_id = ?", prID).
+		Where("reviewer_id = ?", reviewerID).
+		Where("unassigned_at IS NULL").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - BuildSelect: %w", err)
+	}
+
+	before := entity.ReviewerSnapshot{PullRequestID: prID, ReviewerID: reviewerID}
+	err = tx.QueryRow(ctx, sql, args...).Scan(&before.AssignedAt, &before.ReviewOutcome, &before.ReviewedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotAssigned
+	}
+
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - Scan: %w", err)
+	}
+
+	sql, args, err = r.Builder.
+		Update("pr_reviewers").
+		Set("review_outcome", outcome).
+		Set("reviewed_at", squirrel.Expr("LOCALTIMESTAMP")).
+		Where("pull_request_id = ?", prID).
+		Where("reviewer_id = ?", reviewerID).
+		Where("unassigned_at IS NULL").
+		Suffix("RETURNING reviewed_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - BuildUpdate: %w", err)
+	}
+
+	after := before
+	after.ReviewOutcome = &outcome
+	err = tx.QueryRow(ctx, sql, args...).Scan(&after.ReviewedAt)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - Update: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditReviewRecorded, entity.AuditEntityPullRequest, prID, before, after)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - insertAuditEntry: %w", err)
+	}
+
+	// Record event
+	err = insertOutboxEvent(ctx, tx, r.Builder, entity.EventReviewSubmitted, entity.ReviewSubmittedEventData{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+		Outcome:       outcome,
+	})
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - insertOutboxEvent: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("PullRequestRepo - RecordReview - Commit: %w", err)
+	}
+
+	return nil
+}
+
 // GetPRsByReviewer retrieves all PRs where user is a reviewer
 func (r *PullRequestRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
 	sql, args, err := r.Builder.
@@ -252,6 +587,7 @@ func (r *PullRequestRepo) GetPRsByReviewer(ctx context.Context, reviewerID strin
 		From("pull_requests pr").
 		Join("pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id").
 		Where("prr.reviewer_id = ?", reviewerID).
+		Where("prr.unassigned_at IS NULL").
 		OrderBy("pr.created_at DESC").
 		ToSql()
 	if err != nil {
@@ -280,6 +616,274 @@ func (r *PullRequestRepo) GetPRsByReviewer(ctx context.Context, reviewerID strin
 	return prs, nil
 }
 
+// ListPRs retrieves PRs matching the filter ordered by creation time, starting after the given cursor
+func (r *PullRequestRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	direction, cmp := "DESC", "<"
+	if order == entity.SortOrderAsc {
+		direction, cmp = "ASC", ">"
+	}
+
+	builder := r.Builder.
+		Select("pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at").
+		From("pull_requests pr").
+		OrderBy("pr.created_at "+direction, "pr.pull_request_id "+direction).
+		Limit(uint64(limit)) //nolint:gosec // limit is normalized to a positive value by the caller
+
+	if filter.Status != "" {
+		builder = builder.Where(squirrel.Eq{"pr.status": filter.Status})
+	}
+
+	if filter.AuthorID != "" {
+		builder = builder.Where(squirrel.Eq{"pr.author_id": filter.AuthorID})
+	}
+
+	if filter.ReviewerID != "" {
+		builder = builder.Where("EXISTS (SELECT 1 FROM pr_reviewers prr WHERE prr.pull_request_id = pr.pull_request_id AND prr.reviewer_id = ? AND prr.unassigned_at IS NULL)", filter.ReviewerID)
+	}
+
+	if filter.TeamName != "" {
+		builder = builder.
+			Join("users au ON au.user_id = pr.author_id").
+			Where(squirrel.Eq{"au.team_name": filter.TeamName})
+	}
+
+	if filter.NameContains != "" {
+		builder = builder.Where(squirrel.ILike{"pr.pull_request_name": "%" + escapeLike(filter.NameContains) + "%"})
+	}
+
+	if filter.CreatedFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{"pr.created_at": *filter.CreatedFrom})
+	}
+
+	if filter.CreatedTo != nil {
+		builder = builder.Where(squirrel.Lt{"pr.created_at": *filter.CreatedTo})
+	}
+
+	if filter.MergedFrom != nil {
+		builder = builder.Where(squirrel.GtOrEq{"pr.merged_at": *filter.MergedFrom})
+	}
+
+	if filter.MergedTo != nil {
+		builder = builder.Where(squirrel.Lt{"pr.merged_at": *filter.MergedTo})
+	}
+
+	if after != nil {
+		builder = builder.Where("(pr.created_at, pr.pull_request_id) "+cmp+" (?, ?)", after.Time, after.ID)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - ListPRs - BuildSelect: %w", err)
+	}
+
+	prs, err := r.queryPRs(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - ListPRs - queryPRs: %w", err)
+	}
+
+	return prs, nil
+}
+
+// queryPRs runs a select of full PR rows and attaches reviewers to each of them
+func (r *PullRequestRepo) queryPRs(ctx context.Context, sql string, args ...interface{}) ([]entity.PullRequest, error) {
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryPRs - Query: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]entity.PullRequest, 0)
+	prIDs := make([]string, 0)
+	for rows.Next() {
+		var pr entity.PullRequest
+		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - queryPRs - Scan: %w", err)
+		}
+		prs = append(prs, pr)
+		prIDs = append(prIDs, pr.PullRequestID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryPRs - RowsErr: %w", err)
+	}
+
+	reviewers, err := r.getReviewersByPRs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryPRs - getReviewersByPRs: %w", err)
+	}
+
+	for i := range prs {
+		prs[i].AssignedReviewers = reviewers[prs[i].PullRequestID]
+		if prs[i].AssignedReviewers == nil {
+			prs[i].AssignedReviewers = []string{}
+		}
+	}
+
+	return prs, nil
+}
+
+// getReviewersByPRs retrieves reviewer IDs for several PRs at once, keyed by PR ID
+func (r *PullRequestRepo) getReviewersByPRs(ctx context.Context, prIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("pull_request_id", "reviewer_id").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"pull_request_id": prIDs}).
+		Where("unassigned_at IS NULL").
+		OrderBy("pull_request_id", "reviewer_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - getReviewersByPRs - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - getReviewersByPRs - Query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID, reviewerID string
+		if err := rows.Scan(&prID, &reviewerID); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - getReviewersByPRs - Scan: %w", err)
+		}
+		result[prID] = append(result[prID], reviewerID)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - getReviewersByPRs - RowsErr: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetReviewerAssignments retrieves current reviewers with their user details for several PRs, keyed by PR ID
+func (r *PullRequestRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	result := make(map[string][]entity.ReviewerAssignment, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("prr.pull_request_id", "u.user_id", "u.username", "u.is_active", "prr.created_at", "prr.review_outcome", "prr.reviewed_at").
+		From("pr_reviewers prr").
+		Join("users u ON u.user_id = prr.reviewer_id").
+		Where(squirrel.Eq{"prr.pull_request_id": prIDs}).
+		Where("prr.unassigned_at IS NULL").
+		OrderBy("prr.pull_request_id", "u.user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetReviewerAssignments - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetReviewerAssignments - Query: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prID string
+		var assignment entity.ReviewerAssignment
+		if err := rows.Scan(
+			&prID,
+			&assignment.UserID,
+			&assignment.Username,
+			&assignment.IsActive,
+			&assignment.AssignedAt,
+			&assignment.ReviewOutcome,
+			&assignment.ReviewedAt,
+		); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - GetReviewerAssignments - Scan: %w", err)
+		}
+		result[prID] = append(result[prID], assignment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetReviewerAssignments - RowsErr: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetPRsByReviewers retrieves PRs assigned to several reviewers at once, keyed by reviewer ID and ordered newest first;
+// an empty status means any status
+func (r *PullRequestRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	result := make(map[string][]entity.PullRequest, len(reviewerIDs))
+	if len(reviewerIDs) == 0 {
+		return result, nil
+	}
+
+	builder := r.Builder.
+		Select("prr.reviewer_id", "pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status", "pr.created_at", "pr.merged_at").
+		From("pr_reviewers prr").
+		Join("pull_requests pr ON pr.pull_request_id = prr.pull_request_id").
+		Where(squirrel.Eq{"prr.reviewer_id": reviewerIDs}).
+		Where("prr.unassigned_at IS NULL").
+		OrderBy("prr.reviewer_id", "pr.created_at DESC", "pr.pull_request_id DESC")
+
+	if status != "" {
+		builder = builder.Where(squirrel.Eq{"pr.status": status})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetPRsByReviewers - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetPRsByReviewers - Query: %w", err)
+	}
+	defer rows.Close()
+
+	type assignedPR struct {
+		reviewerID string
+		pr         entity.PullRequest
+	}
+
+	assigned := make([]assignedPR, 0)
+	prIDs := make([]string, 0)
+	seen := make(map[string]struct{})
+	for rows.Next() {
+		var a assignedPR
+		if err := rows.Scan(&a.reviewerID, &a.pr.PullRequestID, &a.pr.PullRequestName, &a.pr.AuthorID, &a.pr.Status, &a.pr.CreatedAt, &a.pr.MergedAt); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - GetPRsByReviewers - Scan: %w", err)
+		}
+		assigned = append(assigned, a)
+
+		if _, ok := seen[a.pr.PullRequestID]; !ok {
+			seen[a.pr.PullRequestID] = struct{}{}
+			prIDs = append(prIDs, a.pr.PullRequestID)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetPRsByReviewers - RowsErr: %w", err)
+	}
+
+	reviewers, err := r.getReviewersByPRs(ctx, prIDs)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetPRsByReviewers - getReviewersByPRs: %w", err)
+	}
+
+	for _, a := range assigned {
+		a.pr.AssignedReviewers = reviewers[a.pr.PullRequestID]
+		result[a.reviewerID] = append(result[a.reviewerID], a.pr)
+	}
+
+	return result, nil
+}
+
+// escapeLike escapes LIKE pattern metacharacters so the value is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 // SelectRandomReviewers selects up to maxCount reviewers from the candidates list.
 // Currently uses simple selection (first N candidates). For production, consider using crypto/rand for true randomness.
 func SelectRandomReviewers(candidates []entity.User, maxCount int) []string {
@@ -305,3 +909,185 @@ func SelectRandomReviewers(candidates []entity.User, maxCount int) []string {
 	return reviewerIDs
 }
 
+// GetOverdueReviews retrieves reviewer assignments on open PRs that exceed their team's SLA, optionally restricted to a team.
+// Teams without an SLA of their own use defaultSLA.
+func (r *PullRequestRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	reviews, err := r.queryOverdueReviews(ctx, "t.review_sla_seconds", defaultSLA, teamName, 0)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetOverdueReviews - queryOverdueReviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// GetStaleReviews retrieves up to limit oldest reviewer assignments on open PRs that exceed their team's escalation threshold.
+// Teams without a threshold of their own use defaultThreshold.
+func (r *PullRequestRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	reviews, err := r.queryOverdueReviews(ctx, "t.escalation_seconds", defaultThreshold, "", limit)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetStaleReviews - queryOverdueReviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// GetUnnotifiedOverdueReviews retrieves up to limit oldest overdue reviewer assignments whose team has a chat channel
+// and that were not announced there yet. Teams without an SLA of their own use defaultSLA.
+func (r *PullRequestRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	reviews, err := r.queryOverdueReviews(ctx, "t.review_sla_seconds", defaultSLA, "", limit,
+		squirrel.Expr("prr.overdue_notified_at IS NULL"),
+		squirrel.Expr("t.chat_webhook_url IS NOT NULL"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - GetUnnotifiedOverdueReviews - queryOverdueReviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// MarkOverdueNotified records that the reviewer's team channel was told the review is overdue
+func (r *PullRequestRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	sql, args, err := r.Builder.
+		Update("pr_reviewers").
+		Set("overdue_notified_at", squirrel.Expr("LOCALTIMESTAMP")).
+		Where("pull_request_id = ?", prID).
+		Where("reviewer_id = ?", reviewerID).
+		Where("unassigned_at IS NULL").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - MarkOverdueNotified - BuildUpdate: %w", err)
+	}
+
+	result, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("PullRequestRepo - MarkOverdueNotified - Exec: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entity.ErrNotAssigned
+	}
+
+	return nil
+}
+
+// queryOverdueReviews selects assignments on open PRs older than the team limit stored in limitColumn, oldest first,
+// narrowed by any extra conditions
+func (r *PullRequestRepo) queryOverdueReviews(
+	ctx context.Context,
+	limitColumn string,
+	defaultLimit time.Duration,
+	teamName string,
+	limit int,
+	conditions ...squirrel.Sqlizer,
+) ([]entity.OverdueReview, error) {
+	limitExpr := "COALESCE(" + limitColumn + ", ?)::bigint"
+	defaultSeconds := int64(defaultLimit.Seconds())
+
+	builder := r.Builder.
+		Select(
+			"pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status",
+			"prr.reviewer_id", "u.team_name", "prr.created_at",
+		).
+		Column(limitExpr, defaultSeconds).
+		Column("EXTRACT(EPOCH FROM LOCALTIMESTAMP - prr.created_at)::bigint - "+limitExpr, defaultSeconds).
+		From("pr_reviewers prr").
+		Join("pull_requests pr ON pr.pull_request_id = prr.pull_request_id").
+		Join("users u ON u.user_id = prr.reviewer_id").
+		Join("teams t ON t.team_name = u.team_name").
+		Where(squirrel.Eq{"pr.status": entity.PullRequestStatusOpen}).
+		Where("prr.reviewed_at IS NULL").
+		Where("prr.unassigned_at IS NULL").
+		Where("prr.created_at < LOCALTIMESTAMP - make_interval(secs => "+limitExpr+")", defaultSeconds).
+		OrderBy("prr.created_at", "pr.pull_request_id", "prr.reviewer_id")
+
+	if teamName != "" {
+		builder = builder.Where(squirrel.Eq{"u.team_name": teamName})
+	}
+
+	for _, condition := range conditions {
+		builder = builder.Where(condition)
+	}
+
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit)) //nolint:gosec // limit is checked to be positive
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryOverdueReviews - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryOverdueReviews - Query: %w", err)
+	}
+	defer rows.Close()
+
+	reviews := make([]entity.OverdueReview, 0)
+	for rows.Next() {
+		var review entity.OverdueReview
+		if err := rows.Scan(
+			&review.PullRequestID,
+			&review.PullRequestName,
+			&review.AuthorID,
+			&review.Status,
+			&review.ReviewerID,
+			&review.TeamName,
+			&review.AssignedAt,
+			&review.SLASeconds,
+			&review.OverdueSeconds,
+		); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - queryOverdueReviews - Scan: %w", err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - queryOverdueReviews - RowsErr: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// CountOverdueReviews counts overdue reviewer assignments per team, including teams with none
+func (r *PullRequestRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	sql, args, err := r.Builder.
+		Select("t.team_name", "COUNT(prr.reviewer_id)").
+		From("teams t").
+		LeftJoin("users u ON u.team_name = t.team_name").
+		LeftJoin(
+			"pr_reviewers prr ON prr.reviewer_id = u.user_id"+
+				" AND prr.reviewed_at IS NULL"+
+				" AND prr.unassigned_at IS NULL"+
+				" AND prr.created_at < LOCALTIMESTAMP - make_interval(secs => COALESCE(t.review_sla_seconds, ?)::bigint)"+
+				" AND EXISTS (SELECT 1 FROM pull_requests pr WHERE pr.pull_request_id = prr.pull_request_id AND pr.status = ?)",
+			int64(defaultSLA.Seconds()), entity.PullRequestStatusOpen,
+		).
+		GroupBy("t.team_name").
+		OrderBy("t.team_name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - CountOverdueReviews - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - CountOverdueReviews - Query: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make([]entity.OverdueCount, 0)
+	for rows.Next() {
+		var count entity.OverdueCount
+		if err := rows.Scan(&count.TeamName, &count.Count); err != nil {
+			return nil, fmt.Errorf("PullRequestRepo - CountOverdueReviews - Scan: %w", err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PullRequestRepo - CountOverdueReviews - RowsErr: %w", err)
+	}
+
+	return counts, nil
+}
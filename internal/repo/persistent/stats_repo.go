@@ -0,0 +1,129 @@
+package persistent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+)
+
+// Review metrics of a single reviewer; $1 and $2 bound the time window, $3 optionally restricts the team.
+const _reviewerStatsSQL = `
+SELECT
+	u.user_id,
+	u.username,
+	u.team_name,
+	(SELECT COUNT(*) FROM pr_reviewers prr
+		WHERE prr.reviewer_id = u.user_id AND prr.unassigned_at IS NULL AND prr.created_at >= $1 AND prr.created_at < $2)
+	+ (SELECT COUNT(*) FROM pr_reassignments ra
+		WHERE ra.old_reviewer_id = u.user_id AND ra.assigned_at >= $1 AND ra.assigned_at < $2),
+	(SELECT COUNT(*) FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE prr.reviewer_id = u.user_id AND prr.unassigned_at IS NULL AND pr.status = 'OPEN'),
+	(SELECT COUNT(*) FROM pr_reassignments ra
+		WHERE ra.old_reviewer_id = u.user_id AND ra.reassigned_at >= $1 AND ra.reassigned_at < $2),
+	(SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - prr.created_at))
+		FROM pr_reviewers prr
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE prr.reviewer_id = u.user_id AND prr.unassigned_at IS NULL AND pr.status = 'MERGED' AND pr.merged_at >= $1 AND pr.merged_at < $2)
+FROM users u
+WHERE $3 = '' OR u.team_name = $3
+ORDER BY u.user_id`
+
+// Review metrics aggregated over reviewers of each team; $1 and $2 bound the time window.
+const _teamStatsSQL = `
+SELECT
+	t.team_name,
+	(SELECT COUNT(*) FROM pr_reviewers prr
+		JOIN users u ON u.user_id = prr.reviewer_id
+		WHERE u.team_name = t.team_name AND prr.unassigned_at IS NULL AND prr.created_at >= $1 AND prr.created_at < $2)
+	+ (SELECT COUNT(*) FROM pr_reassignments ra
+		JOIN users u ON u.user_id = ra.old_reviewer_id
+		WHERE u.team_name = t.team_name AND ra.assigned_at >= $1 AND ra.assigned_at < $2),
+	(SELECT COUNT(*) FROM pr_reviewers prr
+		JOIN users u ON u.user_id = prr.reviewer_id
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE u.team_name = t.team_name AND prr.unassigned_at IS NULL AND pr.status = 'OPEN'),
+	(SELECT COUNT(*) FROM pr_reassignments ra
+		JOIN users u ON u.user_id = ra.old_reviewer_id
+		WHERE u.team_name = t.team_name AND ra.reassigned_at >= $1 AND ra.reassigned_at < $2),
+	(SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM pr.merged_at - prr.created_at))
+		FROM pr_reviewers prr
+		JOIN users u ON u.user_id = prr.reviewer_id
+		JOIN pull_requests pr ON pr.pull_request_id = prr.pull_request_id
+		WHERE u.team_name = t.team_name AND prr.unassigned_at IS NULL AND pr.status = 'MERGED' AND pr.merged_at >= $1 AND pr.merged_at < $2)
+FROM teams t
+ORDER BY t.team_name`
+
+// StatsRepo handles aggregated review statistics.
+type StatsRepo struct {
+	*postgres.Postgres
+}
+
+// NewStatsRepo creates a new StatsRepo instance.
+func NewStatsRepo(pg *postgres.Postgres) *StatsRepo {
+	return &StatsRepo{pg}
+}
+
+// GetReviewerStats retrieves review metrics per reviewer, optionally restricted to a team
+func (r *StatsRepo) GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) ([]entity.ReviewerStats, error) {
+	rows, err := r.Pool.Query(ctx, _reviewerStatsSQL, window.From, window.To, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("StatsRepo - GetReviewerStats - Query: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]entity.ReviewerStats, 0)
+	for rows.Next() {
+		var s entity.ReviewerStats
+		if err := rows.Scan(
+			&s.UserID,
+			&s.Username,
+			&s.TeamName,
+			&s.Assignments,
+			&s.OpenReviews,
+			&s.ReassignmentsAway,
+			&s.MedianTimeToMergeSeconds,
+		); err != nil {
+			return nil, fmt.Errorf("StatsRepo - GetReviewerStats - Scan: %w", err)
+		}
+		stats = append(stats, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("StatsRepo - GetReviewerStats - RowsErr: %w", err)
+	}
+
+	return stats, nil
+}
+
+// GetTeamStats retrieves review metrics aggregated per team
+func (r *StatsRepo) GetTeamStats(ctx context.Context, window entity.TimeWindow) ([]entity.TeamStats, error) {
+	rows, err := r.Pool.Query(ctx, _teamStatsSQL, window.From, window.To)
+	if err != nil {
+		return nil, fmt.Errorf("StatsRepo - GetTeamStats - Query: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make([]entity.TeamStats, 0)
+	for rows.Next() {
+		var s entity.TeamStats
+		if err := rows.Scan(
+			&s.TeamName,
+			&s.Assignments,
+			&s.OpenReviews,
+			&s.ReassignmentsAway,
+			&s.MedianTimeToMergeSeconds,
+		); err != nil {
+			return nil, fmt.Errorf("StatsRepo - GetTeamStats - Scan: %w", err)
+		}
+		stats = append(stats, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("StatsRepo - GetTeamStats - RowsErr: %w", err)
+	}
+
+	return stats, nil
+}
@@ -2,10 +2,13 @@ package persistent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
 )
 
 // TeamRepo handles team data persistence.
@@ -41,6 +44,17 @@ func (r *TeamRepo) CreateTeam(ctx context.Context, team entity.Team) error {
 		return fmt.Errorf("TeamRepo - CreateTeam - Exec team: %w", err)
 	}
 
+	// Remember the members that already exist, since the team takes them over
+	memberIDs := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		memberIDs = append(memberIDs, member.UserID)
+	}
+
+	existing, err := lockExistingUsers(ctx, tx, r.Builder, memberIDs)
+	if err != nil {
+		return fmt.Errorf("TeamRepo - CreateTeam - lockExistingUsers: %w", err)
+	}
+
 	// Insert or update users
 	for _, member := range team.Members {
 		sql, args, err := r.Builder.
@@ -59,6 +73,27 @@ func (r *TeamRepo) CreateTeam(ctx context.Context, team entity.Team) error {
 		}
 	}
 
+	// Record audit entry
+	var before interface{}
+	if len(existing) > 0 {
+		before = existing
+	}
+
+	after := make([]entity.User, 0, len(team.Members))
+	for _, member := range team.Members {
+		after = append(after, entity.User{
+			UserID:   member.UserID,
+			Username: member.Username,
+			TeamName: team.TeamName,
+			IsActive: member.IsActive,
+		})
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditTeamCreated, entity.AuditEntityTeam, team.TeamName, before, after)
+	if err != nil {
+		return fmt.Errorf("TeamRepo - CreateTeam - insertAuditEntry: %w", err)
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("TeamRepo - CreateTeam - Commit: %w", err)
 	}
@@ -66,6 +101,45 @@ func (r *TeamRepo) CreateTeam(ctx context.Context, team entity.Team) error {
 	return nil
 }
 
+// lockExistingUsers locks and returns the users among userIDs that already exist, ordered by user_id
+func lockExistingUsers(ctx context.Context, tx pgx.Tx, builder squirrel.StatementBuilderType, userIDs []string) ([]entity.User, error) {
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	sql, args, err := builder.
+		Select("user_id", "username", "team_name", "is_active").
+		From("users").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		OrderBy("user_id").
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("BuildSelect: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("Query: %w", err)
+	}
+	defer rows.Close()
+
+	var users []entity.User
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("Scan: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("RowsErr: %w", err)
+	}
+
+	return users, nil
+}
+
 // GetTeam retrieves a team with its members
 func (r *TeamRepo) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
 	// Get team members
@@ -125,3 +199,189 @@ func (r *TeamRepo) TeamExists(ctx context.Context, teamName string) (bool, error
 	return exists == 1, nil
 }
 
+// ListTeams retrieves all teams with member and active member counts, review SLA, escalation threshold and chat channel presence
+func (r *TeamRepo) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	sql, args, err := r.Builder.
+		Select("t.team_name", "COUNT(u.user_id)", "COUNT(u.user_id) FILTER (WHERE u.is_active)", "t.review_sla_seconds", "t.escalation_seconds", "t.chat_webhook_url IS NOT NULL").
+		From("teams t").
+		LeftJoin("users u ON u.team_name = t.team_name").
+		GroupBy("t.team_name").
+		OrderBy("t.team_name").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("TeamRepo - ListTeams - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("TeamRepo - ListTeams - Query: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make([]entity.TeamSummary, 0)
+	for rows.Next() {
+		var team entity.TeamSummary
+		if err := rows.Scan(&team.TeamName, &team.MemberCount, &team.ActiveCount, &team.ReviewSLASeconds, &team.EscalationSeconds, &team.ChatWebhookConfigured); err != nil {
+			return nil, fmt.Errorf("TeamRepo - ListTeams - Scan: %w", err)
+		}
+		teams = append(teams, team)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("TeamRepo - ListTeams - RowsErr: %w", err)
+	}
+
+	return teams, nil
+}
+
+// SetReviewSLA sets the team's review SLA in seconds and records the change in the audit log; nil resets it
+// to the service default
+func (r *TeamRepo) SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error {
+	err := r.setTeamSetting(ctx, teamName, "review_sla_seconds", slaSeconds, entity.AuditTeamReviewSLAChanged)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return fmt.Errorf("TeamRepo - SetReviewSLA - setTeamSetting: %w", err)
+	}
+
+	return err
+}
+
+// SetEscalationThreshold sets the team's escalation threshold in seconds and records the change in the audit log;
+// nil resets it to the service default
+func (r *TeamRepo) SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error {
+	err := r.setTeamSetting(ctx, teamName, "escalation_seconds", thresholdSeconds, entity.AuditTeamEscalationChanged)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return fmt.Errorf("TeamRepo - SetEscalationThreshold - setTeamSetting: %w", err)
+	}
+
+	return err
+}
+
+// SetChatWebhookURL sets the team's chat channel incoming-webhook URL and records the change in the audit log;
+// nil disables chat notifications for the team
+func (r *TeamRepo) SetChatWebhookURL(ctx context.Context, teamName string, url *string) error {
+	err := r.setTeamSetting(ctx, teamName, "chat_webhook_url", url, entity.AuditTeamChatWebhookChanged)
+	if err != nil && !errors.Is(err, entity.ErrNotFound) {
+		return fmt.Errorf("TeamRepo - SetChatWebhookURL - setTeamSetting: %w", err)
+	}
+
+	return err
+}
+
+// setTeamSetting updates one settings column of a team and records the change in the audit log; setting
+// the current value again is not recorded
+func (r *TeamRepo) setTeamSetting(
+	ctx context.Context,
+	teamName string,
+	column string,
+	value interface{},
+	action entity.AuditAction,
+) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Select("review_sla_seconds", "escalation_seconds", "chat_webhook_url").
+		From("teams").
+		Where("team_name = ?", teamName).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BuildSelect: %w", err)
+	}
+
+	before, beforeURL, err := scanTeamSettings(tx.QueryRow(ctx, sql, args...), teamName)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("Scan: %w", err)
+	}
+
+	sql, args, err = r.Builder.
+		Update("teams").
+		Set(column, value).
+		Where("team_name = ?", teamName).
+		Suffix("RETURNING review_sla_seconds, escalation_seconds, chat_webhook_url").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("BuildUpdate: %w", err)
+	}
+
+	after, afterURL, err := scanTeamSettings(tx.QueryRow(ctx, sql, args...), teamName)
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+
+	if equalIntPtr(before.ReviewSLASeconds, after.ReviewSLASeconds) &&
+		equalIntPtr(before.EscalationSeconds, after.EscalationSeconds) &&
+		beforeURL == afterURL {
+		return nil
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, action, entity.AuditEntityTeam, teamName, before, after)
+	if err != nil {
+		return fmt.Errorf("insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("Commit: %w", err)
+	}
+
+	return nil
+}
+
+// scanTeamSettings reads review_sla_seconds, escalation_seconds and chat_webhook_url; the URL is returned
+// separately so that changes to it can be detected without recording it
+func scanTeamSettings(row pgx.Row, teamName string) (entity.TeamSettingsSnapshot, string, error) {
+	settings := entity.TeamSettingsSnapshot{TeamName: teamName}
+
+	var url *string
+	if err := row.Scan(&settings.ReviewSLASeconds, &settings.EscalationSeconds, &url); err != nil {
+		return entity.TeamSettingsSnapshot{}, "", err
+	}
+
+	if url == nil {
+		return settings, "", nil
+	}
+
+	settings.ChatWebhookConfigured = true
+
+	return settings, *url, nil
+}
+
+func equalIntPtr(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// GetChatWebhookURL retrieves the team's chat channel incoming-webhook URL, empty when none is configured
+func (r *TeamRepo) GetChatWebhookURL(ctx context.Context, teamName string) (string, error) {
+	sql, args, err := r.Builder.
+		Select("COALESCE(chat_webhook_url, '')").
+		From("teams").
+		Where("team_name = ?", teamName).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("TeamRepo - GetChatWebhookURL - BuildSelect: %w", err)
+	}
+
+	var url string
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(&url)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("TeamRepo - GetChatWebhookURL - Scan: %w", err)
+	}
+
+	return url, nil
+}
+
@@ -2,11 +2,14 @@ package persistent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
 )
 
 // UserRepo handles user data persistence.
@@ -59,8 +62,151 @@ func (r *UserRepo) GetUser(ctx context.Context, userID string) (entity.User, err
 	return user, nil
 }
 
-// SetIsActive updates user's active status
+// GetUsers retrieves users by IDs, ordered by user_id
+func (r *UserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	if len(userIDs) == 0 {
+		return []entity.User{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("user_id", "username", "team_name", "is_active").
+		From("users").
+		Where(squirrel.Eq{"user_id": userIDs}).
+		OrderBy("user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsers - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsers - Query: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0, len(userIDs))
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("UserRepo - GetUsers - Scan: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsers - RowsErr: %w", err)
+	}
+
+	return users, nil
+}
+
+// GetUsersByTeams retrieves members of several teams at once ordered by team_name and user_id
+func (r *UserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	if len(teamNames) == 0 {
+		return []entity.User{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("user_id", "username", "team_name", "is_active").
+		From("users").
+		Where(squirrel.Eq{"team_name": teamNames}).
+		OrderBy("team_name", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsersByTeams - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsersByTeams - Query: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("UserRepo - GetUsersByTeams - Scan: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("UserRepo - GetUsersByTeams - RowsErr: %w", err)
+	}
+
+	return users, nil
+}
+
+// ListUsers retrieves users matching the filter ordered by user_id, starting after the given cursor
+func (r *UserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	builder := r.Builder.
+		Select("user_id", "username", "team_name", "is_active").
+		From("users").
+		OrderBy("user_id").
+		Limit(uint64(limit)) //nolint:gosec // limit is normalized to a positive value by the caller
+
+	if filter.TeamName != "" {
+		builder = builder.Where(squirrel.Eq{"team_name": filter.TeamName})
+	}
+
+	if filter.IsActive != nil {
+		builder = builder.Where(squirrel.Eq{"is_active": *filter.IsActive})
+	}
+
+	if after != nil {
+		builder = builder.Where(squirrel.Gt{"user_id": after.ID})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - ListUsers - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("UserRepo - ListUsers - Query: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]entity.User, 0)
+	for rows.Next() {
+		var user entity.User
+		if err := rows.Scan(&user.UserID, &user.Username, &user.TeamName, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("UserRepo - ListUsers - Scan: %w", err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("UserRepo - ListUsers - RowsErr: %w", err)
+	}
+
+	return users, nil
+}
+
+// SetIsActive updates user's active status and records the change in the audit log
 func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetIsActive - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	users, err := lockExistingUsers(ctx, tx, r.Builder, []string{userID})
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetIsActive - lockExistingUsers: %w", err)
+	}
+
+	if len(users) == 0 {
+		return entity.ErrNotFound
+	}
+
+	before := users[0]
+	if before.IsActive == isActive {
+		return nil
+	}
+
 	sql, args, err := r.Builder.
 		Update("users").
 		Set("is_active", isActive).
@@ -71,13 +217,21 @@ func (r *UserRepo) SetIsActive(ctx context.Context, userID string, isActive bool
 		return fmt.Errorf("UserRepo - SetIsActive - BuildUpdate: %w", err)
 	}
 
-	result, err := r.Pool.Exec(ctx, sql, args...)
+	_, err = tx.Exec(ctx, sql, args...)
 	if err != nil {
 		return fmt.Errorf("UserRepo - SetIsActive - Exec: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return entity.ErrNotFound
+	after := before
+	after.IsActive = isActive
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditUserActivityChanged, entity.AuditEntityUser, userID, before, after)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetIsActive - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("UserRepo - SetIsActive - Commit: %w", err)
 	}
 
 	return nil
@@ -122,38 +276,215 @@ func (r *UserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, ex
 	return users, nil
 }
 
-// GetUserReviews retrieves all PRs where user is a reviewer
-func (r *UserRepo) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
+// SetDigestSettings stores the user's digest email address (empty clears it) and opt-out flag and records
+// the change in the audit log
+func (r *UserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Select("COALESCE(email, '')", "NOT digest_opt_out").
+		From("users").
+		Where("user_id = ?", settings.UserID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - BuildSelect: %w", err)
+	}
+
+	before := entity.DigestSettings{UserID: settings.UserID}
+	err = tx.QueryRow(ctx, sql, args...).Scan(&before.Email, &before.DigestEnabled)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - Scan: %w", err)
+	}
+
+	if before == settings {
+		return nil
+	}
+
+	var email *string
+	if settings.Email != "" {
+		email = &settings.Email
+	}
+
+	sql, args, err = r.Builder.
+		Update("users").
+		Set("email", email).
+		Set("digest_opt_out", !settings.DigestEnabled).
+		Set("updated_at", time.Now()).
+		Where("user_id = ?", settings.UserID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - BuildUpdate: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - Exec: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditUserDigestSettingsChanged, entity.AuditEntityUser, settings.UserID, before, settings)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("UserRepo - SetDigestSettings - Commit: %w", err)
+	}
+
+	return nil
+}
+
+// GetUserRole retrieves the user's role
+func (r *UserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	sql, args, err := r.Builder.
+		Select("role").
+		From("users").
+		Where("user_id = ?", userID).
+		ToSql()
+	if err != nil {
+		return "", fmt.Errorf("UserRepo - GetUserRole - BuildSelect: %w", err)
+	}
+
+	var role entity.Role
+	err = r.Pool.QueryRow(ctx, sql, args...).Scan(&role)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", entity.ErrNotFound
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("UserRepo - GetUserRole - Scan: %w", err)
+	}
+
+	return role, nil
+}
+
+// SetUserRole changes the user's role and records the change in the audit log
+func (r *UserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	sql, args, err := r.Builder.
-		Select("pr.pull_request_id", "pr.pull_request_name", "pr.author_id", "pr.status").
-		From("pull_requests pr").
-		Join("pr_reviewers prr ON pr.pull_request_id = prr.pull_request_id").
-		Where("prr.reviewer_id = ?", userID).
-		OrderBy("pr.created_at DESC").
+		Select("role").
+		From("users").
+		Where("user_id = ?", userID).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - BuildSelect: %w", err)
+	}
+
+	var previous entity.Role
+	err = tx.QueryRow(ctx, sql, args...).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - Scan: %w", err)
+	}
+
+	if previous == role {
+		return nil
+	}
+
+	sql, args, err = r.Builder.
+		Update("users").
+		Set("role", role).
+		Set("updated_at", time.Now()).
+		Where("user_id = ?", userID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - BuildUpdate: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - Exec: %w", err)
+	}
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditUserRoleChanged, entity.AuditEntityUser, userID,
+		entity.UserRoleSnapshot{UserID: userID, Role: previous},
+		entity.UserRoleSnapshot{UserID: userID, Role: role})
+	if err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("UserRepo - SetUserRole - Commit: %w", err)
+	}
+
+	return nil
+}
+
+// GetDigestRecipients retrieves up to limit active users with an email address who did not opt out
+// and whose digest for day was not handled yet, ordered by user_id
+func (r *UserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	sql, args, err := r.Builder.
+		Select("user_id", "username", "email").
+		From("users").
+		Where(squirrel.Eq{"is_active": true, "digest_opt_out": false}).
+		Where("email IS NOT NULL").
+		Where("(digest_sent_on IS NULL OR digest_sent_on < ?::date)", day).
+		OrderBy("user_id").
+		Limit(uint64(limit)). //nolint:gosec // limit is a positive batch size
 		ToSql()
 	if err != nil {
-		return nil, fmt.Errorf("UserRepo - GetUserReviews - BuildSelect: %w", err)
+		return nil, fmt.Errorf("UserRepo - GetDigestRecipients - BuildSelect: %w", err)
 	}
 
 	rows, err := r.Pool.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, fmt.Errorf("UserRepo - GetUserReviews - Query: %w", err)
+		return nil, fmt.Errorf("UserRepo - GetDigestRecipients - Query: %w", err)
 	}
 	defer rows.Close()
 
-	var prs []entity.PullRequestShort
+	recipients := make([]entity.DigestRecipient, 0)
 	for rows.Next() {
-		var pr entity.PullRequestShort
-		if err := rows.Scan(&pr.PullRequestID, &pr.PullRequestName, &pr.AuthorID, &pr.Status); err != nil {
-			return nil, fmt.Errorf("UserRepo - GetUserReviews - Scan: %w", err)
+		var recipient entity.DigestRecipient
+		if err := rows.Scan(&recipient.UserID, &recipient.Username, &recipient.Email); err != nil {
+			return nil, fmt.Errorf("UserRepo - GetDigestRecipients - Scan: %w", err)
 		}
-		prs = append(prs, pr)
+		recipients = append(recipients, recipient)
 	}
 
 	if err = rows.Err(); err != nil {
-		return nil, fmt.Errorf("UserRepo - GetUserReviews - RowsErr: %w", err)
+		return nil, fmt.Errorf("UserRepo - GetDigestRecipients - RowsErr: %w", err)
+	}
+
+	return recipients, nil
+}
+
+// MarkDigestSent records that the user's digest for day was handled
+func (r *UserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	sql, args, err := r.Builder.
+		Update("users").
+		Set("digest_sent_on", squirrel.Expr("?::date", day)).
+		Where("user_id = ?", userID).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("UserRepo - MarkDigestSent - BuildUpdate: %w", err)
 	}
 
-	return prs, nil
+	result, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("UserRepo - MarkDigestSent - Exec: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return entity.ErrNotFound
+	}
+
+	return nil
 }
 
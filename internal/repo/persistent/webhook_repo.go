@@ -0,0 +1,334 @@
+package persistent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/pkg/postgres"
+	"github.com/jackc/pgx/v5"
+)
+
+// WebhookRepo handles webhook subscriptions and their deliveries.
+type WebhookRepo struct {
+	*postgres.Postgres
+}
+
+// NewWebhookRepo creates a new WebhookRepo instance.
+func NewWebhookRepo(pg *postgres.Postgres) *WebhookRepo {
+	return &WebhookRepo{pg}
+}
+
+// CreateWebhook stores a webhook subscription and records it in the audit log
+func (r *WebhookRepo) CreateWebhook(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookRepo - CreateWebhook - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Insert("webhooks").
+		Columns("webhook_id", "url", "secret", "event_types", "is_active").
+		Values(webhook.WebhookID, webhook.URL, webhook.Secret, eventTypesToStrings(webhook.EventTypes), webhook.IsActive).
+		Suffix("RETURNING created_at").
+		ToSql()
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookRepo - CreateWebhook - BuildInsert: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, sql, args...).Scan(&webhook.CreatedAt)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookRepo - CreateWebhook - QueryRow: %w", err)
+	}
+
+	// The signing secret must not end up in the log
+	after := webhook
+	after.Secret = ""
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditWebhookCreated, entity.AuditEntityWebhook, webhook.WebhookID, nil, after)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookRepo - CreateWebhook - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookRepo - CreateWebhook - Commit: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// ListWebhooks retrieves all webhook subscriptions without their secrets
+func (r *WebhookRepo) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	sql, args, err := r.Builder.
+		Select("webhook_id", "url", "event_types", "is_active", "created_at").
+		From("webhooks").
+		OrderBy("created_at", "webhook_id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListWebhooks - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListWebhooks - Query: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]entity.Webhook, 0)
+	for rows.Next() {
+		var (
+			webhook    entity.Webhook
+			eventTypes []string
+		)
+		if err := rows.Scan(&webhook.WebhookID, &webhook.URL, &eventTypes, &webhook.IsActive, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("WebhookRepo - ListWebhooks - Scan: %w", err)
+		}
+		webhook.EventTypes = stringsToEventTypes(eventTypes)
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListWebhooks - RowsErr: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook subscription together with its deliveries and records it in the audit log
+func (r *WebhookRepo) DeleteWebhook(ctx context.Context, webhookID string) error {
+	tx, err := r.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - DeleteWebhook - Begin: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	sql, args, err := r.Builder.
+		Delete("webhooks").
+		Where("webhook_id = ?", webhookID).
+		Suffix("RETURNING webhook_id, url, event_types, is_active, created_at").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - DeleteWebhook - BuildDelete: %w", err)
+	}
+
+	var (
+		before     entity.Webhook
+		eventTypes []string
+	)
+	err = tx.QueryRow(ctx, sql, args...).Scan(&before.WebhookID, &before.URL, &eventTypes, &before.IsActive, &before.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.ErrNotFound
+	}
+
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - DeleteWebhook - Delete: %w", err)
+	}
+	before.EventTypes = stringsToEventTypes(eventTypes)
+
+	err = insertAuditEntry(ctx, tx, r.Builder, entity.AuditWebhookDeleted, entity.AuditEntityWebhook, webhookID, before, nil)
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - DeleteWebhook - insertAuditEntry: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("WebhookRepo - DeleteWebhook - Commit: %w", err)
+	}
+
+	return nil
+}
+
+// EnqueueDeliveries creates a pending delivery of the event for every active webhook subscribed to its type
+func (r *WebhookRepo) EnqueueDeliveries(ctx context.Context, eventID string, eventType entity.EventType, payload []byte) (int64, error) {
+	subscribers := r.Builder.
+		Select("webhook_id", "CAST(? AS VARCHAR)", "CAST(? AS VARCHAR)", "CAST(? AS JSONB)").
+		From("webhooks").
+		Where("is_active").
+		Where("(cardinality(event_types) = 0 OR ? = ANY(event_types))", string(eventType))
+
+	sql, args, err := r.Builder.
+		Insert("webhook_deliveries").
+		Columns("webhook_id", "event_id", "event_type", "payload").
+		Select(subscribers).
+		Suffix("ON CONFLICT (webhook_id, event_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("WebhookRepo - EnqueueDeliveries - BuildInsert: %w", err)
+	}
+
+	// Placeholders of the select list come before the filter's
+	args = append([]interface{}{eventID, string(eventType), string(payload)}, args...)
+
+	result, err := r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("WebhookRepo - EnqueueDeliveries - Exec: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ClaimDueDeliveries picks up to limit pending deliveries that are due and leases them for the given duration,
+// so that concurrent instances do not send the same delivery twice
+func (r *WebhookRepo) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.WebhookDeliveryTask, error) {
+	// The subquery keeps the default placeholder format so that the outer builder numbers its arguments
+	due := squirrel.
+		Select("delivery_id").
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"status": entity.DeliveryStatusPending}).
+		Where("next_attempt_at <= LOCALTIMESTAMP").
+		OrderBy("next_attempt_at", "delivery_id").
+		Limit(uint64(limit)). //nolint:gosec // limit is a positive batch size
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sql, args, err := r.Builder.
+		Update("webhook_deliveries d").
+		Set("next_attempt_at", squirrel.Expr("LOCALTIMESTAMP + make_interval(secs => ?)", lease.Seconds())).
+		From("webhooks w").
+		Where("w.webhook_id = d.webhook_id").
+		Where(squirrel.Expr("d.delivery_id IN (?)", due)).
+		Suffix("RETURNING d.delivery_id, d.webhook_id, d.event_id, d.event_type, d.status, d.attempts, d.created_at, w.url, w.secret, d.payload::text").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ClaimDueDeliveries - BuildUpdate: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ClaimDueDeliveries - Query: %w", err)
+	}
+	defer rows.Close()
+
+	tasks := make([]entity.WebhookDeliveryTask, 0)
+	for rows.Next() {
+		var (
+			task    entity.WebhookDeliveryTask
+			payload string
+		)
+		if err := rows.Scan(
+			&task.DeliveryID,
+			&task.WebhookID,
+			&task.EventID,
+			&task.EventType,
+			&task.Status,
+			&task.Attempts,
+			&task.CreatedAt,
+			&task.URL,
+			&task.Secret,
+			&payload,
+		); err != nil {
+			return nil, fmt.Errorf("WebhookRepo - ClaimDueDeliveries - Scan: %w", err)
+		}
+		task.Payload = []byte(payload)
+		tasks = append(tasks, task)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ClaimDueDeliveries - RowsErr: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// UpdateDelivery stores the outcome of a delivery attempt; pending deliveries are retried after retryIn
+func (r *WebhookRepo) UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery, retryIn time.Duration) error {
+	builder := r.Builder.
+		Update("webhook_deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("last_status_code", delivery.LastStatusCode).
+		Set("last_error", delivery.LastError).
+		Set("next_attempt_at", squirrel.Expr("LOCALTIMESTAMP + make_interval(secs => ?)", retryIn.Seconds())).
+		Where("delivery_id = ?", delivery.DeliveryID)
+
+	if delivery.Status == entity.DeliveryStatusSucceeded {
+		builder = builder.Set("delivered_at", squirrel.Expr("LOCALTIMESTAMP"))
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - UpdateDelivery - BuildUpdate: %w", err)
+	}
+
+	_, err = r.Pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("WebhookRepo - UpdateDelivery - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeliveries retrieves the latest deliveries of a webhook, newest first, optionally filtered by status
+func (r *WebhookRepo) ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	builder := r.Builder.
+		Select(
+			"delivery_id", "webhook_id", "event_id", "event_type", "status", "attempts",
+			"last_status_code", "last_error", "next_attempt_at", "created_at", "delivered_at",
+		).
+		From("webhook_deliveries").
+		Where("webhook_id = ?", webhookID).
+		OrderBy("delivery_id DESC").
+		Limit(uint64(limit)) //nolint:gosec // limit is normalized to a positive value by the caller
+
+	if status != "" {
+		builder = builder.Where(squirrel.Eq{"status": status})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListDeliveries - BuildSelect: %w", err)
+	}
+
+	rows, err := r.Pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListDeliveries - Query: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]entity.WebhookDelivery, 0)
+	for rows.Next() {
+		var d entity.WebhookDelivery
+		if err := rows.Scan(
+			&d.DeliveryID,
+			&d.WebhookID,
+			&d.EventID,
+			&d.EventType,
+			&d.Status,
+			&d.Attempts,
+			&d.LastStatusCode,
+			&d.LastError,
+			&d.NextAttemptAt,
+			&d.CreatedAt,
+			&d.DeliveredAt,
+		); err != nil {
+			return nil, fmt.Errorf("WebhookRepo - ListDeliveries - Scan: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepo - ListDeliveries - RowsErr: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func eventTypesToStrings(types []entity.EventType) []string {
+	result := make([]string, 0, len(types))
+	for _, t := range types {
+		result = append(result, string(t))
+	}
+
+	return result
+}
+
+func stringsToEventTypes(values []string) []entity.EventType {
+	result := make([]entity.EventType, 0, len(values))
+	for _, v := range values {
+		result = append(result, entity.EventType(v))
+	}
+
+	return result
+}
@@ -0,0 +1,59 @@
+package webapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// ChatSender posts messages to Slack/Mattermost-compatible incoming webhooks.
+type ChatSender struct {
+	client *http.Client
+}
+
+// NewChatSender creates a new ChatSender; a non-positive timeout falls back to 5 seconds.
+func NewChatSender(timeout time.Duration) *ChatSender {
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	return &ChatSender{
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Send posts the message to the incoming-webhook URL; any response outside 2xx is reported as an error.
+func (s *ChatSender) Send(ctx context.Context, url string, message entity.ChatMessage) error {
+	payload, err := json.Marshal(message)
+	if err != nil {
+		return fmt.Errorf("ChatSender - Send - Marshal: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("ChatSender - Send - NewRequest: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req) //nolint:gosec // the URL is configured on the team by an operator
+	if err != nil {
+		return fmt.Errorf("ChatSender - Send - Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("ChatSender - Send: %w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return nil
+}
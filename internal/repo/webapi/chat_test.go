@@ -0,0 +1,42 @@
+package webapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChatSender_SendPostsIncomingWebhookPayload(t *testing.T) {
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	err := NewChatSender(time.Second).Send(context.Background(), server.URL, entity.ChatMessage{Text: "Review `pr-1`"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.JSONEq(t, `{"text":"Review `+"`pr-1`"+`"}`, string(body))
+}
+
+func TestChatSender_SendNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	err := NewChatSender(time.Second).Send(context.Background(), server.URL, entity.ChatMessage{Text: "hi"})
+
+	assert.ErrorIs(t, err, errUnexpectedStatus)
+}
@@ -0,0 +1,85 @@
+package webapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const _defaultCodeHostTimeout = 10 * time.Second
+
+// codeHostAPI performs authenticated JSON requests against a code host REST API.
+type codeHostAPI struct {
+	client  *http.Client
+	baseURL string
+	headers map[string]string
+}
+
+func newCodeHostAPI(baseURL string, timeout time.Duration, headers map[string]string) codeHostAPI {
+	if timeout <= 0 {
+		timeout = _defaultCodeHostTimeout
+	}
+
+	return codeHostAPI{
+		client:  &http.Client{Timeout: timeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		headers: headers,
+	}
+}
+
+// do sends body as JSON to baseURL+path and decodes a 2xx response into out when it is not nil.
+// Any response outside 2xx is reported as errUnexpectedStatus.
+func (a codeHostAPI) do(ctx context.Context, method string, path string, body any, out any) error {
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("Marshal: %w", err)
+		}
+
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, a.baseURL+path, reader)
+	if err != nil {
+		return fmt.Errorf("NewRequest: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	for key, value := range a.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+		return fmt.Errorf("%s %s: %w: %d", method, path, errUnexpectedStatus, resp.StatusCode)
+	}
+
+	if out == nil {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%s %s: Decode: %w", method, path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   map[string]any
+}
+
+// fakeCodeHost records requests and answers them from routes keyed by "<method> <escaped path>"
+type fakeCodeHost struct {
+	mu       sync.Mutex
+	requests []recordedRequest
+	routes   map[string]func(w http.ResponseWriter)
+}
+
+func newFakeCodeHost(t *testing.T, routes map[string]func(w http.ResponseWriter)) (*fakeCodeHost, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeCodeHost{routes: routes}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := r.URL.EscapedPath()
+		if r.URL.RawQuery != "" {
+			path += "?" + r.URL.RawQuery
+		}
+
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		fake.mu.Lock()
+		fake.requests = append(fake.requests, recordedRequest{Method: r.Method, Path: path, Header: r.Header, Body: body})
+		fake.mu.Unlock()
+
+		route, ok := fake.routes[r.Method+" "+path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		route(w)
+	}))
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+func respondJSON(status int, body string) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}
+}
+
+func TestGitHubClient_RequestReviewers(t *testing.T) {
+	path := "/repos/acme/api/pulls/42/requested_reviewers"
+	fake, server := newFakeCodeHost(t, map[string]func(w http.ResponseWriter){
+		"POST " + path:   respondJSON(http.StatusCreated, `{"number":42}`),
+		"DELETE " + path: respondJSON(http.StatusOK, `{"number":42}`),
+	})
+
+	client := NewGitHubClient(server.URL+"/", "gh-token", time.Second)
+	ref := entity.CodeHostRef{Provider: entity.IdentityProviderGitHub, Repository: "acme/api", Number: 42}
+
+	err := client.RequestReviewers(context.Background(), ref, []string{"carol"}, []string{"bob"})
+
+	require.NoError(t, err)
+	require.Len(t, fake.requests, 2)
+
+	assert.Equal(t, http.MethodPost, fake.requests[0].Method)
+	assert.Equal(t, path, fake.requests[0].Path)
+	assert.Equal(t, map[string]any{"reviewers": []any{"carol"}}, fake.requests[0].Body)
+	assert.Equal(t, "Bearer gh-token", fake.requests[0].Header.Get("Authorization"))
+	assert.Equal(t, "application/vnd.github+json", fake.requests[0].Header.Get("Accept"))
+
+	assert.Equal(t, http.MethodDelete, fake.requests[1].Method)
+	assert.Equal(t, map[string]any{"reviewers": []any{"bob"}}, fake.requests[1].Body)
+}
+
+func TestGitHubClient_RequestReviewersRejected(t *testing.T) {
+	_, server := newFakeCodeHost(t, map[string]func(w http.ResponseWriter){
+		"POST /repos/acme/api/pulls/42/requested_reviewers": respondJSON(http.StatusUnprocessableEntity, `{"message":"Reviews may only be requested from collaborators."}`),
+	})
+
+	client := NewGitHubClient(server.URL, "gh-token", time.Second)
+	ref := entity.CodeHostRef{Provider: entity.IdentityProviderGitHub, Repository: "acme/api", Number: 42}
+
+	err := client.RequestReviewers(context.Background(), ref, []string{"outsider"}, nil)
+
+	assert.ErrorIs(t, err, errUnexpectedStatus)
+}
+
+func TestGitLabClient_RequestReviewers(t *testing.T) {
+	path := "/projects/acme%2Fbilling/merge_requests/7"
+	fake, server := newFakeCodeHost(t, map[string]func(w http.ResponseWriter){
+		"GET " + path:               respondJSON(http.StatusOK, `{"iid":7,"reviewers":[{"id":11,"username":"Bob"},{"id":12,"username":"dave"}]}`),
+		"GET /users?username=carol": respondJSON(http.StatusOK, `[{"id":13,"username":"carol"}]`),
+		"PUT " + path:               respondJSON(http.StatusOK, `{"iid":7}`),
+	})
+
+	client := NewGitLabClient(server.URL, "gl-token", time.Second)
+	ref := entity.CodeHostRef{Provider: entity.IdentityProviderGitLab, Repository: "acme/billing", Number: 7}
+
+	err := client.RequestReviewers(context.Background(), ref, []string{"carol"}, []string{"bob"})
+
+	require.NoError(t, err)
+	require.Len(t, fake.requests, 3)
+	assert.Equal(t, "gl-token", fake.requests[0].Header.Get("PRIVATE-TOKEN"))
+
+	// Reviewers set by hand (dave) are kept
+	assert.Equal(t, http.MethodPut, fake.requests[2].Method)
+	assert.Equal(t, map[string]any{"reviewer_ids": []any{float64(12), float64(13)}}, fake.requests[2].Body)
+}
+
+func TestGitLabClient_RequestReviewersUnchanged(t *testing.T) {
+	fake, server := newFakeCodeHost(t, map[string]func(w http.ResponseWriter){
+		"GET /projects/acme%2Fbilling/merge_requests/7": respondJSON(http.StatusOK, `{"iid":7,"reviewers":[{"id":13,"username":"carol"}]}`),
+	})
+
+	client := NewGitLabClient(server.URL, "gl-token", time.Second)
+	ref := entity.CodeHostRef{Provider: entity.IdentityProviderGitLab, Repository: "acme/billing", Number: 7}
+
+	err := client.RequestReviewers(context.Background(), ref, []string{"Carol"}, nil)
+
+	require.NoError(t, err)
+	assert.Len(t, fake.requests, 1)
+}
+
+func TestGitLabClient_RequestReviewersUnknownUser(t *testing.T) {
+	_, server := newFakeCodeHost(t, map[string]func(w http.ResponseWriter){
+		"GET /projects/acme%2Fbilling/merge_requests/7": respondJSON(http.StatusOK, `{"iid":7,"reviewers":[]}`),
+		"GET /users?username=ghost":                     respondJSON(http.StatusOK, `[]`),
+	})
+
+	client := NewGitLabClient(server.URL, "gl-token", time.Second)
+	ref := entity.CodeHostRef{Provider: entity.IdentityProviderGitLab, Repository: "acme/billing", Number: 7}
+
+	err := client.RequestReviewers(context.Background(), ref, []string{"ghost"}, nil)
+
+	assert.ErrorIs(t, err, errUnknownGitLabUser)
+}
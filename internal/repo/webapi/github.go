@@ -0,0 +1,65 @@
+package webapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// GitHubClient requests pull request reviews through the GitHub REST API.
+type GitHubClient struct {
+	api codeHostAPI
+}
+
+// NewGitHubClient creates a new GitHubClient for the API at baseURL (https://api.github.com for github.com)
+// authenticated with a token allowed to write pull requests; a non-positive timeout falls back to 10 seconds.
+func NewGitHubClient(baseURL string, token string, timeout time.Duration) *GitHubClient {
+	return &GitHubClient{
+		api: newCodeHostAPI(baseURL, timeout, map[string]string{
+			"Accept":               "application/vnd.github+json",
+			"Authorization":        "Bearer " + token,
+			"X-GitHub-Api-Version": "2022-11-28",
+		}),
+	}
+}
+
+type githubReviewersRequest struct {
+	Reviewers []string `json:"reviewers"`
+}
+
+// RequestReviewers requests reviews from the add logins, then withdraws the requests of the remove logins.
+// Reviewers requested by other means are left untouched.
+func (c *GitHubClient) RequestReviewers(ctx context.Context, ref entity.CodeHostRef, add []string, remove []string) error {
+	path := fmt.Sprintf("/repos/%s/pulls/%d/requested_reviewers", escapeRepository(ref.Repository), ref.Number)
+
+	if len(add) > 0 {
+		err := c.api.do(ctx, http.MethodPost, path, githubReviewersRequest{Reviewers: add}, nil)
+		if err != nil {
+			return fmt.Errorf("GitHubClient - RequestReviewers - add: %w", err)
+		}
+	}
+
+	if len(remove) > 0 {
+		err := c.api.do(ctx, http.MethodDelete, path, githubReviewersRequest{Reviewers: remove}, nil)
+		if err != nil {
+			return fmt.Errorf("GitHubClient - RequestReviewers - remove: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// escapeRepository escapes each segment of "<owner>/<repo>" for use in a URL path
+func escapeRepository(repository string) string {
+	segments := strings.Split(repository, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,110 @@
+package webapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+var errUnknownGitLabUser = errors.New("unknown GitLab user")
+
+// GitLabClient sets merge request reviewers through the GitLab REST API.
+type GitLabClient struct {
+	api codeHostAPI
+}
+
+// NewGitLabClient creates a new GitLabClient for the API at baseURL (https://gitlab.com/api/v4 for gitlab.com)
+// authenticated with a token that has the api scope; a non-positive timeout falls back to 10 seconds.
+func NewGitLabClient(baseURL string, token string, timeout time.Duration) *GitLabClient {
+	return &GitLabClient{
+		api: newCodeHostAPI(baseURL, timeout, map[string]string{
+			"PRIVATE-TOKEN": token,
+		}),
+	}
+}
+
+type gitlabUserRef struct {
+	ID       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+type gitlabMergeRequestReviewers struct {
+	Reviewers []gitlabUserRef `json:"reviewers"`
+}
+
+type gitlabUpdateReviewersRequest struct {
+	ReviewerIDs []int `json:"reviewer_ids"`
+}
+
+// RequestReviewers adds the add usernames to the merge request reviewers and removes the remove usernames.
+// GitLab only accepts the full reviewer list, so the current reviewers are read first and kept.
+func (c *GitLabClient) RequestReviewers(ctx context.Context, ref entity.CodeHostRef, add []string, remove []string) error {
+	path := fmt.Sprintf("/projects/%s/merge_requests/%d", url.PathEscape(ref.Repository), ref.Number)
+
+	var mr gitlabMergeRequestReviewers
+	if err := c.api.do(ctx, http.MethodGet, path, nil, &mr); err != nil {
+		return fmt.Errorf("GitLabClient - RequestReviewers - GetMergeRequest: %w", err)
+	}
+
+	removed := make(map[string]bool, len(remove))
+	for _, username := range remove {
+		removed[strings.ToLower(username)] = true
+	}
+
+	present := make(map[string]bool, len(mr.Reviewers))
+	reviewerIDs := make([]int, 0, len(mr.Reviewers)+len(add))
+	for _, reviewer := range mr.Reviewers {
+		username := strings.ToLower(reviewer.Username)
+		present[username] = true
+
+		if !removed[username] {
+			reviewerIDs = append(reviewerIDs, reviewer.ID)
+		}
+	}
+
+	changed := len(reviewerIDs) != len(mr.Reviewers)
+	for _, username := range add {
+		if present[strings.ToLower(username)] {
+			continue
+		}
+
+		id, err := c.userID(ctx, username)
+		if err != nil {
+			return fmt.Errorf("GitLabClient - RequestReviewers - %w", err)
+		}
+
+		reviewerIDs = append(reviewerIDs, id)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	err := c.api.do(ctx, http.MethodPut, path, gitlabUpdateReviewersRequest{ReviewerIDs: reviewerIDs}, nil)
+	if err != nil {
+		return fmt.Errorf("GitLabClient - RequestReviewers - UpdateMergeRequest: %w", err)
+	}
+
+	return nil
+}
+
+// userID resolves a username to the numeric user ID GitLab expects in reviewer_ids
+func (c *GitLabClient) userID(ctx context.Context, username string) (int, error) {
+	var users []gitlabUserRef
+	if err := c.api.do(ctx, http.MethodGet, "/users?username="+url.QueryEscape(username), nil, &users); err != nil {
+		return 0, fmt.Errorf("GetUser: %w", err)
+	}
+
+	if len(users) == 0 {
+		return 0, fmt.Errorf("%w: %s", errUnknownGitLabUser, username)
+	}
+
+	return users[0].ID, nil
+}
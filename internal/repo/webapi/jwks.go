@@ -0,0 +1,209 @@
+package webapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	_defaultJWKSRefresh = time.Hour
+	// _minJWKSRefetch limits refetches triggered by tokens signed with an unknown key.
+	_minJWKSRefetch = time.Minute
+	_maxJWKSSize    = 1 << 20
+	_jwtLeeway      = time.Minute
+)
+
+var errNoJWKSKeys = errors.New("JWKS contains no keys")
+
+// _jwtAlgorithms are the asymmetric signature algorithms accepted in tokens; HMAC is refused,
+// since it would let anyone holding the public key sign tokens.
+var _jwtAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// JWTVerifier validates JWTs issued by an OIDC identity provider against its JSON Web Key Set.
+type JWTVerifier struct {
+	jwksURL  string
+	issuer   string
+	audience string
+	refresh  time.Duration
+	client   *http.Client
+	now      func() time.Time
+
+	mu        sync.Mutex
+	keys      jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+type identityClaims struct {
+	Email string `json:"email"`
+}
+
+// NewJWTVerifier creates a new JWTVerifier. The key set is read from an http(s) URL or, for file:// URLs,
+// from a local file, and reloaded every refresh interval (1 hour when non-positive) or when a token names
+// an unknown key. Empty issuer and audience are not checked; a non-positive timeout falls back to 5 seconds.
+func NewJWTVerifier(jwksURL string, issuer string, audience string, refresh time.Duration, timeout time.Duration) *JWTVerifier {
+	if refresh <= 0 {
+		refresh = _defaultJWKSRefresh
+	}
+
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	return &JWTVerifier{
+		jwksURL:  jwksURL,
+		issuer:   issuer,
+		audience: audience,
+		refresh:  refresh,
+		client:   &http.Client{Timeout: timeout},
+		now:      time.Now,
+	}
+}
+
+// Verify checks the token's signature, expiry, issuer and audience and returns its identity claims.
+// Invalid tokens yield ErrUnauthorized; other errors mean the key set could not be loaded.
+func (v *JWTVerifier) Verify(ctx context.Context, token string) (entity.IdentityClaims, error) {
+	parsed, err := jwt.ParseSigned(token, _jwtAlgorithms)
+	if err != nil || len(parsed.Headers) != 1 {
+		return entity.IdentityClaims{}, entity.ErrUnauthorized
+	}
+
+	keys, err := v.keysFor(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return entity.IdentityClaims{}, fmt.Errorf("JWTVerifier - Verify - keysFor: %w", err)
+	}
+
+	var (
+		claims   jwt.Claims
+		identity identityClaims
+		verified bool
+	)
+
+	for _, key := range keys {
+		if err := parsed.Claims(key.Key, &claims, &identity); err == nil {
+			verified = true
+
+			break
+		}
+	}
+
+	if !verified || claims.Expiry == nil {
+		return entity.IdentityClaims{}, entity.ErrUnauthorized
+	}
+
+	expected := jwt.Expected{Issuer: v.issuer, Time: v.now()}
+	if v.audience != "" {
+		expected.AnyAudience = jwt.Audience{v.audience}
+	}
+
+	if err := claims.ValidateWithLeeway(expected, _jwtLeeway); err != nil {
+		return entity.IdentityClaims{}, entity.ErrUnauthorized
+	}
+
+	return entity.IdentityClaims{
+		Subject: claims.Subject,
+		Email:   identity.Email,
+	}, nil
+}
+
+// keysFor returns the signing keys that may have signed a token with the given key ID,
+// reloading the key set when it is stale or does not know the key.
+func (v *JWTVerifier) keysFor(ctx context.Context, keyID string) ([]jose.JSONWebKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	age := v.now().Sub(v.fetchedAt)
+	stale := v.fetchedAt.IsZero() || age >= v.refresh
+	unknown := keyID != "" && len(v.keys.Key(keyID)) == 0 && age >= _minJWKSRefetch
+
+	if stale || unknown {
+		keys, err := v.load(ctx)
+		if err != nil && v.fetchedAt.IsZero() {
+			return nil, err
+		}
+
+		v.fetchedAt = v.now()
+
+		// A failed reload keeps serving the previous key set and is retried shortly
+		if err != nil {
+			v.fetchedAt = v.fetchedAt.Add(_minJWKSRefetch - v.refresh)
+		} else {
+			v.keys = keys
+		}
+	}
+
+	if keyID != "" {
+		return v.keys.Key(keyID), nil
+	}
+
+	return v.keys.Keys, nil
+}
+
+func (v *JWTVerifier) load(ctx context.Context) (jose.JSONWebKeySet, error) {
+	data, err := v.read(ctx)
+	if err != nil {
+		return jose.JSONWebKeySet{}, err
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return jose.JSONWebKeySet{}, fmt.Errorf("parse JWKS: %w", err)
+	}
+
+	if len(keys.Keys) == 0 {
+		return jose.JSONWebKeySet{}, errNoJWKSKeys
+	}
+
+	return keys, nil
+}
+
+func (v *JWTVerifier) read(ctx context.Context) ([]byte, error) {
+	if path, ok := strings.CutPrefix(v.jwksURL, "file://"); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read JWKS file: %w", err)
+		}
+
+		return data, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.jwksURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build JWKS request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := v.client.Do(req) //nolint:gosec // the URL is configured by an operator
+	if err != nil {
+		return nil, fmt.Errorf("fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch JWKS: %w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, _maxJWKSSize))
+	if err != nil {
+		return nil, fmt.Errorf("read JWKS response: %w", err)
+	}
+
+	return data, nil
+}
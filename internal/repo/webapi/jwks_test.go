@@ -0,0 +1,203 @@
+package webapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "pr-reviews"
+)
+
+var testTokenNow = time.Unix(1700000000, 0)
+
+type testSigner struct {
+	keyID string
+	key   *ecdsa.PrivateKey
+}
+
+func newTestSigner(t *testing.T, keyID string) testSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return testSigner{keyID: keyID, key: key}
+}
+
+func (s testSigner) jwk() jose.JSONWebKey {
+	return jose.JSONWebKey{Key: &s.key.PublicKey, KeyID: s.keyID, Algorithm: string(jose.ES256), Use: "sig"}
+}
+
+func (s testSigner) sign(t *testing.T, claims jwt.Claims, email string) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: s.key},
+		(&jose.SignerOptions{}).WithType("JWT").WithHeader("kid", s.keyID))
+	require.NoError(t, err)
+
+	token, err := jwt.Signed(signer).Claims(claims).Claims(map[string]any{"email": email}).Serialize()
+	require.NoError(t, err)
+
+	return token
+}
+
+func validClaims() jwt.Claims {
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "u1",
+		Audience: jwt.Audience{testAudience},
+		IssuedAt: jwt.NewNumericDate(testTokenNow.Add(-time.Minute)),
+		Expiry:   jwt.NewNumericDate(testTokenNow.Add(time.Hour)),
+	}
+}
+
+// serveJWKS stands in for the identity provider; the served key set can be swapped and fetches are counted
+func serveJWKS(t *testing.T, keys ...jose.JSONWebKey) (*httptest.Server, *atomic.Pointer[jose.JSONWebKeySet], *atomic.Int32) {
+	t.Helper()
+
+	var (
+		set     atomic.Pointer[jose.JSONWebKeySet]
+		fetches atomic.Int32
+	)
+
+	set.Store(&jose.JSONWebKeySet{Keys: keys})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(set.Load())
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &set, &fetches
+}
+
+func newTestVerifier(jwksURL string) *JWTVerifier {
+	verifier := NewJWTVerifier(jwksURL, testIssuer, testAudience, time.Hour, time.Second)
+	verifier.now = func() time.Time { return testTokenNow }
+
+	return verifier
+}
+
+func TestJWTVerifier_Verify(t *testing.T) {
+	signer := newTestSigner(t, "key-1")
+	server, _, _ := serveJWKS(t, signer.jwk())
+
+	claims, err := newTestVerifier(server.URL).Verify(context.Background(), signer.sign(t, validClaims(), "alice@example.com"))
+
+	require.NoError(t, err)
+	assert.Equal(t, entity.IdentityClaims{Subject: "u1", Email: "alice@example.com"}, claims)
+}
+
+func TestJWTVerifier_VerifyFromFile(t *testing.T) {
+	signer := newTestSigner(t, "key-1")
+
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{signer.jwk()}})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	claims, err := newTestVerifier("file://"+path).Verify(context.Background(), signer.sign(t, validClaims(), ""))
+
+	require.NoError(t, err)
+	assert.Equal(t, "u1", claims.Subject)
+}
+
+func TestJWTVerifier_RejectsInvalidTokens(t *testing.T) {
+	signer := newTestSigner(t, "key-1")
+	stranger := newTestSigner(t, "key-1")
+	server, _, _ := serveJWKS(t, signer.jwk())
+
+	tests := []struct {
+		name  string
+		token func() string
+	}{
+		{name: "malformed", token: func() string { return "not-a-jwt" }},
+		{name: "signed by another key", token: func() string { return stranger.sign(t, validClaims(), "") }},
+		{name: "expired", token: func() string {
+			claims := validClaims()
+			claims.Expiry = jwt.NewNumericDate(testTokenNow.Add(-2 * time.Minute))
+			return signer.sign(t, claims, "")
+		}},
+		{name: "without expiry", token: func() string {
+			claims := validClaims()
+			claims.Expiry = nil
+			return signer.sign(t, claims, "")
+		}},
+		{name: "wrong issuer", token: func() string {
+			claims := validClaims()
+			claims.Issuer = "https://evil.example.com"
+			return signer.sign(t, claims, "")
+		}},
+		{name: "wrong audience", token: func() string {
+			claims := validClaims()
+			claims.Audience = jwt.Audience{"another-service"}
+			return signer.sign(t, claims, "")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newTestVerifier(server.URL).Verify(context.Background(), tt.token())
+			assert.ErrorIs(t, err, entity.ErrUnauthorized)
+		})
+	}
+}
+
+func TestJWTVerifier_ReloadsForUnknownKey(t *testing.T) {
+	oldSigner := newTestSigner(t, "key-1")
+	newSigner := newTestSigner(t, "key-2")
+	server, set, fetches := serveJWKS(t, oldSigner.jwk())
+
+	verifier := newTestVerifier(server.URL)
+	ctx := context.Background()
+
+	_, err := verifier.Verify(ctx, oldSigner.sign(t, validClaims(), ""))
+	require.NoError(t, err)
+
+	// The identity provider rotates its key; a token with the new kid shortly after the last fetch is refused
+	set.Store(&jose.JSONWebKeySet{Keys: []jose.JSONWebKey{newSigner.jwk()}})
+
+	_, err = verifier.Verify(ctx, newSigner.sign(t, validClaims(), ""))
+	assert.ErrorIs(t, err, entity.ErrUnauthorized)
+	assert.Equal(t, int32(1), fetches.Load())
+
+	// Once the refetch interval has passed, the unknown kid triggers a reload
+	verifier.now = func() time.Time { return testTokenNow.Add(2 * time.Minute) }
+
+	_, err = verifier.Verify(ctx, newSigner.sign(t, validClaims(), ""))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load())
+}
+
+func TestJWTVerifier_KeySetUnavailable(t *testing.T) {
+	signer := newTestSigner(t, "key-1")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	_, err := newTestVerifier(server.URL).Verify(context.Background(), signer.sign(t, validClaims(), ""))
+
+	assert.ErrorIs(t, err, errUnexpectedStatus)
+	assert.NotErrorIs(t, err, entity.ErrUnauthorized)
+}
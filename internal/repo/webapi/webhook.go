@@ -0,0 +1,93 @@
+// Package webapi implements repositories backed by external HTTP APIs.
+package webapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+const (
+	// HeaderEvent carries the event type of a delivery.
+	HeaderEvent = "X-PR-Reviews-Event"
+	// HeaderDelivery carries the delivery id, stable across retries.
+	HeaderDelivery = "X-PR-Reviews-Delivery"
+	// HeaderTimestamp carries the unix time the request was signed at.
+	HeaderTimestamp = "X-PR-Reviews-Timestamp"
+	// HeaderSignature carries "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>".
+	HeaderSignature = "X-PR-Reviews-Signature"
+
+	_defaultTimeout = 5 * time.Second
+)
+
+var errUnexpectedStatus = errors.New("unexpected response status")
+
+// WebhookSender posts signed webhook payloads over HTTP.
+type WebhookSender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewWebhookSender creates a new WebhookSender; a non-positive timeout falls back to 5 seconds.
+func NewWebhookSender(timeout time.Duration) *WebhookSender {
+	if timeout <= 0 {
+		timeout = _defaultTimeout
+	}
+
+	return &WebhookSender{
+		client: &http.Client{Timeout: timeout},
+		now:    time.Now,
+	}
+}
+
+// Send posts the payload to the webhook URL and returns the response status code.
+// Any response outside 2xx is reported as an error together with its status code.
+func (s *WebhookSender) Send(ctx context.Context, request entity.WebhookRequest) (int, error) {
+	timestamp := strconv.FormatInt(s.now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("WebhookSender - Send - NewRequest: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(request.EventType))
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(request.DeliveryID, 10))
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(request.Secret, timestamp, request.Payload))
+
+	resp, err := s.client.Do(req) //nolint:gosec // the URL is registered by an operator through the webhooks API
+	if err != nil {
+		return 0, fmt.Errorf("WebhookSender - Send - Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("WebhookSender - Send: %w: %d", errUnexpectedStatus, resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Sign returns the signature header value for a payload signed at the given unix timestamp.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
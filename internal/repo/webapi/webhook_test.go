@@ -0,0 +1,78 @@
+package webapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookSender_SendSignsRequest(t *testing.T) {
+	payload := []byte(`{"event_id":"evt-1"}`)
+
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sender := NewWebhookSender(time.Second)
+	sender.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	code, err := sender.Send(context.Background(), entity.WebhookRequest{
+		URL:        server.URL,
+		Secret:     "s3cret",
+		EventType:  entity.EventPRCreated,
+		DeliveryID: 42,
+		Payload:    payload,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, "application/json", received.Header.Get("Content-Type"))
+	assert.Equal(t, "pull_request.created", received.Header.Get(HeaderEvent))
+	assert.Equal(t, "42", received.Header.Get(HeaderDelivery))
+	assert.Equal(t, "1700000000", received.Header.Get(HeaderTimestamp))
+	assert.Equal(t, Sign("s3cret", "1700000000", payload), received.Header.Get(HeaderSignature))
+	assert.Equal(t, payload, body)
+}
+
+func TestWebhookSender_SendNon2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	code, err := NewWebhookSender(time.Second).Send(context.Background(), entity.WebhookRequest{URL: server.URL})
+
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestWebhookSender_SendUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	code, err := NewWebhookSender(time.Second).Send(context.Background(), entity.WebhookRequest{URL: url})
+
+	assert.Error(t, err)
+	assert.Equal(t, 0, code)
+}
+
+func TestSign(t *testing.T) {
+	// Reference value: printf '1700000000.{}' | openssl dgst -sha256 -hmac key
+	assert.Equal(t,
+		"sha256=9d713ed406bb7076d4123f0dc2c39d2df5c654ed4b0cd56b52c8b4c940bd63ae",
+		Sign("key", "1700000000", []byte("{}")),
+	)
+}
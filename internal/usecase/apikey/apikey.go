@@ -0,0 +1,134 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+const (
+	// _shownPrefixLen is how much of a token is kept in clear to tell keys apart.
+	_shownPrefixLen = len(entity.APIKeyTokenPrefix) + 8
+
+	_tokenBytes = 32
+	_idBytes    = 8
+)
+
+// UseCase handles API keys: minting, revocation and authentication of bearer tokens.
+type UseCase struct {
+	apiKeyRepo repo.APIKeyRepo
+	now        func() time.Time
+}
+
+// New creates a new APIKey use case instance.
+func New(apiKeyRepo repo.APIKeyRepo) *UseCase {
+	return &UseCase{
+		apiKeyRepo: apiKeyRepo,
+		now:        time.Now,
+	}
+}
+
+// CreateAPIKey mints an API key and returns it together with its token, which is not stored and cannot be shown again
+func (uc *UseCase) CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (entity.APIKey, error) {
+	if expiresAt != nil && !expiresAt.After(uc.now()) {
+		return entity.APIKey{}, entity.ErrInvalidExpiry
+	}
+
+	keyID, err := randomHex(_idBytes)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyUseCase - CreateAPIKey - randomHex id: %w", err)
+	}
+
+	secret, err := randomHex(_tokenBytes)
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyUseCase - CreateAPIKey - randomHex token: %w", err)
+	}
+
+	token := entity.APIKeyTokenPrefix + secret
+
+	key, err := uc.apiKeyRepo.CreateAPIKey(ctx, entity.APIKey{
+		KeyID:       "key-" + keyID,
+		Name:        name,
+		TokenHash:   hashToken(token),
+		TokenPrefix: token[:_shownPrefixLen],
+		Scopes:      scopes,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return entity.APIKey{}, fmt.Errorf("APIKeyUseCase - CreateAPIKey - CreateAPIKey: %w", err)
+	}
+
+	key.Token = token
+
+	return key, nil
+}
+
+// ListAPIKeys retrieves all API keys, including revoked and expired ones; tokens are not included
+func (uc *UseCase) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	keys, err := uc.apiKeyRepo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("APIKeyUseCase - ListAPIKeys - ListAPIKeys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey makes an API key unusable; the key stays listed with its revocation time
+func (uc *UseCase) RevokeAPIKey(ctx context.Context, keyID string) error {
+	err := uc.apiKeyRepo.RevokeAPIKey(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("APIKeyUseCase - RevokeAPIKey - RevokeAPIKey: %w", err)
+	}
+
+	return nil
+}
+
+// Authenticate resolves a bearer token to its API key; unknown, revoked and expired keys yield ErrUnauthorized
+func (uc *UseCase) Authenticate(ctx context.Context, token string) (entity.APIKey, error) {
+	if !strings.HasPrefix(token, entity.APIKeyTokenPrefix) {
+		return entity.APIKey{}, entity.ErrUnauthorized
+	}
+
+	key, err := uc.apiKeyRepo.GetAPIKeyByHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.APIKey{}, entity.ErrUnauthorized
+		}
+
+		return entity.APIKey{}, fmt.Errorf("APIKeyUseCase - Authenticate - GetAPIKeyByHash: %w", err)
+	}
+
+	if !key.IsValidAt(uc.now()) {
+		return entity.APIKey{}, entity.ErrUnauthorized
+	}
+
+	return key, nil
+}
+
+// hashToken returns the hex SHA-256 of a token; tokens are random, so a fast unsalted hash is enough
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
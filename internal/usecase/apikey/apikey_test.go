@@ -0,0 +1,203 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAPIKeyRepo struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyRepo) CreateAPIKey(ctx context.Context, key entity.APIKey) (entity.APIKey, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepo) GetAPIKeyByHash(ctx context.Context, tokenHash string) (entity.APIKey, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepo) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyRepo) RevokeAPIKey(ctx context.Context, keyID string) error {
+	args := m.Called(ctx, keyID)
+	return args.Error(0)
+}
+
+var _ repo.APIKeyRepo = (*mockAPIKeyRepo)(nil)
+
+var testNow = time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+func newTestUseCase(apiKeyRepo repo.APIKeyRepo) *UseCase {
+	uc := New(apiKeyRepo)
+	uc.now = func() time.Time { return testNow }
+
+	return uc
+}
+
+func TestCreateAPIKey_StoresOnlyHash(t *testing.T) {
+	apiKeyRepo := new(mockAPIKeyRepo)
+	uc := newTestUseCase(apiKeyRepo)
+
+	ctx := context.Background()
+	expiresAt := testNow.Add(24 * time.Hour)
+	scopes := []entity.APIKeyScope{entity.APIKeyScopeRead}
+
+	var stored entity.APIKey
+
+	apiKeyRepo.On("CreateAPIKey", ctx, mock.MatchedBy(func(k entity.APIKey) bool {
+		return k.Token == "" && len(k.TokenHash) == 64 && strings.HasPrefix(k.KeyID, "key-") && k.Name == "ci"
+	})).Run(func(args mock.Arguments) {
+		stored = args.Get(1).(entity.APIKey)
+	}).Return(entity.APIKey{KeyID: "key-1", Name: "ci", Scopes: scopes, ExpiresAt: &expiresAt}, nil)
+
+	key, err := uc.CreateAPIKey(ctx, "ci", scopes, &expiresAt)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", key.KeyID)
+	assert.True(t, strings.HasPrefix(key.Token, "prk_"))
+	assert.Equal(t, hashToken(key.Token), stored.TokenHash)
+	assert.True(t, strings.HasPrefix(key.Token, stored.TokenPrefix))
+	apiKeyRepo.AssertExpectations(t)
+}
+
+func TestCreateAPIKey_ExpiryInPast(t *testing.T) {
+	apiKeyRepo := new(mockAPIKeyRepo)
+	uc := newTestUseCase(apiKeyRepo)
+
+	expiresAt := testNow.Add(-time.Minute)
+
+	_, err := uc.CreateAPIKey(context.Background(), "ci", []entity.APIKeyScope{entity.APIKeyScopeRead}, &expiresAt)
+
+	assert.Equal(t, entity.ErrInvalidExpiry, err)
+	apiKeyRepo.AssertNotCalled(t, "CreateAPIKey")
+}
+
+func TestRevokeAPIKey_NotFound(t *testing.T) {
+	apiKeyRepo := new(mockAPIKeyRepo)
+	uc := newTestUseCase(apiKeyRepo)
+
+	ctx := context.Background()
+
+	apiKeyRepo.On("RevokeAPIKey", ctx, "key-404").Return(entity.ErrNotFound)
+
+	err := uc.RevokeAPIKey(ctx, "key-404")
+
+	assert.Equal(t, entity.ErrNotFound, err)
+}
+
+func TestAuthenticate(t *testing.T) {
+	past := testNow.Add(-time.Hour)
+	future := testNow.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		token    string
+		stored   entity.APIKey
+		repoErr  error
+		expected error
+	}{
+		{
+			name:   "valid key",
+			token:  "prk_valid",
+			stored: entity.APIKey{KeyID: "key-1", ExpiresAt: &future},
+		},
+		{
+			name:   "key without expiry",
+			token:  "prk_valid",
+			stored: entity.APIKey{KeyID: "key-1"},
+		},
+		{
+			name:     "unknown token",
+			token:    "prk_unknown",
+			repoErr:  entity.ErrNotFound,
+			expected: entity.ErrUnauthorized,
+		},
+		{
+			name:     "expired key",
+			token:    "prk_expired",
+			stored:   entity.APIKey{KeyID: "key-1", ExpiresAt: &past},
+			expected: entity.ErrUnauthorized,
+		},
+		{
+			name:     "revoked key",
+			token:    "prk_revoked",
+			stored:   entity.APIKey{KeyID: "key-1", RevokedAt: &past},
+			expected: entity.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiKeyRepo := new(mockAPIKeyRepo)
+			uc := newTestUseCase(apiKeyRepo)
+
+			ctx := context.Background()
+
+			apiKeyRepo.On("GetAPIKeyByHash", ctx, hashToken(tt.token)).Return(tt.stored, tt.repoErr)
+
+			key, err := uc.Authenticate(ctx, tt.token)
+
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.stored.KeyID, key.KeyID)
+		})
+	}
+}
+
+func TestAuthenticate_ForeignTokenSkipsLookup(t *testing.T) {
+	apiKeyRepo := new(mockAPIKeyRepo)
+	uc := newTestUseCase(apiKeyRepo)
+
+	_, err := uc.Authenticate(context.Background(), "eyJhbGciOiJSUzI1NiJ9.e30.sig")
+
+	assert.Equal(t, entity.ErrUnauthorized, err)
+	apiKeyRepo.AssertNotCalled(t, "GetAPIKeyByHash")
+}
+
+func TestAuthenticate_RepoError(t *testing.T) {
+	apiKeyRepo := new(mockAPIKeyRepo)
+	uc := newTestUseCase(apiKeyRepo)
+
+	ctx := context.Background()
+	repoErr := errors.New("connection refused")
+
+	apiKeyRepo.On("GetAPIKeyByHash", ctx, mock.Anything).Return(entity.APIKey{}, repoErr)
+
+	_, err := uc.Authenticate(ctx, "prk_token")
+
+	assert.ErrorIs(t, err, repoErr)
+}
+
+func TestAPIKey_HasScope(t *testing.T) {
+	read := entity.APIKey{Scopes: []entity.APIKeyScope{entity.APIKeyScopeRead}}
+	write := entity.APIKey{Scopes: []entity.APIKeyScope{entity.APIKeyScopeWrite}}
+	admin := entity.APIKey{Scopes: []entity.APIKeyScope{entity.APIKeyScopeAdmin}}
+
+	assert.True(t, read.HasScope(entity.APIKeyScopeRead))
+	assert.False(t, read.HasScope(entity.APIKeyScopeWrite))
+	assert.True(t, write.HasScope(entity.APIKeyScopeRead))
+	assert.False(t, write.HasScope(entity.APIKeyScopeAdmin))
+	assert.True(t, admin.HasScope(entity.APIKeyScopeWrite))
+}
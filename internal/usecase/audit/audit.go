@@ -0,0 +1,48 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+// UseCase handles reading the audit log of state changes.
+type UseCase struct {
+	auditRepo repo.AuditRepo
+}
+
+// New creates a new Audit use case instance.
+func New(auditRepo repo.AuditRepo) *UseCase {
+	return &UseCase{
+		auditRepo: auditRepo,
+	}
+}
+
+// ListEntries retrieves a page of audit entries matching the filter, newest first
+func (uc *UseCase) ListEntries(ctx context.Context, filter entity.AuditFilter, page entity.PageRequest) (entity.AuditPage, error) {
+	scope := entity.NewPageScope("", filter)
+
+	after, err := entity.DecodeCursor(page.Cursor, scope)
+	if err != nil {
+		return entity.AuditPage{}, err
+	}
+
+	limit := page.NormalizedLimit()
+
+	// Fetch one extra row to know whether there is a next page
+	entries, err := uc.auditRepo.ListEntries(ctx, filter, after, limit+1)
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCursor) {
+			return entity.AuditPage{}, entity.ErrInvalidCursor
+		}
+
+		return entity.AuditPage{}, fmt.Errorf("AuditUseCase - ListEntries - ListEntries: %w", err)
+	}
+
+	entries, nextCursor := entity.TrimPage(entries, limit, scope, entity.AuditCursor)
+
+	return entity.AuditPage{Entries: entries, NextCursor: nextCursor}, nil
+}
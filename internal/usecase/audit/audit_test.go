@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAuditRepo struct {
+	mock.Mock
+}
+
+func (m *mockAuditRepo) ListEntries(ctx context.Context, filter entity.AuditFilter, after *entity.Cursor, limit int) ([]entity.AuditEntry, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.AuditEntry), args.Error(1)
+}
+
+var _ repo.AuditRepo = (*mockAuditRepo)(nil)
+
+func auditEntries(ids ...int64) []entity.AuditEntry {
+	entries := make([]entity.AuditEntry, 0, len(ids))
+	for _, id := range ids {
+		entries = append(entries, entity.AuditEntry{
+			AuditID:    id,
+			Action:     entity.AuditPRMerged,
+			EntityType: entity.AuditEntityPullRequest,
+			EntityID:   "pr-" + strconv.FormatInt(id, 10),
+			CreatedAt:  time.Date(2026, 10, 18, 12, 0, int(id), 0, time.UTC),
+		})
+	}
+
+	return entries
+}
+
+func TestListEntries(t *testing.T) {
+	ctx := context.Background()
+	filter := entity.AuditFilter{Actor: "u1", EntityType: entity.AuditEntityPullRequest}
+
+	t.Run("first page with next cursor", func(t *testing.T) {
+		auditRepo := new(mockAuditRepo)
+		auditRepo.On("ListEntries", ctx, filter, (*entity.Cursor)(nil), 3).Return(auditEntries(5, 4, 3), nil)
+
+		page, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{Limit: 2})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Entries, 2)
+		assert.Equal(t, int64(4), page.Entries[1].AuditID)
+
+		next, err := entity.DecodeCursor(page.NextCursor, entity.NewPageScope("", filter))
+		require.NoError(t, err)
+		assert.Equal(t, "4", next.ID)
+		auditRepo.AssertExpectations(t)
+	})
+
+	t.Run("next page", func(t *testing.T) {
+		cursor := entity.AuditCursor(auditEntries(4)[0])
+		cursor.PageScope = entity.NewPageScope("", filter)
+		auditRepo := new(mockAuditRepo)
+		auditRepo.On("ListEntries", ctx, filter, mock.MatchedBy(func(c *entity.Cursor) bool {
+			return c != nil && c.ID == "4"
+		}), 3).Return(auditEntries(3), nil)
+
+		page, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{Limit: 2, Cursor: cursor.Encode()})
+
+		require.NoError(t, err)
+		assert.Len(t, page.Entries, 1)
+		assert.Empty(t, page.NextCursor)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		auditRepo := new(mockAuditRepo)
+
+		_, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{Cursor: "%%%"})
+
+		assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+		auditRepo.AssertNotCalled(t, "ListEntries")
+	})
+
+	t.Run("cursor of another listing", func(t *testing.T) {
+		auditRepo := new(mockAuditRepo)
+		auditRepo.On("ListEntries", ctx, filter, mock.Anything, entity.DefaultPageLimit+1).Return(nil, entity.ErrInvalidCursor)
+
+		cursor := entity.Cursor{ID: "u1", PageScope: entity.NewPageScope("", filter)}
+		_, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{Cursor: cursor.Encode()})
+
+		assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+	})
+
+	t.Run("cursor of another filter", func(t *testing.T) {
+		cursor := entity.AuditCursor(auditEntries(4)[0])
+		cursor.PageScope = entity.NewPageScope("", entity.AuditFilter{Actor: "u2"})
+		auditRepo := new(mockAuditRepo)
+
+		_, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{Cursor: cursor.Encode()})
+
+		assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+		auditRepo.AssertNotCalled(t, "ListEntries")
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		auditRepo := new(mockAuditRepo)
+		auditRepo.On("ListEntries", ctx, filter, (*entity.Cursor)(nil), entity.DefaultPageLimit+1).Return(nil, errors.New("db down"))
+
+		_, err := New(auditRepo).ListEntries(ctx, filter, entity.PageRequest{})
+
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, entity.ErrInvalidCursor)
+	})
+}
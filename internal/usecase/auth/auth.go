@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+var (
+	// _userScopes are granted to callers authenticated as a user; only admins may manage API keys.
+	_userScopes  = []entity.APIKeyScope{entity.APIKeyScopeRead, entity.APIKeyScopeWrite}
+	_adminScopes = []entity.APIKeyScope{entity.APIKeyScopeAdmin}
+)
+
+// UseCase authenticates bearer tokens: API keys and JWTs issued by the OIDC identity provider.
+type UseCase struct {
+	apiKey       usecase.APIKey
+	verifier     repo.TokenVerifier
+	identityRepo repo.IdentityRepo
+	userRepo     repo.UserRepo
+	userClaim    entity.UserClaim
+}
+
+// New creates a new Auth use case instance. A nil verifier disables JWTs, so only API keys are accepted.
+func New(apiKey usecase.APIKey, verifier repo.TokenVerifier, identityRepo repo.IdentityRepo, userRepo repo.UserRepo, userClaim entity.UserClaim) *UseCase {
+	return &UseCase{
+		apiKey:       apiKey,
+		verifier:     verifier,
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		userClaim:    userClaim,
+	}
+}
+
+// Authenticate resolves a bearer token to the caller. API keys yield a principal with the key's scopes,
+// acting as an admin with the admin scope and as a bot otherwise; JWTs yield the user the token's claim
+// maps to, with the user's role. Invalid tokens and unknown users yield ErrUnauthorized.
+func (uc *UseCase) Authenticate(ctx context.Context, token string) (entity.Principal, error) {
+	if strings.HasPrefix(token, entity.APIKeyTokenPrefix) {
+		key, err := uc.apiKey.Authenticate(ctx, token)
+		if err != nil {
+			if errors.Is(err, entity.ErrUnauthorized) {
+				return entity.Principal{}, entity.ErrUnauthorized
+			}
+
+			return entity.Principal{}, fmt.Errorf("AuthUseCase - Authenticate - apiKey.Authenticate: %w", err)
+		}
+
+		role := entity.RoleBot
+		if key.HasScope(entity.APIKeyScopeAdmin) {
+			role = entity.RoleAdmin
+		}
+
+		return entity.Principal{KeyID: key.KeyID, Role: role, Scopes: key.Scopes}, nil
+	}
+
+	if uc.verifier == nil {
+		return entity.Principal{}, entity.ErrUnauthorized
+	}
+
+	claims, err := uc.verifier.Verify(ctx, token)
+	if err != nil {
+		if errors.Is(err, entity.ErrUnauthorized) {
+			return entity.Principal{}, entity.ErrUnauthorized
+		}
+
+		return entity.Principal{}, fmt.Errorf("AuthUseCase - Authenticate - Verify: %w", err)
+	}
+
+	userID, err := uc.resolveUser(ctx, claims)
+	if err != nil {
+		if errors.Is(err, entity.ErrUnauthorized) {
+			return entity.Principal{}, entity.ErrUnauthorized
+		}
+
+		return entity.Principal{}, fmt.Errorf("AuthUseCase - Authenticate - resolveUser: %w", err)
+	}
+
+	role, err := uc.userRepo.GetUserRole(ctx, userID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.Principal{}, entity.ErrUnauthorized
+		}
+
+		return entity.Principal{}, fmt.Errorf("AuthUseCase - Authenticate - GetUserRole: %w", err)
+	}
+
+	scopes := _userScopes
+	if role == entity.RoleAdmin {
+		scopes = _adminScopes
+	}
+
+	return entity.Principal{UserID: userID, Role: role, Scopes: scopes}, nil
+}
+
+// resolveUser maps the configured claim to a user ID: an explicit "oidc" identity mapping wins, otherwise the
+// subject is taken as the user ID. An email is only resolved through the mapping, which admins manage; users'
+// digest addresses are set by the users themselves and prove nothing about who signs in.
+// A subject is returned unchecked; Authenticate rejects it when no such user exists.
+func (uc *UseCase) resolveUser(ctx context.Context, claims entity.IdentityClaims) (string, error) {
+	value := claims.Subject
+	if uc.userClaim == entity.UserClaimEmail {
+		value = claims.Email
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", entity.ErrUnauthorized
+	}
+
+	userID, err := uc.identityRepo.GetUserIDByLogin(ctx, entity.IdentityProviderOIDC, strings.ToLower(value))
+	if err == nil {
+		return userID, nil
+	}
+
+	if !errors.Is(err, entity.ErrNotFound) {
+		return "", fmt.Errorf("GetUserIDByLogin: %w", err)
+	}
+
+	if uc.userClaim == entity.UserClaimEmail {
+		return "", entity.ErrUnauthorized
+	}
+
+	return value, nil
+}
@@ -0,0 +1,357 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockAPIKeyUseCase struct {
+	mock.Mock
+}
+
+func (m *mockAPIKeyUseCase) CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (entity.APIKey, error) {
+	args := m.Called(ctx, name, scopes, expiresAt)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyUseCase) ListAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]entity.APIKey), args.Error(1)
+}
+
+func (m *mockAPIKeyUseCase) RevokeAPIKey(ctx context.Context, keyID string) error {
+	args := m.Called(ctx, keyID)
+	return args.Error(0)
+}
+
+func (m *mockAPIKeyUseCase) Authenticate(ctx context.Context, token string) (entity.APIKey, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(entity.APIKey), args.Error(1)
+}
+
+type mockTokenVerifier struct {
+	mock.Mock
+}
+
+func (m *mockTokenVerifier) Verify(ctx context.Context, token string) (entity.IdentityClaims, error) {
+	args := m.Called(ctx, token)
+	return args.Get(0).(entity.IdentityClaims), args.Error(1)
+}
+
+type mockIdentityRepo struct {
+	mock.Mock
+}
+
+func (m *mockIdentityRepo) SetIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *mockIdentityRepo) GetUserIDByLogin(ctx context.Context, provider entity.IdentityProvider, login string) (string, error) {
+	args := m.Called(ctx, provider, login)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityRepo) GetLoginByUserID(ctx context.Context, provider entity.IdentityProvider, userID string) (string, error) {
+	args := m.Called(ctx, provider, userID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityRepo) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	args := m.Called(ctx, provider)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockIdentityRepo) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	args := m.Called(ctx, provider, login)
+	return args.Error(0)
+}
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+var (
+	_ usecase.APIKey     = (*mockAPIKeyUseCase)(nil)
+	_ repo.TokenVerifier = (*mockTokenVerifier)(nil)
+	_ repo.IdentityRepo  = (*mockIdentityRepo)(nil)
+	_ repo.UserRepo      = (*mockUserRepo)(nil)
+)
+
+type mocks struct {
+	apiKey   *mockAPIKeyUseCase
+	verifier *mockTokenVerifier
+	identity *mockIdentityRepo
+	users    *mockUserRepo
+}
+
+func newUseCase(userClaim entity.UserClaim) (*UseCase, mocks) {
+	m := mocks{
+		apiKey:   new(mockAPIKeyUseCase),
+		verifier: new(mockTokenVerifier),
+		identity: new(mockIdentityRepo),
+		users:    new(mockUserRepo),
+	}
+
+	return New(m.apiKey, m.verifier, m.identity, m.users, userClaim), m
+}
+
+func TestAuthenticate_APIKey(t *testing.T) {
+	uc, m := newUseCase(entity.UserClaimSubject)
+	ctx := context.Background()
+
+	scopes := []entity.APIKeyScope{entity.APIKeyScopeAdmin}
+	m.apiKey.On("Authenticate", ctx, "prk_secret").Return(entity.APIKey{KeyID: "key-1", Scopes: scopes}, nil)
+
+	principal, err := uc.Authenticate(ctx, "prk_secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Principal{KeyID: "key-1", Role: entity.RoleAdmin, Scopes: scopes}, principal)
+	m.verifier.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything)
+}
+
+func TestAuthenticate_APIKeyWithoutAdminScopeActsAsBot(t *testing.T) {
+	uc, m := newUseCase(entity.UserClaimSubject)
+	ctx := context.Background()
+
+	scopes := []entity.APIKeyScope{entity.APIKeyScopeWrite}
+	m.apiKey.On("Authenticate", ctx, "prk_secret").Return(entity.APIKey{KeyID: "key-1", Scopes: scopes}, nil)
+
+	principal, err := uc.Authenticate(ctx, "prk_secret")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.RoleBot, principal.Role)
+}
+
+func TestAuthenticate_UnknownAPIKey(t *testing.T) {
+	uc, m := newUseCase(entity.UserClaimSubject)
+	ctx := context.Background()
+
+	m.apiKey.On("Authenticate", ctx, "prk_unknown").Return(entity.APIKey{}, entity.ErrUnauthorized)
+
+	_, err := uc.Authenticate(ctx, "prk_unknown")
+
+	assert.ErrorIs(t, err, entity.ErrUnauthorized)
+}
+
+func TestAuthenticate_JWTWithoutVerifier(t *testing.T) {
+	uc := New(new(mockAPIKeyUseCase), nil, new(mockIdentityRepo), new(mockUserRepo), entity.UserClaimSubject)
+
+	_, err := uc.Authenticate(context.Background(), "eyJhbGciOiJSUzI1NiJ9.e30.sig")
+
+	assert.ErrorIs(t, err, entity.ErrUnauthorized)
+}
+
+func TestAuthenticate_JWT(t *testing.T) {
+	tests := []struct {
+		name     string
+		claim    entity.UserClaim
+		claims   entity.IdentityClaims
+		setup    func(m mocks)
+		expected string
+		role     entity.Role
+		err      error
+	}{
+		{
+			name:   "identity mapping wins",
+			claim:  entity.UserClaimSubject,
+			claims: entity.IdentityClaims{Subject: "00uAbC"},
+			setup: func(m mocks) {
+				m.identity.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderOIDC, "00uabc").Return("u1", nil)
+				m.users.On("GetUserRole", mock.Anything, "u1").Return(entity.RoleMember, nil)
+			},
+			expected: "u1",
+			role:     entity.RoleMember,
+		},
+		{
+			name:   "subject is the user ID",
+			claim:  entity.UserClaimSubject,
+			claims: entity.IdentityClaims{Subject: "u2"},
+			setup: func(m mocks) {
+				m.identity.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderOIDC, "u2").Return("", entity.ErrNotFound)
+				m.users.On("GetUserRole", mock.Anything, "u2").Return(entity.RoleTeamLead, nil)
+			},
+			expected: "u2",
+			role:     entity.RoleTeamLead,
+		},
+		{
+			name:   "unknown subject",
+			claim:  entity.UserClaimSubject,
+			claims: entity.IdentityClaims{Subject: "ghost"},
+			setup: func(m mocks) {
+				m.identity.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderOIDC, "ghost").Return("", entity.ErrNotFound)
+				m.users.On("GetUserRole", mock.Anything, "ghost").Return(entity.Role(""), entity.ErrNotFound)
+			},
+			err: entity.ErrUnauthorized,
+		},
+		{
+			name:   "email mapped to a user",
+			claim:  entity.UserClaimEmail,
+			claims: entity.IdentityClaims{Subject: "00uabc", Email: "Alice@Example.com"},
+			setup: func(m mocks) {
+				m.identity.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderOIDC, "alice@example.com").Return("u3", nil)
+				m.users.On("GetUserRole", mock.Anything, "u3").Return(entity.RoleMember, nil)
+			},
+			expected: "u3",
+			role:     entity.RoleMember,
+		},
+		{
+			// Digest addresses are set by users themselves, so they are never matched
+			name:   "unmapped email",
+			claim:  entity.UserClaimEmail,
+			claims: entity.IdentityClaims{Subject: "u1", Email: "alice@example.com"},
+			setup: func(m mocks) {
+				m.identity.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderOIDC, "alice@example.com").Return("", entity.ErrNotFound)
+			},
+			err: entity.ErrUnauthorized,
+		},
+		{
+			name:   "missing email claim",
+			claim:  entity.UserClaimEmail,
+			claims: entity.IdentityClaims{Subject: "u1"},
+			setup:  func(mocks) {},
+			err:    entity.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, m := newUseCase(tt.claim)
+			ctx := context.Background()
+
+			m.verifier.On("Verify", ctx, "jwt").Return(tt.claims, nil)
+			tt.setup(m)
+
+			principal, err := uc.Authenticate(ctx, "jwt")
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, principal.UserID)
+			assert.Equal(t, tt.role, principal.Role)
+			assert.Empty(t, principal.KeyID)
+			assert.True(t, principal.HasScope(entity.APIKeyScopeWrite))
+			assert.False(t, principal.HasScope(entity.APIKeyScopeAdmin))
+		})
+	}
+}
+
+func TestAuthenticate_AdminUserManagesAPIKeys(t *testing.T) {
+	uc, m := newUseCase(entity.UserClaimSubject)
+	ctx := context.Background()
+
+	m.verifier.On("Verify", ctx, "jwt").Return(entity.IdentityClaims{Subject: "u1"}, nil)
+	m.identity.On("GetUserIDByLogin", ctx, entity.IdentityProviderOIDC, "u1").Return("", entity.ErrNotFound)
+	m.users.On("GetUserRole", ctx, "u1").Return(entity.RoleAdmin, nil)
+
+	principal, err := uc.Authenticate(ctx, "jwt")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.RoleAdmin, principal.Role)
+	assert.True(t, principal.HasScope(entity.APIKeyScopeAdmin))
+}
+
+func TestAuthenticate_VerifierError(t *testing.T) {
+	uc, m := newUseCase(entity.UserClaimSubject)
+	ctx := context.Background()
+
+	jwksErr := errors.New("fetch JWKS: connection refused")
+	m.verifier.On("Verify", ctx, "jwt").Return(entity.IdentityClaims{}, jwksErr)
+
+	_, err := uc.Authenticate(ctx, "jwt")
+
+	assert.ErrorIs(t, err, jwksErr)
+	assert.NotErrorIs(t, err, entity.ErrUnauthorized)
+}
@@ -0,0 +1,253 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+const (
+	// _sweepLockKey identifies the advisory lock that lets only one instance announce overdue reviews at a time.
+	_sweepLockKey int64 = 0x70725f63686174 // "pr_chat"
+
+	_defaultBatchSize = 100
+)
+
+// UseCase posts review notifications to the chat channels of reviewers' teams.
+type UseCase struct {
+	teamRepo   repo.TeamRepo
+	userRepo   repo.UserRepo
+	prRepo     repo.PullRequestRepo
+	lockRepo   repo.LockRepo
+	sender     repo.ChatSender
+	defaultSLA time.Duration
+	batchSize  int
+}
+
+// New creates a new Chat use case instance; defaultSLA applies to teams without their own SLA.
+func New(
+	teamRepo repo.TeamRepo,
+	userRepo repo.UserRepo,
+	prRepo repo.PullRequestRepo,
+	lockRepo repo.LockRepo,
+	sender repo.ChatSender,
+	defaultSLA time.Duration,
+	batchSize int,
+) *UseCase {
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+
+	return &UseCase{
+		teamRepo:   teamRepo,
+		userRepo:   userRepo,
+		prRepo:     prRepo,
+		lockRepo:   lockRepo,
+		sender:     sender,
+		defaultSLA: defaultSLA,
+		batchSize:  batchSize,
+	}
+}
+
+// Publish announces reviewer.assigned and reviewer.reassigned events in the channel of the (new) reviewer's team.
+// Other events, unknown users or PRs and teams without a channel are skipped.
+func (uc *UseCase) Publish(ctx context.Context, event entity.Event) error {
+	var (
+		prID          string
+		reviewerID    string
+		oldReviewerID string
+		reason        entity.ReassignmentReason
+	)
+
+	switch event.Type {
+	case entity.EventReviewerAssigned:
+		var data entity.ReviewerAssignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return fmt.Errorf("ChatUseCase - Publish - %w", err)
+		}
+
+		prID, reviewerID = data.PullRequestID, data.ReviewerID
+	case entity.EventReviewerReassigned:
+		var data entity.ReviewerReassignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return fmt.Errorf("ChatUseCase - Publish - %w", err)
+		}
+
+		prID, reviewerID, oldReviewerID, reason = data.PullRequestID, data.NewReviewerID, data.OldReviewerID, data.Reason
+	default:
+		return nil
+	}
+
+	userIDs := []string{reviewerID}
+	if oldReviewerID != "" {
+		userIDs = append(userIDs, oldReviewerID)
+	}
+
+	users, err := uc.usersByID(ctx, userIDs)
+	if err != nil {
+		return fmt.Errorf("ChatUseCase - Publish - %w", err)
+	}
+
+	reviewer, ok := users[reviewerID]
+	if !ok {
+		return nil
+	}
+
+	url, err := uc.teamRepo.GetChatWebhookURL(ctx, reviewer.TeamName)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("ChatUseCase - Publish - GetChatWebhookURL: %w", err)
+	}
+
+	if url == "" {
+		return nil
+	}
+
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("ChatUseCase - Publish - GetPR: %w", err)
+	}
+
+	message := assignedMessage(pr, username(users, reviewerID))
+	if oldReviewerID != "" {
+		message = reassignedMessage(pr, username(users, oldReviewerID), username(users, reviewerID), reason)
+	}
+
+	err = uc.sender.Send(ctx, url, message)
+	if err != nil {
+		return fmt.Errorf("ChatUseCase - Publish - Send %s: %w", event.EventID, err)
+	}
+
+	return nil
+}
+
+// NotifyOverdueReviews announces each review that went past its team's SLA once, in the channel of the reviewer's team.
+// Only one instance sweeps at a time; when another instance holds the lock the result is empty.
+func (uc *UseCase) NotifyOverdueReviews(ctx context.Context) (entity.ChatNotificationResult, error) {
+	var result entity.ChatNotificationResult
+
+	_, err := uc.lockRepo.WithTryLock(ctx, _sweepLockKey, func(ctx context.Context) error {
+		var err error
+		result, err = uc.sweep(ctx)
+
+		return err
+	})
+	if err != nil {
+		return result, fmt.Errorf("ChatUseCase - NotifyOverdueReviews - WithTryLock: %w", err)
+	}
+
+	return result, nil
+}
+
+// sweep announces one batch of overdue reviews, continuing past individual failures; failed ones are retried next sweep
+func (uc *UseCase) sweep(ctx context.Context) (entity.ChatNotificationResult, error) {
+	var result entity.ChatNotificationResult
+
+	overdue, err := uc.prRepo.GetUnnotifiedOverdueReviews(ctx, uc.defaultSLA, uc.batchSize)
+	if err != nil {
+		return result, fmt.Errorf("GetUnnotifiedOverdueReviews: %w", err)
+	}
+
+	result.Overdue = len(overdue)
+	if len(overdue) == 0 {
+		return result, nil
+	}
+
+	reviewerIDs := make([]string, 0, len(overdue))
+	for _, review := range overdue {
+		reviewerIDs = append(reviewerIDs, review.ReviewerID)
+	}
+
+	users, err := uc.usersByID(ctx, reviewerIDs)
+	if err != nil {
+		return result, err
+	}
+
+	urls := make(map[string]string)
+
+	var errs []error
+	for _, review := range overdue {
+		url, ok := urls[review.TeamName]
+		if !ok {
+			url, err = uc.teamRepo.GetChatWebhookURL(ctx, review.TeamName)
+			if err != nil {
+				result.Failed++
+				errs = append(errs, fmt.Errorf("GetChatWebhookURL %s: %w", review.TeamName, err))
+
+				continue
+			}
+
+			urls[review.TeamName] = url
+		}
+
+		err = uc.sender.Send(ctx, url, overdueMessage(review, username(users, review.ReviewerID)))
+		if err != nil {
+			result.Failed++
+			errs = append(errs, fmt.Errorf("Send %s/%s: %w", review.PullRequestID, review.ReviewerID, err))
+
+			continue
+		}
+
+		err = uc.prRepo.MarkOverdueNotified(ctx, review.PullRequestID, review.ReviewerID)
+		if err != nil && !errors.Is(err, entity.ErrNotAssigned) {
+			result.Failed++
+			errs = append(errs, fmt.Errorf("MarkOverdueNotified %s/%s: %w", review.PullRequestID, review.ReviewerID, err))
+
+			continue
+		}
+
+		result.Notified++
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// usersByID loads the users with the given IDs, leaving out unknown ones
+func (uc *UseCase) usersByID(ctx context.Context, userIDs []string) (map[string]entity.User, error) {
+	users, err := uc.userRepo.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("GetUsers: %w", err)
+	}
+
+	byID := make(map[string]entity.User, len(users))
+	for _, user := range users {
+		byID[user.UserID] = user
+	}
+
+	return byID, nil
+}
+
+// username returns the username of a loaded user, falling back to the user ID
+func username(users map[string]entity.User, userID string) string {
+	if user, ok := users[userID]; ok && user.Username != "" {
+		return user.Username
+	}
+
+	return userID
+}
+
+// decodeEventData converts event data into its payload type; relayed events carry it as raw JSON
+func decodeEventData(event entity.Event, v any) error {
+	raw, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("Marshal %s data: %w", event.Type, err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("Unmarshal %s data: %w", event.Type, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,508 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTeamRepo struct {
+	mock.Mock
+}
+
+func (m *mockTeamRepo) CreateTeam(ctx context.Context, team entity.Team) error {
+	args := m.Called(ctx, team)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return entity.Team{}, args.Error(1)
+	}
+	return args.Get(0).(entity.Team), args.Error(1)
+}
+
+func (m *mockTeamRepo) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	args := m.Called(ctx, teamName)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockTeamRepo) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamRepo) SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error {
+	args := m.Called(ctx, teamName, slaSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error {
+	args := m.Called(ctx, teamName, thresholdSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetChatWebhookURL(ctx context.Context, teamName string, url *string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetChatWebhookURL(ctx context.Context, teamName string) (string, error) {
+	args := m.Called(ctx, teamName)
+	return args.String(0), args.Error(1)
+}
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+type mockLockRepo struct {
+	mock.Mock
+}
+
+func (m *mockLockRepo) WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx, key)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+type mockChatSender struct {
+	mock.Mock
+}
+
+func (m *mockChatSender) Send(ctx context.Context, url string, message entity.ChatMessage) error {
+	args := m.Called(ctx, url, message)
+	return args.Error(0)
+}
+
+var (
+	_ repo.TeamRepo        = (*mockTeamRepo)(nil)
+	_ repo.UserRepo        = (*mockUserRepo)(nil)
+	_ repo.PullRequestRepo = (*mockPRRepo)(nil)
+	_ repo.LockRepo        = (*mockLockRepo)(nil)
+	_ repo.ChatSender      = (*mockChatSender)(nil)
+)
+
+const _channel = "https://chat.example.com/hooks/backend"
+
+type mocks struct {
+	teamRepo *mockTeamRepo
+	userRepo *mockUserRepo
+	prRepo   *mockPRRepo
+	lockRepo *mockLockRepo
+	sender   *mockChatSender
+}
+
+func newUseCase() (*UseCase, mocks) {
+	m := mocks{
+		teamRepo: new(mockTeamRepo),
+		userRepo: new(mockUserRepo),
+		prRepo:   new(mockPRRepo),
+		lockRepo: new(mockLockRepo),
+		sender:   new(mockChatSender),
+	}
+
+	return New(m.teamRepo, m.userRepo, m.prRepo, m.lockRepo, m.sender, 24*time.Hour, 10), m
+}
+
+// relayed returns the event as the outbox relay hands it over, with raw JSON data
+func relayed(eventType entity.EventType, data any) entity.Event {
+	raw, _ := json.Marshal(data)
+
+	return entity.Event{EventID: "evt-1", Type: eventType, Data: json.RawMessage(raw)}
+}
+
+func TestPublish_ReviewerAssigned(t *testing.T) {
+	uc, m := newUseCase()
+	ctx := context.Background()
+
+	m.userRepo.On("GetUsers", ctx, []string{"u2"}).Return([]entity.User{{UserID: "u2", Username: "bob", TeamName: "backend"}}, nil)
+	m.teamRepo.On("GetChatWebhookURL", ctx, "backend").Return(_channel, nil)
+	m.prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", PullRequestName: "Add search"}, nil)
+	m.sender.On("Send", ctx, _channel, entity.ChatMessage{Text: ":eyes: @bob, please review \"Add search\" (`pr-1`)"}).Return(nil)
+
+	err := uc.Publish(ctx, relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"}))
+
+	assert.NoError(t, err)
+	m.sender.AssertExpectations(t)
+}
+
+func TestPublish_ReviewerReassigned(t *testing.T) {
+	uc, m := newUseCase()
+	ctx := context.Background()
+
+	m.userRepo.On("GetUsers", ctx, []string{"u3", "u2"}).Return([]entity.User{
+		{UserID: "u2", Username: "bob", TeamName: "backend"},
+		{UserID: "u3", Username: "carol", TeamName: "backend"},
+	}, nil)
+	m.teamRepo.On("GetChatWebhookURL", ctx, "backend").Return(_channel, nil)
+	m.prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", PullRequestName: "Add search"}, nil)
+	m.sender.On("Send", ctx, _channel, entity.ChatMessage{
+		Text: ":arrows_counterclockwise: @carol, please review \"Add search\" (`pr-1`) instead of @bob — the review was stale",
+	}).Return(nil)
+
+	err := uc.Publish(ctx, relayed(entity.EventReviewerReassigned, entity.ReviewerReassignedEventData{
+		PullRequestID: "pr-1",
+		OldReviewerID: "u2",
+		NewReviewerID: "u3",
+		Reason:        entity.ReassignmentAutomatic,
+	}))
+
+	assert.NoError(t, err)
+	m.sender.AssertExpectations(t)
+}
+
+func TestPublish_Skipped(t *testing.T) {
+	assigned := relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"})
+
+	tests := []struct {
+		name  string
+		event entity.Event
+		setup func(m mocks)
+	}{
+		{name: "other event", event: relayed(entity.EventPRMerged, map[string]any{"pull_request": map[string]any{"pull_request_id": "pr-1"}})},
+		{
+			name:  "unknown reviewer",
+			event: assigned,
+			setup: func(m mocks) {
+				m.userRepo.On("GetUsers", mock.Anything, []string{"u2"}).Return([]entity.User{}, nil)
+			},
+		},
+		{
+			name:  "team without channel",
+			event: assigned,
+			setup: func(m mocks) {
+				m.userRepo.On("GetUsers", mock.Anything, []string{"u2"}).Return([]entity.User{{UserID: "u2", TeamName: "backend"}}, nil)
+				m.teamRepo.On("GetChatWebhookURL", mock.Anything, "backend").Return("", nil)
+			},
+		},
+		{
+			name:  "unknown PR",
+			event: assigned,
+			setup: func(m mocks) {
+				m.userRepo.On("GetUsers", mock.Anything, []string{"u2"}).Return([]entity.User{{UserID: "u2", TeamName: "backend"}}, nil)
+				m.teamRepo.On("GetChatWebhookURL", mock.Anything, "backend").Return(_channel, nil)
+				m.prRepo.On("GetPR", mock.Anything, "pr-1").Return(nil, entity.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, m := newUseCase()
+			if tt.setup != nil {
+				tt.setup(m)
+			}
+
+			err := uc.Publish(context.Background(), tt.event)
+
+			assert.NoError(t, err)
+			m.sender.AssertNotCalled(t, "Send")
+		})
+	}
+}
+
+func TestPublish_SendError(t *testing.T) {
+	uc, m := newUseCase()
+	ctx := context.Background()
+
+	m.userRepo.On("GetUsers", ctx, []string{"u2"}).Return([]entity.User{{UserID: "u2", Username: "bob", TeamName: "backend"}}, nil)
+	m.teamRepo.On("GetChatWebhookURL", ctx, "backend").Return(_channel, nil)
+	m.prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1"}, nil)
+	m.sender.On("Send", ctx, _channel, mock.Anything).Return(errors.New("channel not found"))
+
+	err := uc.Publish(ctx, relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"}))
+
+	assert.Error(t, err)
+}
+
+func TestNotifyOverdueReviews(t *testing.T) {
+	uc, m := newUseCase()
+	ctx := context.Background()
+
+	overdue := []entity.OverdueReview{
+		{
+			PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1", PullRequestName: "Add search"},
+			ReviewerID:       "u2",
+			TeamName:         "backend",
+			SLASeconds:       86400,
+			OverdueSeconds:   3*3600 + 20*60,
+		},
+		{
+			PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-2", PullRequestName: "Fix login"},
+			ReviewerID:       "u3",
+			TeamName:         "backend",
+			SLASeconds:       86400,
+			OverdueSeconds:   600,
+		},
+	}
+
+	m.lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(true, nil)
+	m.prRepo.On("GetUnnotifiedOverdueReviews", ctx, 24*time.Hour, 10).Return(overdue, nil)
+	m.userRepo.On("GetUsers", ctx, []string{"u2", "u3"}).Return([]entity.User{
+		{UserID: "u2", Username: "bob", TeamName: "backend"},
+		{UserID: "u3", Username: "carol", TeamName: "backend"},
+	}, nil)
+	m.teamRepo.On("GetChatWebhookURL", ctx, "backend").Return(_channel, nil).Once()
+	m.sender.On("Send", ctx, _channel, entity.ChatMessage{
+		Text: ":hourglass: @bob, your review of \"Add search\" (`pr-1`) is overdue by 3h 20m (SLA 1d)",
+	}).Return(nil)
+	m.sender.On("Send", ctx, _channel, entity.ChatMessage{
+		Text: ":hourglass: @carol, your review of \"Fix login\" (`pr-2`) is overdue by 10m (SLA 1d)",
+	}).Return(errors.New("rate limited"))
+	m.prRepo.On("MarkOverdueNotified", ctx, "pr-1", "u2").Return(nil)
+
+	result, err := uc.NotifyOverdueReviews(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, entity.ChatNotificationResult{Overdue: 2, Notified: 1, Failed: 1}, result)
+	m.prRepo.AssertNotCalled(t, "MarkOverdueNotified", ctx, "pr-2", "u3")
+	m.teamRepo.AssertExpectations(t)
+	m.sender.AssertExpectations(t)
+}
+
+func TestNotifyOverdueReviews_LockHeldElsewhere(t *testing.T) {
+	uc, m := newUseCase()
+	ctx := context.Background()
+
+	m.lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(false, nil)
+
+	result, err := uc.NotifyOverdueReviews(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.ChatNotificationResult{}, result)
+	m.prRepo.AssertNotCalled(t, "GetUnnotifiedOverdueReviews")
+}
+
+func TestFormatDuration(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		want    string
+	}{
+		{seconds: 30, want: "less than a minute"},
+		{seconds: 45 * 60, want: "45m"},
+		{seconds: 2 * 3600, want: "2h"},
+		{seconds: 3*3600 + 20*60, want: "3h 20m"},
+		{seconds: 48 * 3600, want: "2d"},
+		{seconds: 26*3600 + 59*60, want: "1d 2h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, formatDuration(tt.seconds))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package chat
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// Messages use only markup that Slack and Mattermost render alike: emoji shortcodes, @mentions and inline code.
+
+// assignedMessage tells the reviewer's team that a review was requested
+func assignedMessage(pr entity.PullRequest, reviewer string) entity.ChatMessage {
+	return entity.ChatMessage{
+		Text: fmt.Sprintf(":eyes: @%s, please review %q (`%s`)", reviewer, pr.PullRequestName, pr.PullRequestID),
+	}
+}
+
+// reassignedMessage tells the new reviewer's team that a review was handed over
+func reassignedMessage(pr entity.PullRequest, oldReviewer string, newReviewer string, reason entity.ReassignmentReason) entity.ChatMessage {
+	text := fmt.Sprintf(":arrows_counterclockwise: @%s, please review %q (`%s`) instead of @%s",
+		newReviewer, pr.PullRequestName, pr.PullRequestID, oldReviewer)
+	if reason == entity.ReassignmentAutomatic {
+		text += " — the review was stale"
+	}
+
+	return entity.ChatMessage{Text: text}
+}
+
+// overdueMessage tells the reviewer's team that a review went past its SLA
+func overdueMessage(review entity.OverdueReview, reviewer string) entity.ChatMessage {
+	return entity.ChatMessage{
+		Text: fmt.Sprintf(":hourglass: @%s, your review of %q (`%s`) is overdue by %s (SLA %s)",
+			reviewer, review.PullRequestName, review.PullRequestID,
+			formatDuration(review.OverdueSeconds), formatDuration(review.SLASeconds)),
+	}
+}
+
+// formatDuration renders whole days, hours and minutes, e.g. "1d 2h" or "3h 20m"
+func formatDuration(seconds int64) string {
+	d := time.Duration(seconds) * time.Second
+	if d < time.Minute {
+		return "less than a minute"
+	}
+
+	days := int64(d / (24 * time.Hour))
+	hours := int64(d % (24 * time.Hour) / time.Hour)
+	minutes := int64(d % time.Hour / time.Minute)
+
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0 && minutes > 0:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dm", minutes)
+	}
+}
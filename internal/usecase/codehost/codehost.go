@@ -0,0 +1,119 @@
+package codehost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+// UseCase mirrors reviewer assignments onto the code host a pull request was ingested from.
+type UseCase struct {
+	identityRepo repo.IdentityRepo
+	clients      map[entity.IdentityProvider]repo.CodeHostClient
+}
+
+// New creates a new CodeHost use case instance; providers without a client are not written back to.
+func New(identityRepo repo.IdentityRepo, clients map[entity.IdentityProvider]repo.CodeHostClient) *UseCase {
+	return &UseCase{
+		identityRepo: identityRepo,
+		clients:      clients,
+	}
+}
+
+// Publish requests reviews on the code host for reviewer.assigned and reviewer.reassigned events,
+// withdrawing the request of a replaced reviewer. Events of PRs that were not ingested from a configured
+// code host and reviewers without a mapped login are skipped.
+func (uc *UseCase) Publish(ctx context.Context, event entity.Event) error {
+	var (
+		prID   string
+		add    []string
+		remove []string
+	)
+
+	switch event.Type {
+	case entity.EventReviewerAssigned:
+		var data entity.ReviewerAssignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return fmt.Errorf("CodeHostUseCase - Publish - %w", err)
+		}
+
+		prID, add = data.PullRequestID, []string{data.ReviewerID}
+	case entity.EventReviewerReassigned:
+		var data entity.ReviewerReassignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return fmt.Errorf("CodeHostUseCase - Publish - %w", err)
+		}
+
+		prID, add, remove = data.PullRequestID, []string{data.NewReviewerID}, []string{data.OldReviewerID}
+	default:
+		return nil
+	}
+
+	ref, ok := entity.ParseCodeHostRef(prID)
+	if !ok {
+		return nil
+	}
+
+	client, ok := uc.clients[ref.Provider]
+	if !ok {
+		return nil
+	}
+
+	addLogins, err := uc.logins(ctx, ref.Provider, add)
+	if err != nil {
+		return fmt.Errorf("CodeHostUseCase - Publish - %w", err)
+	}
+
+	removeLogins, err := uc.logins(ctx, ref.Provider, remove)
+	if err != nil {
+		return fmt.Errorf("CodeHostUseCase - Publish - %w", err)
+	}
+
+	if len(addLogins) == 0 && len(removeLogins) == 0 {
+		return nil
+	}
+
+	err = client.RequestReviewers(ctx, ref, addLogins, removeLogins)
+	if err != nil {
+		return fmt.Errorf("CodeHostUseCase - Publish - RequestReviewers %s: %w", prID, err)
+	}
+
+	return nil
+}
+
+// logins maps user IDs to their logins on the provider, leaving out unmapped users
+func (uc *UseCase) logins(ctx context.Context, provider entity.IdentityProvider, userIDs []string) ([]string, error) {
+	logins := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		login, err := uc.identityRepo.GetLoginByUserID(ctx, provider, userID)
+		if errors.Is(err, entity.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("GetLoginByUserID: %w", err)
+		}
+
+		logins = append(logins, login)
+	}
+
+	return logins, nil
+}
+
+// decodeEventData converts event data into its payload type; relayed events carry it as raw JSON
+func decodeEventData(event entity.Event, v any) error {
+	raw, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("Marshal %s data: %w", event.Type, err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("Unmarshal %s data: %w", event.Type, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package codehost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockIdentityRepo struct {
+	mock.Mock
+}
+
+func (m *mockIdentityRepo) SetIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *mockIdentityRepo) GetUserIDByLogin(ctx context.Context, provider entity.IdentityProvider, login string) (string, error) {
+	args := m.Called(ctx, provider, login)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityRepo) GetLoginByUserID(ctx context.Context, provider entity.IdentityProvider, userID string) (string, error) {
+	args := m.Called(ctx, provider, userID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityRepo) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	args := m.Called(ctx, provider)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockIdentityRepo) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	args := m.Called(ctx, provider, login)
+	return args.Error(0)
+}
+
+type mockCodeHostClient struct {
+	mock.Mock
+}
+
+func (m *mockCodeHostClient) RequestReviewers(ctx context.Context, ref entity.CodeHostRef, add []string, remove []string) error {
+	args := m.Called(ctx, ref, add, remove)
+	return args.Error(0)
+}
+
+var (
+	_ repo.IdentityRepo   = (*mockIdentityRepo)(nil)
+	_ repo.CodeHostClient = (*mockCodeHostClient)(nil)
+)
+
+func newUseCase() (*UseCase, *mockIdentityRepo, *mockCodeHostClient, *mockCodeHostClient) {
+	identityRepo := new(mockIdentityRepo)
+	github, gitlab := new(mockCodeHostClient), new(mockCodeHostClient)
+
+	return New(identityRepo, map[entity.IdentityProvider]repo.CodeHostClient{
+		entity.IdentityProviderGitHub: github,
+		entity.IdentityProviderGitLab: gitlab,
+	}), identityRepo, github, gitlab
+}
+
+// relayed returns the event as the outbox relay hands it over, with raw JSON data
+func relayed(eventType entity.EventType, data any) entity.Event {
+	raw, _ := json.Marshal(data)
+
+	return entity.Event{EventID: "evt-1", Type: eventType, Data: json.RawMessage(raw)}
+}
+
+func TestPublish_ReviewerAssigned(t *testing.T) {
+	uc, identityRepo, github, _ := newUseCase()
+	ctx := context.Background()
+
+	identityRepo.On("GetLoginByUserID", ctx, entity.IdentityProviderGitHub, "u2").Return("bob", nil)
+	github.On("RequestReviewers", ctx, entity.CodeHostRef{Provider: entity.IdentityProviderGitHub, Repository: "acme/api", Number: 42}, []string{"bob"}, []string{}).Return(nil)
+
+	err := uc.Publish(ctx, relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "acme/api#42", ReviewerID: "u2"}))
+
+	assert.NoError(t, err)
+	github.AssertExpectations(t)
+}
+
+func TestPublish_ReviewerReassigned(t *testing.T) {
+	uc, identityRepo, _, gitlab := newUseCase()
+	ctx := context.Background()
+
+	identityRepo.On("GetLoginByUserID", ctx, entity.IdentityProviderGitLab, "u2").Return("bob", nil)
+	identityRepo.On("GetLoginByUserID", ctx, entity.IdentityProviderGitLab, "u3").Return("carol", nil)
+	gitlab.On("RequestReviewers", ctx, entity.CodeHostRef{Provider: entity.IdentityProviderGitLab, Repository: "acme/billing", Number: 7}, []string{"carol"}, []string{"bob"}).Return(nil)
+
+	err := uc.Publish(ctx, entity.Event{Type: entity.EventReviewerReassigned, Data: entity.ReviewerReassignedEventData{
+		PullRequestID: "acme/billing!7",
+		OldReviewerID: "u2",
+		NewReviewerID: "u3",
+		Reason:        entity.ReassignmentAutomatic,
+	}})
+
+	assert.NoError(t, err)
+	gitlab.AssertExpectations(t)
+}
+
+func TestPublish_Skipped(t *testing.T) {
+	tests := []struct {
+		name  string
+		event entity.Event
+		setup func(identityRepo *mockIdentityRepo)
+	}{
+		{name: "other event", event: relayed(entity.EventPRCreated, map[string]any{"pull_request": map[string]any{"pull_request_id": "acme/api#42"}})},
+		{name: "PR not from a code host", event: relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "pr-1001", ReviewerID: "u2"})},
+		{
+			name:  "unmapped reviewer",
+			event: relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "acme/api#42", ReviewerID: "u9"}),
+			setup: func(identityRepo *mockIdentityRepo) {
+				identityRepo.On("GetLoginByUserID", mock.Anything, entity.IdentityProviderGitHub, "u9").Return("", entity.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, identityRepo, github, gitlab := newUseCase()
+			if tt.setup != nil {
+				tt.setup(identityRepo)
+			}
+
+			err := uc.Publish(context.Background(), tt.event)
+
+			assert.NoError(t, err)
+			github.AssertNotCalled(t, "RequestReviewers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+			gitlab.AssertNotCalled(t, "RequestReviewers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestPublish_ProviderNotConfigured(t *testing.T) {
+	identityRepo := new(mockIdentityRepo)
+	uc := New(identityRepo, nil)
+
+	err := uc.Publish(context.Background(), relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "acme/api#42", ReviewerID: "u2"}))
+
+	assert.NoError(t, err)
+	identityRepo.AssertNotCalled(t, "GetLoginByUserID", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPublish_ClientError(t *testing.T) {
+	uc, identityRepo, github, _ := newUseCase()
+	ctx := context.Background()
+	apiErr := errors.New("bad gateway")
+
+	identityRepo.On("GetLoginByUserID", ctx, entity.IdentityProviderGitHub, "u2").Return("bob", nil)
+	github.On("RequestReviewers", ctx, mock.Anything, []string{"bob"}, []string{}).Return(apiErr)
+
+	err := uc.Publish(ctx, relayed(entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "acme/api#42", ReviewerID: "u2"}))
+
+	assert.ErrorIs(t, err, apiErr)
+}
@@ -3,6 +3,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
 )
@@ -14,19 +15,130 @@ type (
 	Team interface {
 		CreateTeam(ctx context.Context, team entity.Team) error
 		GetTeam(ctx context.Context, teamName string) (entity.Team, error)
+		ListTeams(ctx context.Context) ([]entity.TeamSummary, error)
+		SetChatWebhook(ctx context.Context, teamName string, url string) error
 	}
 
 	// User defines user use case interface.
 	User interface {
 		SetIsActive(ctx context.Context, userID string, isActive bool) (entity.User, error)
-		GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error)
+		GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error)
+		GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error)
+		ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error)
+		SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error)
+		SetRole(ctx context.Context, userID string, role entity.Role) error
 	}
 
 	// PullRequest defines pull request use case interface.
 	PullRequest interface {
 		CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error)
+		GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error)
+		GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error)
+		ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error)
 		MergePR(ctx context.Context, prID string) (entity.PullRequest, error)
+		ClosePR(ctx context.Context, prID string) (entity.PullRequest, error)
+		ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error)
 		ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error)
+		AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error)
+		RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error
+	}
+
+	// Lookup defines batched lookup use case interface.
+	Lookup interface {
+		GetUsers(ctx context.Context, userIDs []string) (map[string]entity.User, error)
+		GetTeamMembers(ctx context.Context, teamNames []string) (map[string][]entity.User, error)
+		GetAssignedPRs(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error)
+	}
+
+	// Stats defines review statistics use case interface.
+	Stats interface {
+		GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) (entity.ReviewerStatsReport, error)
+		GetTeamStats(ctx context.Context, window entity.TimeWindow) (entity.TeamStatsReport, error)
+	}
+
+	// SLA defines review SLA use case interface.
+	SLA interface {
+		SetTeamSLA(ctx context.Context, teamName string, sla time.Duration) error
+		GetOverdueReviews(ctx context.Context, teamName string) ([]entity.OverdueReview, error)
+		CountOverdueByTeam(ctx context.Context) ([]entity.OverdueCount, error)
+		SetTeamEscalation(ctx context.Context, teamName string, threshold time.Duration) error
+	}
+
+	// Escalation defines stale review escalation use case interface.
+	Escalation interface {
+		EscalateStaleReviews(ctx context.Context) (entity.EscalationResult, error)
+	}
+
+	// Maintenance defines operator maintenance use case interface.
+	Maintenance interface {
+		Seed(ctx context.Context, snapshot entity.Snapshot) (entity.SeedResult, error)
+		ReassignUser(ctx context.Context, userID string, deactivate bool) ([]entity.UserReassignment, error)
+		Export(ctx context.Context) (entity.Snapshot, error)
+	}
+
+	// APIKey defines API key use case interface.
+	APIKey interface {
+		CreateAPIKey(ctx context.Context, name string, scopes []entity.APIKeyScope, expiresAt *time.Time) (entity.APIKey, error)
+		ListAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+		RevokeAPIKey(ctx context.Context, keyID string) error
+		Authenticate(ctx context.Context, token string) (entity.APIKey, error)
+	}
+
+	// Auth defines request authentication use case interface.
+	Auth interface {
+		Authenticate(ctx context.Context, token string) (entity.Principal, error)
+	}
+
+	// Audit defines audit log use case interface.
+	Audit interface {
+		ListEntries(ctx context.Context, filter entity.AuditFilter, page entity.PageRequest) (entity.AuditPage, error)
+	}
+
+	// EventPublisher defines domain event publishing interface.
+	EventPublisher interface {
+		Publish(ctx context.Context, event entity.Event) error
+	}
+
+	// Outbox defines outbox relay use case interface.
+	Outbox interface {
+		RelayEvents(ctx context.Context) (entity.RelayResult, error)
+	}
+
+	// Webhook defines outbound webhook use case interface.
+	Webhook interface {
+		EventPublisher
+		CreateWebhook(ctx context.Context, url string, eventTypes []entity.EventType) (entity.Webhook, error)
+		ListWebhooks(ctx context.Context) ([]entity.Webhook, error)
+		DeleteWebhook(ctx context.Context, webhookID string) error
+		ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error)
+		DeliverPending(ctx context.Context) (entity.DeliveryResult, error)
+	}
+
+	// Chat defines chat notification use case interface.
+	Chat interface {
+		EventPublisher
+		NotifyOverdueReviews(ctx context.Context) (entity.ChatNotificationResult, error)
+	}
+
+	// Digest defines email digest use case interface.
+	Digest interface {
+		SendDigests(ctx context.Context) (entity.DigestResult, error)
+	}
+
+	// Stream defines live review activity stream use case interface.
+	Stream interface {
+		EventPublisher
+		Listen(ctx context.Context) error
+		Subscribe(filter entity.StreamFilter) (<-chan entity.StreamEvent, func())
+	}
+
+	// Integration defines code host integration use case interface.
+	Integration interface {
+		HandleGitHubWebhook(ctx context.Context, event string, signature string, body []byte) (entity.IntegrationResult, error)
+		HandleGitLabWebhook(ctx context.Context, event string, token string, body []byte) (entity.IntegrationResult, error)
+		SetIdentity(ctx context.Context, identity entity.UserIdentity) (entity.UserIdentity, error)
+		ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error)
+		DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error
 	}
 )
 
@@ -0,0 +1,183 @@
+package digest
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+const (
+	// _runLockKey identifies the advisory lock that lets only one instance send digests at a time.
+	_runLockKey int64 = 0x70725f646967 // "pr_dig"
+
+	_defaultBatchSize = 50
+)
+
+//go:embed templates
+var templates embed.FS
+
+var (
+	_textTemplate = texttemplate.Must(texttemplate.ParseFS(templates, "templates/digest.txt.tmpl"))
+	_htmlTemplate = htmltemplate.Must(htmltemplate.ParseFS(templates, "templates/digest.html.tmpl"))
+)
+
+// digestData is what the digest templates are rendered with
+type digestData struct {
+	Username     string
+	Date         string
+	PullRequests []entity.PullRequestShort
+}
+
+// UseCase emails active users a daily digest of their open review assignments.
+type UseCase struct {
+	userRepo  repo.UserRepo
+	prRepo    repo.PullRequestRepo
+	lockRepo  repo.LockRepo
+	mailer    repo.MailSender
+	sendHour  int
+	batchSize int
+	now       func() time.Time
+}
+
+// New creates a new Digest use case instance; digests go out from sendHour (UTC) on.
+func New(userRepo repo.UserRepo, prRepo repo.PullRequestRepo, lockRepo repo.LockRepo, mailer repo.MailSender, sendHour int, batchSize int) *UseCase {
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+
+	return &UseCase{
+		userRepo:  userRepo,
+		prRepo:    prRepo,
+		lockRepo:  lockRepo,
+		mailer:    mailer,
+		sendHour:  sendHour,
+		batchSize: batchSize,
+		now:       time.Now,
+	}
+}
+
+// SendDigests emails one batch of users whose digest for the current UTC day is due.
+// Before the send hour, or when another instance holds the lock, the result is empty.
+func (uc *UseCase) SendDigests(ctx context.Context) (entity.DigestResult, error) {
+	var result entity.DigestResult
+
+	now := uc.now().UTC()
+	if now.Hour() < uc.sendHour {
+		return result, nil
+	}
+
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	_, err := uc.lockRepo.WithTryLock(ctx, _runLockKey, func(ctx context.Context) error {
+		var err error
+		result, err = uc.send(ctx, day)
+
+		return err
+	})
+	if err != nil {
+		return result, fmt.Errorf("DigestUseCase - SendDigests - WithTryLock: %w", err)
+	}
+
+	return result, nil
+}
+
+// send handles one batch of due recipients, continuing past individual failures; failed ones are retried next run
+func (uc *UseCase) send(ctx context.Context, day time.Time) (entity.DigestResult, error) {
+	var result entity.DigestResult
+
+	recipients, err := uc.userRepo.GetDigestRecipients(ctx, day, uc.batchSize)
+	if err != nil {
+		return result, fmt.Errorf("GetDigestRecipients: %w", err)
+	}
+
+	result.Due = len(recipients)
+
+	var errs []error
+	for _, recipient := range recipients {
+		sent, err := uc.deliver(ctx, recipient, day)
+		if err != nil {
+			result.Failed++
+			errs = append(errs, fmt.Errorf("%s: %w", recipient.UserID, err))
+
+			continue
+		}
+
+		if sent {
+			result.Sent++
+		} else {
+			result.Skipped++
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// deliver emails the recipient's open review assignments, skipping users without any, and marks the day as handled
+func (uc *UseCase) deliver(ctx context.Context, recipient entity.DigestRecipient, day time.Time) (bool, error) {
+	prs, err := uc.prRepo.GetPRsByReviewer(ctx, recipient.UserID)
+	if err != nil {
+		return false, fmt.Errorf("GetPRsByReviewer: %w", err)
+	}
+
+	open := make([]entity.PullRequestShort, 0, len(prs))
+	for _, pr := range prs {
+		if pr.Status == entity.PullRequestStatusOpen {
+			open = append(open, pr)
+		}
+	}
+
+	if len(open) > 0 {
+		email, err := render(recipient, day, open)
+		if err != nil {
+			return false, err
+		}
+
+		if err = uc.mailer.Send(ctx, email); err != nil {
+			return false, fmt.Errorf("Send: %w", err)
+		}
+	}
+
+	if err = uc.userRepo.MarkDigestSent(ctx, recipient.UserID, day); err != nil {
+		return false, fmt.Errorf("MarkDigestSent: %w", err)
+	}
+
+	return len(open) > 0, nil
+}
+
+// render builds the digest email from the plain-text and HTML templates
+func render(recipient entity.DigestRecipient, day time.Time, prs []entity.PullRequestShort) (entity.Email, error) {
+	data := digestData{
+		Username:     recipient.Username,
+		Date:         day.Format("2006-01-02"),
+		PullRequests: prs,
+	}
+
+	var text, html bytes.Buffer
+	if err := _textTemplate.Execute(&text, data); err != nil {
+		return entity.Email{}, fmt.Errorf("render text: %w", err)
+	}
+
+	if err := _htmlTemplate.Execute(&html, data); err != nil {
+		return entity.Email{}, fmt.Errorf("render html: %w", err)
+	}
+
+	subject := fmt.Sprintf("%d pull requests are waiting for your review", len(prs))
+	if len(prs) == 1 {
+		subject = "1 pull request is waiting for your review"
+	}
+
+	return entity.Email{
+		To:       recipient.Email,
+		Subject:  subject,
+		TextBody: text.String(),
+		HTMLBody: html.String(),
+	}, nil
+}
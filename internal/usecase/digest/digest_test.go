@@ -0,0 +1,343 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+type mockLockRepo struct {
+	mock.Mock
+}
+
+func (m *mockLockRepo) WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx, key)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+type mockMailSender struct {
+	mock.Mock
+}
+
+func (m *mockMailSender) Send(ctx context.Context, email entity.Email) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+var (
+	_ repo.UserRepo        = (*mockUserRepo)(nil)
+	_ repo.PullRequestRepo = (*mockPRRepo)(nil)
+	_ repo.LockRepo        = (*mockLockRepo)(nil)
+	_ repo.MailSender      = (*mockMailSender)(nil)
+)
+
+var _day = time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+func newUseCase(now time.Time) (*UseCase, *mockUserRepo, *mockPRRepo, *mockLockRepo, *mockMailSender) {
+	userRepo, prRepo, lockRepo, mailer := new(mockUserRepo), new(mockPRRepo), new(mockLockRepo), new(mockMailSender)
+
+	uc := New(userRepo, prRepo, lockRepo, mailer, 8, 10)
+	uc.now = func() time.Time { return now }
+
+	return uc, userRepo, prRepo, lockRepo, mailer
+}
+
+func TestSendDigests(t *testing.T) {
+	uc, userRepo, prRepo, lockRepo, mailer := newUseCase(_day.Add(9*time.Hour + 30*time.Minute))
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _runLockKey).Return(true, nil)
+	userRepo.On("GetDigestRecipients", ctx, _day, 10).Return([]entity.DigestRecipient{
+		{UserID: "u1", Username: "alice", Email: "alice@example.com"},
+		{UserID: "u2", Username: "bob", Email: "bob@example.com"},
+		{UserID: "u3", Username: "carol", Email: "carol@example.com"},
+	}, nil)
+
+	prRepo.On("GetPRsByReviewer", ctx, "u1").Return([]entity.PullRequestShort{
+		{PullRequestID: "pr-1", PullRequestName: "Fix <b>login</b>", AuthorID: "u2", Status: entity.PullRequestStatusOpen},
+		{PullRequestID: "pr-2", PullRequestName: "Old change", AuthorID: "u2", Status: entity.PullRequestStatusMerged},
+		{PullRequestID: "pr-3", PullRequestName: "Add search", AuthorID: "u3", Status: entity.PullRequestStatusOpen},
+	}, nil)
+	prRepo.On("GetPRsByReviewer", ctx, "u2").Return([]entity.PullRequestShort{
+		{PullRequestID: "pr-4", PullRequestName: "Closed change", AuthorID: "u1", Status: entity.PullRequestStatusClosed},
+	}, nil)
+	prRepo.On("GetPRsByReviewer", ctx, "u3").Return([]entity.PullRequestShort{
+		{PullRequestID: "pr-5", PullRequestName: "Bump deps", AuthorID: "u1", Status: entity.PullRequestStatusOpen},
+	}, nil)
+
+	var sent entity.Email
+	mailer.On("Send", ctx, mock.MatchedBy(func(email entity.Email) bool { return email.To == "alice@example.com" })).
+		Run(func(args mock.Arguments) { sent = args.Get(1).(entity.Email) }).
+		Return(nil)
+	mailer.On("Send", ctx, mock.MatchedBy(func(email entity.Email) bool { return email.To == "carol@example.com" })).
+		Return(errors.New("mailbox unavailable"))
+
+	userRepo.On("MarkDigestSent", ctx, "u1", _day).Return(nil)
+	userRepo.On("MarkDigestSent", ctx, "u2", _day).Return(nil)
+
+	result, err := uc.SendDigests(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, entity.DigestResult{Due: 3, Sent: 1, Skipped: 1, Failed: 1}, result)
+	userRepo.AssertExpectations(t)
+	userRepo.AssertNotCalled(t, "MarkDigestSent", ctx, "u3", _day)
+
+	assert.Equal(t, "2 pull requests are waiting for your review", sent.Subject)
+	assert.Contains(t, sent.TextBody, "Hi alice,")
+	assert.Contains(t, sent.TextBody, "- Fix <b>login</b> (pr-1) by u2")
+	assert.Contains(t, sent.TextBody, "- Add search (pr-3) by u3")
+	assert.NotContains(t, sent.TextBody, "Old change")
+	assert.Contains(t, sent.HTMLBody, "<td>Fix &lt;b&gt;login&lt;/b&gt;</td>")
+	assert.Contains(t, sent.HTMLBody, "<code>pr-3</code>")
+	assert.Contains(t, sent.HTMLBody, "as of 2026-10-18")
+}
+
+func TestSendDigests_BeforeSendHour(t *testing.T) {
+	uc, userRepo, _, lockRepo, _ := newUseCase(_day.Add(7 * time.Hour))
+
+	result, err := uc.SendDigests(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DigestResult{}, result)
+	lockRepo.AssertNotCalled(t, "WithTryLock")
+	userRepo.AssertNotCalled(t, "GetDigestRecipients")
+}
+
+func TestSendDigests_LockHeldElsewhere(t *testing.T) {
+	uc, userRepo, _, lockRepo, _ := newUseCase(_day.Add(9 * time.Hour))
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _runLockKey).Return(false, nil)
+
+	result, err := uc.SendDigests(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DigestResult{}, result)
+	userRepo.AssertNotCalled(t, "GetDigestRecipients")
+}
+
+func TestRender_SinglePullRequest(t *testing.T) {
+	email, err := render(
+		entity.DigestRecipient{UserID: "u1", Username: "alice", Email: "alice@example.com"},
+		_day,
+		[]entity.PullRequestShort{{PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "u2"}},
+	)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "alice@example.com", email.To)
+	assert.Equal(t, "1 pull request is waiting for your review", email.Subject)
+	assert.Contains(t, email.TextBody, "1 pull request is waiting for your review as of 2026-10-18:")
+}
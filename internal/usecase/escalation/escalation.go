@@ -0,0 +1,89 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+const (
+	// _sweepLockKey identifies the advisory lock that lets only one instance sweep at a time.
+	_sweepLockKey int64 = 0x70725f657363 // "pr_esc"
+
+	_defaultBatchSize = 100
+)
+
+// UseCase handles automatic reassignment of stale reviews.
+type UseCase struct {
+	prRepo           repo.PullRequestRepo
+	lockRepo         repo.LockRepo
+	pullRequest      usecase.PullRequest
+	defaultThreshold time.Duration
+	batchSize        int
+}
+
+// New creates a new Escalation use case instance; defaultThreshold applies to teams without their own threshold.
+func New(prRepo repo.PullRequestRepo, lockRepo repo.LockRepo, pullRequest usecase.PullRequest, defaultThreshold time.Duration, batchSize int) *UseCase {
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+
+	return &UseCase{
+		prRepo:           prRepo,
+		lockRepo:         lockRepo,
+		pullRequest:      pullRequest,
+		defaultThreshold: defaultThreshold,
+		batchSize:        batchSize,
+	}
+}
+
+// EscalateStaleReviews reassigns open reviews older than their team's escalation threshold.
+// Only one instance sweeps at a time; when another instance holds the lock the result is empty.
+func (uc *UseCase) EscalateStaleReviews(ctx context.Context) (entity.EscalationResult, error) {
+	var result entity.EscalationResult
+
+	_, err := uc.lockRepo.WithTryLock(ctx, _sweepLockKey, func(ctx context.Context) error {
+		var err error
+		result, err = uc.sweep(ctx)
+
+		return err
+	})
+	if err != nil {
+		return result, fmt.Errorf("EscalationUseCase - EscalateStaleReviews - WithTryLock: %w", err)
+	}
+
+	return result, nil
+}
+
+// sweep reassigns one batch of stale reviews, continuing past individual failures
+func (uc *UseCase) sweep(ctx context.Context) (entity.EscalationResult, error) {
+	var result entity.EscalationResult
+
+	stale, err := uc.prRepo.GetStaleReviews(ctx, uc.defaultThreshold, uc.batchSize)
+	if err != nil {
+		return result, fmt.Errorf("GetStaleReviews: %w", err)
+	}
+
+	result.Stale = len(stale)
+
+	var errs []error
+	for _, review := range stale {
+		_, _, err := uc.pullRequest.AutoReassignReviewer(ctx, review.PullRequestID, review.ReviewerID)
+		switch {
+		case err == nil:
+			result.Reassigned++
+		case errors.Is(err, entity.ErrNoCandidate), errors.Is(err, entity.ErrNotAssigned), errors.Is(err, entity.ErrPRMerged):
+			// Nobody to hand over to, or the review changed since it was selected
+			result.Skipped++
+		default:
+			errs = append(errs, fmt.Errorf("AutoReassignReviewer %s/%s: %w", review.PullRequestID, review.ReviewerID, err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
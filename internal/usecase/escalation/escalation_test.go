@@ -0,0 +1,324 @@
+package escalation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+type mockLockRepo struct {
+	mock.Mock
+}
+
+func (m *mockLockRepo) WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx, key)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+type mockPullRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID, prName, authorID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+var (
+	_ repo.PullRequestRepo = (*mockPRRepo)(nil)
+	_ repo.LockRepo        = (*mockLockRepo)(nil)
+	_ usecase.PullRequest  = (*mockPullRequestUseCase)(nil)
+)
+
+func staleReview(prID, reviewerID string) entity.OverdueReview {
+	return entity.OverdueReview{
+		PullRequestShort: entity.PullRequestShort{PullRequestID: prID, Status: entity.PullRequestStatusOpen},
+		ReviewerID:       reviewerID,
+	}
+}
+
+func TestEscalateStaleReviews_ReassignsStale(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	lockRepo := new(mockLockRepo)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(prRepo, lockRepo, prUC, 48*time.Hour, 10)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(true, nil)
+	prRepo.On("GetStaleReviews", ctx, 48*time.Hour, 10).Return([]entity.OverdueReview{
+		staleReview("pr-1", "u2"),
+		staleReview("pr-2", "u3"),
+	}, nil)
+	prUC.On("AutoReassignReviewer", ctx, "pr-1", "u2").Return(entity.PullRequest{}, "u4", nil)
+	prUC.On("AutoReassignReviewer", ctx, "pr-2", "u3").Return(nil, "", entity.ErrNoCandidate)
+
+	result, err := uc.EscalateStaleReviews(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EscalationResult{Stale: 2, Reassigned: 1, Skipped: 1}, result)
+	prRepo.AssertExpectations(t)
+	prUC.AssertExpectations(t)
+}
+
+func TestEscalateStaleReviews_LockHeldElsewhere(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	lockRepo := new(mockLockRepo)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(prRepo, lockRepo, prUC, 48*time.Hour, 10)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(false, nil)
+
+	result, err := uc.EscalateStaleReviews(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.EscalationResult{}, result)
+	prRepo.AssertNotCalled(t, "GetStaleReviews", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestEscalateStaleReviews_ContinuesAfterFailure(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	lockRepo := new(mockLockRepo)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(prRepo, lockRepo, prUC, time.Hour, 0)
+
+	ctx := context.Background()
+	dbErr := errors.New("db down")
+
+	lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(true, nil)
+	prRepo.On("GetStaleReviews", ctx, time.Hour, _defaultBatchSize).Return([]entity.OverdueReview{
+		staleReview("pr-1", "u2"),
+		staleReview("pr-2", "u3"),
+	}, nil)
+	prUC.On("AutoReassignReviewer", ctx, "pr-1", "u2").Return(nil, "", dbErr)
+	prUC.On("AutoReassignReviewer", ctx, "pr-2", "u3").Return(entity.PullRequest{}, "u5", nil)
+
+	result, err := uc.EscalateStaleReviews(ctx)
+
+	assert.ErrorIs(t, err, dbErr)
+	assert.Equal(t, 1, result.Reassigned)
+	prUC.AssertExpectations(t)
+}
+
+func TestEscalateStaleReviews_StaleQueryError(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	lockRepo := new(mockLockRepo)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(prRepo, lockRepo, prUC, time.Hour, 5)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _sweepLockKey).Return(true, nil)
+	prRepo.On("GetStaleReviews", ctx, time.Hour, 5).Return(nil, errors.New("db down"))
+
+	_, err := uc.EscalateStaleReviews(ctx)
+
+	assert.Error(t, err)
+	prUC.AssertNotCalled(t, "AutoReassignReviewer", mock.Anything, mock.Anything, mock.Anything)
+}
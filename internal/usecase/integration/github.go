@@ -0,0 +1,191 @@
+package integration
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// GitHub event names from the X-GitHub-Event header
+const (
+	githubEventPing              = "ping"
+	githubEventPullRequest       = "pull_request"
+	githubEventPullRequestReview = "pull_request_review"
+)
+
+type githubUser struct {
+	Login string `json:"login"`
+}
+
+type githubRepository struct {
+	FullName string `json:"full_name"`
+}
+
+type githubPullRequest struct {
+	Number int        `json:"number"`
+	Title  string     `json:"title"`
+	Draft  bool       `json:"draft"`
+	Merged bool       `json:"merged"`
+	User   githubUser `json:"user"`
+}
+
+type githubReview struct {
+	State string     `json:"state"`
+	User  githubUser `json:"user"`
+}
+
+type githubPayload struct {
+	Action      string             `json:"action"`
+	PullRequest *githubPullRequest `json:"pull_request"`
+	Review      *githubReview      `json:"review"`
+	Repository  githubRepository   `json:"repository"`
+}
+
+// HandleGitHubWebhook verifies a GitHub delivery and applies pull_request and pull_request_review events.
+// Events that cannot be mapped onto our pull requests are acknowledged as ignored so GitHub does not retry them.
+func (uc *UseCase) HandleGitHubWebhook(ctx context.Context, event string, signature string, body []byte) (entity.IntegrationResult, error) {
+	if !validGitHubSignature(uc.githubSecret, signature, body) {
+		return entity.IntegrationResult{}, entity.ErrInvalidSignature
+	}
+
+	result := entity.IntegrationResult{Event: event}
+
+	switch event {
+	case githubEventPullRequest, githubEventPullRequestReview:
+	case githubEventPing:
+		return ignored(result, "ping"), nil
+	default:
+		return ignored(result, "unsupported event"), nil
+	}
+
+	var payload githubPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.PullRequest == nil {
+		return entity.IntegrationResult{}, entity.ErrInvalidPayload
+	}
+
+	result.Action = payload.Action
+	result.PullRequestID = entity.CodeHostRef{
+		Provider:   entity.IdentityProviderGitHub,
+		Repository: payload.Repository.FullName,
+		Number:     payload.PullRequest.Number,
+	}.ID()
+
+	var err error
+	if event == githubEventPullRequest {
+		result, err = uc.applyGitHubPullRequest(ctx, result, payload)
+	} else {
+		result, err = uc.applyGitHubReview(ctx, result, payload)
+	}
+
+	if err != nil {
+		return entity.IntegrationResult{}, fmt.Errorf("IntegrationUseCase - HandleGitHubWebhook - %s: %w", event, err)
+	}
+
+	return result, nil
+}
+
+// applyGitHubPullRequest creates PRs once they are ready for review and merges, closes or reopens them along with GitHub
+func (uc *UseCase) applyGitHubPullRequest(ctx context.Context, result entity.IntegrationResult, payload githubPayload) (entity.IntegrationResult, error) {
+	pr := payload.PullRequest
+
+	switch payload.Action {
+	case "opened", "ready_for_review":
+		if pr.Draft {
+			return ignored(result, "draft pull request"), nil
+		}
+
+		return uc.openPullRequest(ctx, result, entity.IdentityProviderGitHub, pr.Title, pr.User.Login)
+	case "reopened":
+		if pr.Draft {
+			return ignored(result, "draft pull request"), nil
+		}
+
+		return uc.reopenPullRequest(ctx, result, entity.IdentityProviderGitHub, pr.Title, pr.User.Login)
+	case "closed":
+		if pr.Merged {
+			return uc.mergePullRequest(ctx, result)
+		}
+
+		return uc.closePullRequest(ctx, result)
+	default:
+		return ignored(result, "unsupported action"), nil
+	}
+}
+
+// applyGitHubReview records reviews submitted by assigned reviewers
+func (uc *UseCase) applyGitHubReview(ctx context.Context, result entity.IntegrationResult, payload githubPayload) (entity.IntegrationResult, error) {
+	if payload.Action != "submitted" || payload.Review == nil {
+		return ignored(result, "unsupported action"), nil
+	}
+
+	outcome, ok := githubReviewOutcome(payload.Review.State)
+	if !ok {
+		return ignored(result, "unsupported review state"), nil
+	}
+
+	reviewerID, ok, err := uc.resolveUser(ctx, entity.IdentityProviderGitHub, payload.Review.User.Login)
+	if err != nil {
+		return result, err
+	}
+
+	if !ok {
+		return ignored(result, "reviewer login is not mapped to a user"), nil
+	}
+
+	err = uc.pullRequest.RecordReview(ctx, result.PullRequestID, reviewerID, outcome)
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return ignored(result, "unknown pull request"), nil
+	case errors.Is(err, entity.ErrNotAssigned):
+		return ignored(result, "reviewer is not assigned"), nil
+	case errors.Is(err, entity.ErrPRMerged):
+		return ignored(result, "pull request is merged"), nil
+	case err != nil:
+		return result, fmt.Errorf("RecordReview: %w", err)
+	}
+
+	result.Status = entity.IntegrationStatusProcessed
+
+	return result, nil
+}
+
+func githubReviewOutcome(state string) (entity.ReviewOutcome, bool) {
+	switch state {
+	case "approved":
+		return entity.ReviewOutcomeApproved, true
+	case "changes_requested":
+		return entity.ReviewOutcomeChangesRequested, true
+	case "commented":
+		return entity.ReviewOutcomeCommented, true
+	default:
+		return "", false
+	}
+}
+
+// validGitHubSignature checks the X-Hub-Signature-256 header: "sha256=" followed by the hex HMAC-SHA256 of the body
+func validGitHubSignature(secret string, signature string, body []byte) bool {
+	if secret == "" {
+		return false
+	}
+
+	const prefix = "sha256="
+	if len(signature) <= len(prefix) || signature[:len(prefix)] != prefix {
+		return false
+	}
+
+	expected, err := hex.DecodeString(signature[len(prefix):])
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,113 @@
+package integration
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+)
+
+// gitlabEventMergeRequest is the X-Gitlab-Event header of merge request events
+const gitlabEventMergeRequest = "Merge Request Hook"
+
+type gitlabUser struct {
+	Username string `json:"username"`
+}
+
+type gitlabProject struct {
+	PathWithNamespace string `json:"path_with_namespace"`
+}
+
+type gitlabMergeRequest struct {
+	IID            int    `json:"iid"`
+	Title          string `json:"title"`
+	Action         string `json:"action"`
+	Draft          bool   `json:"draft"`
+	WorkInProgress bool   `json:"work_in_progress"`
+}
+
+type gitlabBoolChange struct {
+	Previous bool `json:"previous"`
+	Current  bool `json:"current"`
+}
+
+type gitlabChanges struct {
+	Draft *gitlabBoolChange `json:"draft"`
+}
+
+type gitlabPayload struct {
+	ObjectKind       string              `json:"object_kind"`
+	User             gitlabUser          `json:"user"`
+	Project          gitlabProject       `json:"project"`
+	ObjectAttributes *gitlabMergeRequest `json:"object_attributes"`
+	Changes          gitlabChanges       `json:"changes"`
+}
+
+// HandleGitLabWebhook verifies a GitLab delivery and applies merge request events.
+// Events that cannot be mapped onto our pull requests are acknowledged as ignored so GitLab does not retry them.
+func (uc *UseCase) HandleGitLabWebhook(ctx context.Context, event string, token string, body []byte) (entity.IntegrationResult, error) {
+	if uc.gitlabToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(uc.gitlabToken)) != 1 {
+		return entity.IntegrationResult{}, entity.ErrInvalidSignature
+	}
+
+	result := entity.IntegrationResult{Event: event}
+
+	if event != gitlabEventMergeRequest {
+		return ignored(result, "unsupported event"), nil
+	}
+
+	var payload gitlabPayload
+	if err := json.Unmarshal(body, &payload); err != nil || payload.ObjectKind != "merge_request" || payload.ObjectAttributes == nil {
+		return entity.IntegrationResult{}, entity.ErrInvalidPayload
+	}
+
+	result.Action = payload.ObjectAttributes.Action
+	result.PullRequestID = entity.CodeHostRef{
+		Provider:   entity.IdentityProviderGitLab,
+		Repository: payload.Project.PathWithNamespace,
+		Number:     payload.ObjectAttributes.IID,
+	}.ID()
+
+	result, err := uc.applyGitLabMergeRequest(ctx, result, payload)
+	if err != nil {
+		return entity.IntegrationResult{}, fmt.Errorf("IntegrationUseCase - HandleGitLabWebhook - %s: %w", result.Action, err)
+	}
+
+	return result, nil
+}
+
+// applyGitLabMergeRequest creates MRs once they are ready for review and merges, closes or reopens them along with GitLab.
+// GitLab does not send the author's username, so the user who opened the MR or marked it ready is treated as its author.
+func (uc *UseCase) applyGitLabMergeRequest(ctx context.Context, result entity.IntegrationResult, payload gitlabPayload) (entity.IntegrationResult, error) {
+	mr := payload.ObjectAttributes
+
+	switch mr.Action {
+	case "open":
+		if mr.Draft || mr.WorkInProgress {
+			return ignored(result, "draft merge request"), nil
+		}
+
+		return uc.openPullRequest(ctx, result, entity.IdentityProviderGitLab, mr.Title, payload.User.Username)
+	case "reopen":
+		if mr.Draft || mr.WorkInProgress {
+			return ignored(result, "draft merge request"), nil
+		}
+
+		return uc.reopenPullRequest(ctx, result, entity.IdentityProviderGitLab, mr.Title, payload.User.Username)
+	case "update":
+		draft := payload.Changes.Draft
+		if draft == nil || !draft.Previous || draft.Current {
+			return ignored(result, "update does not mark the merge request ready"), nil
+		}
+
+		return uc.openPullRequest(ctx, result, entity.IdentityProviderGitLab, mr.Title, payload.User.Username)
+	case "merge":
+		return uc.mergePullRequest(ctx, result)
+	case "close":
+		return uc.closePullRequest(ctx, result)
+	default:
+		return ignored(result, "unsupported action"), nil
+	}
+}
@@ -0,0 +1,145 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestHandleGitLabWebhook_InvalidToken(t *testing.T) {
+	body := loadFixture(t, "gitlab", "merge_request_open.json")
+
+	tests := []struct {
+		name       string
+		configured string
+		token      string
+	}{
+		{name: "missing header", configured: testToken, token: ""},
+		{name: "wrong token", configured: testToken, token: "guess"},
+		{name: "token not configured", configured: "", token: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prUC := new(mockPullRequestUseCase)
+			uc := New(new(mockIdentityRepo), new(mockUserRepo), prUC, testSecret, tt.configured)
+
+			_, err := uc.HandleGitLabWebhook(context.Background(), "Merge Request Hook", tt.token, body)
+
+			assert.ErrorIs(t, err, entity.ErrInvalidSignature)
+			prUC.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandleGitLabWebhook_InvalidPayload(t *testing.T) {
+	uc, _, _ := newUseCase()
+
+	for _, body := range []string{`{"object_kind":`, `{"object_kind":"push"}`, `{"object_kind":"merge_request"}`} {
+		_, err := uc.HandleGitLabWebhook(context.Background(), "Merge Request Hook", testToken, []byte(body))
+
+		assert.ErrorIs(t, err, entity.ErrInvalidPayload, body)
+	}
+}
+
+func TestHandleGitLabWebhook_CreatesPullRequest(t *testing.T) {
+	for _, fixture := range []string{"merge_request_open.json", "merge_request_reopen.json", "merge_request_update_ready.json"} {
+		t.Run(fixture, func(t *testing.T) {
+			uc, identityRepo, prUC := newUseCase()
+			ctx := context.Background()
+			body := loadFixture(t, "gitlab", fixture)
+
+			// A reopened MR the service has not seen yet is created like an opened one
+			prUC.On("ReopenPR", ctx, "acme/billing!7").Return(nil, entity.ErrNotFound).Maybe()
+			identityRepo.On("GetUserIDByLogin", ctx, entity.IdentityProviderGitLab, "alice-dev").Return("u1", nil)
+			prUC.On("CreatePR", ctx, "acme/billing!7", "Retry failed invoices", "u1").Return(entity.PullRequest{}, nil)
+
+			result, err := uc.HandleGitLabWebhook(ctx, "Merge Request Hook", testToken, body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+			assert.Equal(t, "acme/billing!7", result.PullRequestID)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleGitLabWebhook_ChangesPullRequestStatus(t *testing.T) {
+	tests := []struct {
+		fixture string
+		method  string
+	}{
+		{fixture: "merge_request_merge.json", method: "MergePR"},
+		{fixture: "merge_request_close.json", method: "ClosePR"},
+		{fixture: "merge_request_reopen.json", method: "ReopenPR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			uc, _, prUC := newUseCase()
+			ctx := context.Background()
+			body := loadFixture(t, "gitlab", tt.fixture)
+
+			prUC.On(tt.method, ctx, "acme/billing!7").Return(entity.PullRequest{}, nil)
+
+			result, err := uc.HandleGitLabWebhook(ctx, "Merge Request Hook", testToken, body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+			prUC.AssertExpectations(t)
+			prUC.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandleGitLabWebhook_Ignored(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		fixture string
+		setup   func(prUC *mockPullRequestUseCase)
+	}{
+		{name: "unsupported event", event: "Push Hook", fixture: "merge_request_open.json"},
+		{name: "draft", event: "Merge Request Hook", fixture: "merge_request_open_draft.json"},
+		{name: "update without ready", event: "Merge Request Hook", fixture: "merge_request_update_title.json"},
+		{name: "unsupported action", event: "Merge Request Hook", fixture: "merge_request_approved.json"},
+		{
+			name: "close merged", event: "Merge Request Hook", fixture: "merge_request_close.json",
+			setup: func(prUC *mockPullRequestUseCase) {
+				prUC.On("ClosePR", mock.Anything, "acme/billing!7").Return(nil, entity.ErrPRMerged)
+			},
+		},
+		{
+			name: "reopen merged", event: "Merge Request Hook", fixture: "merge_request_reopen.json",
+			setup: func(prUC *mockPullRequestUseCase) {
+				prUC.On("ReopenPR", mock.Anything, "acme/billing!7").Return(nil, entity.ErrPRMerged)
+			},
+		},
+		{
+			name: "merge unknown", event: "Merge Request Hook", fixture: "merge_request_merge.json",
+			setup: func(prUC *mockPullRequestUseCase) {
+				prUC.On("MergePR", mock.Anything, "acme/billing!7").Return(nil, entity.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, _, prUC := newUseCase()
+			body := loadFixture(t, "gitlab", tt.fixture)
+			if tt.setup != nil {
+				tt.setup(prUC)
+			}
+
+			result, err := uc.HandleGitLabWebhook(context.Background(), tt.event, testToken, body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusIgnored, result.Status)
+			assert.NotEmpty(t, result.Reason)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
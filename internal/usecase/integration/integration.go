@@ -0,0 +1,179 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+// UseCase translates code host events into pull request operations.
+type UseCase struct {
+	identityRepo repo.IdentityRepo
+	userRepo     repo.UserRepo
+	pullRequest  usecase.PullRequest
+	githubSecret string
+	gitlabToken  string
+}
+
+// New creates a new Integration use case instance; an empty githubSecret or gitlabToken rejects every delivery from that code host.
+func New(identityRepo repo.IdentityRepo, userRepo repo.UserRepo, pullRequest usecase.PullRequest, githubSecret string, gitlabToken string) *UseCase {
+	return &UseCase{
+		identityRepo: identityRepo,
+		userRepo:     userRepo,
+		pullRequest:  pullRequest,
+		githubSecret: githubSecret,
+		gitlabToken:  gitlabToken,
+	}
+}
+
+// SetIdentity maps a code host login to an existing user
+func (uc *UseCase) SetIdentity(ctx context.Context, identity entity.UserIdentity) (entity.UserIdentity, error) {
+	_, err := uc.userRepo.GetUser(ctx, identity.UserID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.UserIdentity{}, entity.ErrNotFound
+		}
+
+		return entity.UserIdentity{}, fmt.Errorf("IntegrationUseCase - SetIdentity - GetUser: %w", err)
+	}
+
+	identity.Login = normalizeLogin(identity.Login)
+
+	err = uc.identityRepo.SetIdentity(ctx, identity)
+	if err != nil {
+		return entity.UserIdentity{}, fmt.Errorf("IntegrationUseCase - SetIdentity - SetIdentity: %w", err)
+	}
+
+	return identity, nil
+}
+
+// ListIdentities retrieves identity mappings, optionally for one provider
+func (uc *UseCase) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	identities, err := uc.identityRepo.ListIdentities(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("IntegrationUseCase - ListIdentities - ListIdentities: %w", err)
+	}
+
+	return identities, nil
+}
+
+// DeleteIdentity removes a login mapping
+func (uc *UseCase) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	err := uc.identityRepo.DeleteIdentity(ctx, provider, normalizeLogin(login))
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("IntegrationUseCase - DeleteIdentity - DeleteIdentity: %w", err)
+	}
+
+	return nil
+}
+
+// openPullRequest creates the PR described by result, authored by the user mapped to login
+func (uc *UseCase) openPullRequest(ctx context.Context, result entity.IntegrationResult, provider entity.IdentityProvider, title string, login string) (entity.IntegrationResult, error) {
+	authorID, ok, err := uc.resolveUser(ctx, provider, login)
+	if err != nil {
+		return result, err
+	}
+
+	if !ok {
+		return ignored(result, "author login is not mapped to a user"), nil
+	}
+
+	_, err = uc.pullRequest.CreatePR(ctx, result.PullRequestID, title, authorID)
+	switch {
+	case errors.Is(err, entity.ErrPRExists):
+		return ignored(result, "pull request already exists"), nil
+	case errors.Is(err, entity.ErrNotFound):
+		return ignored(result, "author is not a known user"), nil
+	case err != nil:
+		return result, fmt.Errorf("CreatePR: %w", err)
+	}
+
+	result.Status = entity.IntegrationStatusProcessed
+
+	return result, nil
+}
+
+// reopenPullRequest reopens the PR described by result, creating it when it is not known yet
+func (uc *UseCase) reopenPullRequest(ctx context.Context, result entity.IntegrationResult, provider entity.IdentityProvider, title string, login string) (entity.IntegrationResult, error) {
+	_, err := uc.pullRequest.ReopenPR(ctx, result.PullRequestID)
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return uc.openPullRequest(ctx, result, provider, title, login)
+	case errors.Is(err, entity.ErrPRMerged):
+		return ignored(result, "pull request is merged"), nil
+	case err != nil:
+		return result, fmt.Errorf("ReopenPR: %w", err)
+	}
+
+	result.Status = entity.IntegrationStatusProcessed
+
+	return result, nil
+}
+
+// mergePullRequest merges the PR described by result
+func (uc *UseCase) mergePullRequest(ctx context.Context, result entity.IntegrationResult) (entity.IntegrationResult, error) {
+	_, err := uc.pullRequest.MergePR(ctx, result.PullRequestID)
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return ignored(result, "unknown pull request"), nil
+	case err != nil:
+		return result, fmt.Errorf("MergePR: %w", err)
+	}
+
+	result.Status = entity.IntegrationStatusProcessed
+
+	return result, nil
+}
+
+// closePullRequest closes the PR described by result without merging it
+func (uc *UseCase) closePullRequest(ctx context.Context, result entity.IntegrationResult) (entity.IntegrationResult, error) {
+	_, err := uc.pullRequest.ClosePR(ctx, result.PullRequestID)
+	switch {
+	case errors.Is(err, entity.ErrNotFound):
+		return ignored(result, "unknown pull request"), nil
+	case errors.Is(err, entity.ErrPRMerged):
+		return ignored(result, "pull request is merged"), nil
+	case err != nil:
+		return result, fmt.Errorf("ClosePR: %w", err)
+	}
+
+	result.Status = entity.IntegrationStatusProcessed
+
+	return result, nil
+}
+
+// resolveUser maps a code host login to a user ID; ok is false when the login is not mapped
+func (uc *UseCase) resolveUser(ctx context.Context, provider entity.IdentityProvider, login string) (userID string, ok bool, err error) {
+	userID, err = uc.identityRepo.GetUserIDByLogin(ctx, provider, normalizeLogin(login))
+	if errors.Is(err, entity.ErrNotFound) {
+		return "", false, nil
+	}
+
+	if err != nil {
+		return "", false, fmt.Errorf("GetUserIDByLogin: %w", err)
+	}
+
+	return userID, true, nil
+}
+
+// normalizeLogin makes logins comparable; code hosts treat them case-insensitively
+func normalizeLogin(login string) string {
+	return strings.ToLower(strings.TrimSpace(login))
+}
+
+// ignored builds the result of an event that did not change anything
+func ignored(result entity.IntegrationResult, reason string) entity.IntegrationResult {
+	result.Status = entity.IntegrationStatusIgnored
+	result.Reason = reason
+
+	return result
+}
@@ -0,0 +1,483 @@
+package integration
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testSecret = "It's a Secret to Everybody"
+	testToken  = "gitlab-token"
+)
+
+type mockIdentityRepo struct {
+	mock.Mock
+}
+
+func (m *mockIdentityRepo) SetIdentity(ctx context.Context, identity entity.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *mockIdentityRepo) GetUserIDByLogin(ctx context.Context, provider entity.IdentityProvider, login string) (string, error) {
+	args := m.Called(ctx, provider, login)
+	return args.String(0), args.Error(1)
+}
+
+func (m *mockIdentityRepo) ListIdentities(ctx context.Context, provider entity.IdentityProvider) ([]entity.UserIdentity, error) {
+	args := m.Called(ctx, provider)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.UserIdentity), args.Error(1)
+}
+
+func (m *mockIdentityRepo) DeleteIdentity(ctx context.Context, provider entity.IdentityProvider, login string) error {
+	args := m.Called(ctx, provider, login)
+	return args.Error(0)
+}
+
+func (m *mockIdentityRepo) GetLoginByUserID(ctx context.Context, provider entity.IdentityProvider, userID string) (string, error) {
+	args := m.Called(ctx, provider, userID)
+	return args.String(0), args.Error(1)
+}
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPullRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID, prName, authorID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+var (
+	_ repo.IdentityRepo   = (*mockIdentityRepo)(nil)
+	_ repo.UserRepo       = (*mockUserRepo)(nil)
+	_ usecase.PullRequest = (*mockPullRequestUseCase)(nil)
+)
+
+func loadFixture(t *testing.T, provider string, name string) []byte {
+	t.Helper()
+
+	body, err := os.ReadFile(filepath.Join("testdata", provider, name))
+	if err != nil {
+		t.Fatalf("read fixture %s: %v", name, err)
+	}
+
+	return body
+}
+
+func sign(body []byte) string {
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newUseCase() (*UseCase, *mockIdentityRepo, *mockPullRequestUseCase) {
+	identityRepo := new(mockIdentityRepo)
+	prUC := new(mockPullRequestUseCase)
+
+	return New(identityRepo, new(mockUserRepo), prUC, testSecret, testToken), identityRepo, prUC
+}
+
+func TestHandleGitHubWebhook_InvalidSignature(t *testing.T) {
+	body := loadFixture(t, "github", "pull_request_opened.json")
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+	}{
+		{name: "missing header", secret: testSecret, signature: ""},
+		{name: "wrong prefix", secret: testSecret, signature: "sha1=" + sign(body)[len("sha256="):]},
+		{name: "not hex", secret: testSecret, signature: "sha256=zz"},
+		{name: "wrong digest", secret: testSecret, signature: sign([]byte("other"))},
+		{name: "secret not configured", secret: "", signature: sign(body)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prUC := new(mockPullRequestUseCase)
+			uc := New(new(mockIdentityRepo), new(mockUserRepo), prUC, tt.secret, testToken)
+
+			_, err := uc.HandleGitHubWebhook(context.Background(), "pull_request", tt.signature, body)
+
+			assert.ErrorIs(t, err, entity.ErrInvalidSignature)
+			prUC.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_InvalidPayload(t *testing.T) {
+	uc, _, _ := newUseCase()
+	body := []byte(`{"action":`)
+
+	_, err := uc.HandleGitHubWebhook(context.Background(), "pull_request", sign(body), body)
+
+	assert.ErrorIs(t, err, entity.ErrInvalidPayload)
+}
+
+func TestHandleGitHubWebhook_CreatesPullRequest(t *testing.T) {
+	for _, fixture := range []string{"pull_request_opened.json", "pull_request_reopened.json", "pull_request_ready_for_review.json"} {
+		t.Run(fixture, func(t *testing.T) {
+			uc, identityRepo, prUC := newUseCase()
+			ctx := context.Background()
+			body := loadFixture(t, "github", fixture)
+
+			// A reopened PR the service has not seen yet is created like an opened one
+			prUC.On("ReopenPR", ctx, "acme/api#42").Return(nil, entity.ErrNotFound).Maybe()
+			identityRepo.On("GetUserIDByLogin", ctx, entity.IdentityProviderGitHub, "alice-dev").Return("u1", nil)
+			prUC.On("CreatePR", ctx, "acme/api#42", "Add search endpoint", "u1").Return(entity.PullRequest{}, nil)
+
+			result, err := uc.HandleGitHubWebhook(ctx, "pull_request", sign(body), body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+			assert.Equal(t, "acme/api#42", result.PullRequestID)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_MergesPullRequest(t *testing.T) {
+	uc, _, prUC := newUseCase()
+	ctx := context.Background()
+	body := loadFixture(t, "github", "pull_request_closed_merged.json")
+
+	prUC.On("MergePR", ctx, "acme/api#42").Return(entity.PullRequest{}, nil)
+
+	result, err := uc.HandleGitHubWebhook(ctx, "pull_request", sign(body), body)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.IntegrationResult{
+		Event:         "pull_request",
+		Action:        "closed",
+		Status:        entity.IntegrationStatusProcessed,
+		PullRequestID: "acme/api#42",
+	}, result)
+	prUC.AssertExpectations(t)
+}
+
+func TestHandleGitHubWebhook_ClosesPullRequest(t *testing.T) {
+	uc, _, prUC := newUseCase()
+	ctx := context.Background()
+	body := loadFixture(t, "github", "pull_request_closed.json")
+
+	prUC.On("ClosePR", ctx, "acme/api#42").Return(entity.PullRequest{}, nil)
+
+	result, err := uc.HandleGitHubWebhook(ctx, "pull_request", sign(body), body)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+	prUC.AssertExpectations(t)
+}
+
+func TestHandleGitHubWebhook_ReopensPullRequest(t *testing.T) {
+	uc, _, prUC := newUseCase()
+	ctx := context.Background()
+	body := loadFixture(t, "github", "pull_request_reopened.json")
+
+	prUC.On("ReopenPR", ctx, "acme/api#42").Return(entity.PullRequest{}, nil)
+
+	result, err := uc.HandleGitHubWebhook(ctx, "pull_request", sign(body), body)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+	assert.Equal(t, "reopened", result.Action)
+	prUC.AssertExpectations(t)
+	prUC.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestHandleGitHubWebhook_RecordsReview(t *testing.T) {
+	tests := []struct {
+		fixture string
+		outcome entity.ReviewOutcome
+	}{
+		{fixture: "pull_request_review_approved.json", outcome: entity.ReviewOutcomeApproved},
+		{fixture: "pull_request_review_changes_requested.json", outcome: entity.ReviewOutcomeChangesRequested},
+		{fixture: "pull_request_review_commented.json", outcome: entity.ReviewOutcomeCommented},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			uc, identityRepo, prUC := newUseCase()
+			ctx := context.Background()
+			body := loadFixture(t, "github", tt.fixture)
+
+			identityRepo.On("GetUserIDByLogin", ctx, entity.IdentityProviderGitHub, "bob").Return("u2", nil)
+			prUC.On("RecordReview", ctx, "acme/api#42", "u2", tt.outcome).Return(nil)
+
+			result, err := uc.HandleGitHubWebhook(ctx, "pull_request_review", sign(body), body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusProcessed, result.Status)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_Ignored(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		fixture string
+		setup   func(identityRepo *mockIdentityRepo, prUC *mockPullRequestUseCase)
+	}{
+		{name: "ping", event: "ping", fixture: "ping.json"},
+		{name: "unsupported event", event: "push", fixture: "ping.json"},
+		{name: "draft", event: "pull_request", fixture: "pull_request_opened_draft.json"},
+		{name: "unsupported action", event: "pull_request", fixture: "pull_request_labeled.json"},
+		{
+			name: "unmapped author", event: "pull_request", fixture: "pull_request_opened.json",
+			setup: func(identityRepo *mockIdentityRepo, _ *mockPullRequestUseCase) {
+				identityRepo.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderGitHub, "alice-dev").Return("", entity.ErrNotFound)
+			},
+		},
+		{
+			name: "already exists", event: "pull_request", fixture: "pull_request_opened.json",
+			setup: func(identityRepo *mockIdentityRepo, prUC *mockPullRequestUseCase) {
+				identityRepo.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderGitHub, "alice-dev").Return("u1", nil)
+				prUC.On("CreatePR", mock.Anything, "acme/api#42", "Add search endpoint", "u1").Return(nil, entity.ErrPRExists)
+			},
+		},
+		{
+			name: "unknown pull request merged", event: "pull_request", fixture: "pull_request_closed_merged.json",
+			setup: func(_ *mockIdentityRepo, prUC *mockPullRequestUseCase) {
+				prUC.On("MergePR", mock.Anything, "acme/api#42").Return(nil, entity.ErrNotFound)
+			},
+		},
+		{
+			name: "merged pull request reopened", event: "pull_request", fixture: "pull_request_reopened.json",
+			setup: func(_ *mockIdentityRepo, prUC *mockPullRequestUseCase) {
+				prUC.On("ReopenPR", mock.Anything, "acme/api#42").Return(nil, entity.ErrPRMerged)
+			},
+		},
+		{
+			name: "reviewer not assigned", event: "pull_request_review", fixture: "pull_request_review_approved.json",
+			setup: func(identityRepo *mockIdentityRepo, prUC *mockPullRequestUseCase) {
+				identityRepo.On("GetUserIDByLogin", mock.Anything, entity.IdentityProviderGitHub, "bob").Return("u2", nil)
+				prUC.On("RecordReview", mock.Anything, "acme/api#42", "u2", entity.ReviewOutcomeApproved).Return(entity.ErrNotAssigned)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, identityRepo, prUC := newUseCase()
+			body := loadFixture(t, "github", tt.fixture)
+			if tt.setup != nil {
+				tt.setup(identityRepo, prUC)
+			}
+
+			result, err := uc.HandleGitHubWebhook(context.Background(), tt.event, sign(body), body)
+
+			assert.NoError(t, err)
+			assert.Equal(t, entity.IntegrationStatusIgnored, result.Status)
+			assert.NotEmpty(t, result.Reason)
+			prUC.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHandleGitHubWebhook_UseCaseError(t *testing.T) {
+	uc, identityRepo, prUC := newUseCase()
+	ctx := context.Background()
+	body := loadFixture(t, "github", "pull_request_opened.json")
+	dbErr := errors.New("db down")
+
+	identityRepo.On("GetUserIDByLogin", ctx, entity.IdentityProviderGitHub, "alice-dev").Return("u1", nil)
+	prUC.On("CreatePR", ctx, "acme/api#42", "Add search endpoint", "u1").Return(nil, dbErr)
+
+	_, err := uc.HandleGitHubWebhook(ctx, "pull_request", sign(body), body)
+
+	assert.ErrorIs(t, err, dbErr)
+}
+
+func TestSetIdentity(t *testing.T) {
+	identityRepo := new(mockIdentityRepo)
+	userRepo := new(mockUserRepo)
+	uc := New(identityRepo, userRepo, nil, testSecret, testToken)
+	ctx := context.Background()
+
+	userRepo.On("GetUser", ctx, "u1").Return(entity.User{UserID: "u1"}, nil)
+	userRepo.On("GetUser", ctx, "ghost").Return(nil, entity.ErrNotFound)
+	identityRepo.On("SetIdentity", ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "octocat", UserID: "u1"}).Return(nil)
+
+	identity, err := uc.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: " OctoCat ", UserID: "u1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "octocat", identity.Login)
+
+	_, err = uc.SetIdentity(ctx, entity.UserIdentity{Provider: entity.IdentityProviderGitHub, Login: "nobody", UserID: "ghost"})
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+
+	identityRepo.AssertNumberOfCalls(t, "SetIdentity", 1)
+}
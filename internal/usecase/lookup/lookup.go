@@ -0,0 +1,63 @@
+package lookup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+// UseCase handles batched lookups of users and pull requests by key.
+type UseCase struct {
+	userRepo repo.UserRepo
+	prRepo   repo.PullRequestRepo
+}
+
+// New creates a new Lookup use case instance.
+func New(userRepo repo.UserRepo, prRepo repo.PullRequestRepo) *UseCase {
+	return &UseCase{
+		userRepo: userRepo,
+		prRepo:   prRepo,
+	}
+}
+
+// GetUsers retrieves users keyed by user_id; unknown IDs are absent from the result
+func (uc *UseCase) GetUsers(ctx context.Context, userIDs []string) (map[string]entity.User, error) {
+	users, err := uc.userRepo.GetUsers(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("LookupUseCase - GetUsers - GetUsers: %w", err)
+	}
+
+	result := make(map[string]entity.User, len(users))
+	for _, u := range users {
+		result[u.UserID] = u
+	}
+
+	return result, nil
+}
+
+// GetTeamMembers retrieves members of several teams keyed by team_name; teams without members are absent from the result
+func (uc *UseCase) GetTeamMembers(ctx context.Context, teamNames []string) (map[string][]entity.User, error) {
+	users, err := uc.userRepo.GetUsersByTeams(ctx, teamNames)
+	if err != nil {
+		return nil, fmt.Errorf("LookupUseCase - GetTeamMembers - GetUsersByTeams: %w", err)
+	}
+
+	result := make(map[string][]entity.User, len(teamNames))
+	for _, u := range users {
+		result[u.TeamName] = append(result[u.TeamName], u)
+	}
+
+	return result, nil
+}
+
+// GetAssignedPRs retrieves PRs assigned to several reviewers keyed by reviewer user_id; an empty status means any status
+func (uc *UseCase) GetAssignedPRs(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	prs, err := uc.prRepo.GetPRsByReviewers(ctx, reviewerIDs, status)
+	if err != nil {
+		return nil, fmt.Errorf("LookupUseCase - GetAssignedPRs - GetPRsByReviewers: %w", err)
+	}
+
+	return prs, nil
+}
@@ -0,0 +1,260 @@
+package lookup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+func TestGetTeamMembers_GroupsByTeam(t *testing.T) {
+	userRepo := new(mockUserRepo)
+	uc := New(userRepo, new(mockPRRepo))
+
+	userRepo.On("GetUsersByTeams", mock.Anything, []string{"backend", "frontend", "empty"}).Return([]entity.User{
+		{UserID: "u1", TeamName: "backend"},
+		{UserID: "u2", TeamName: "backend"},
+		{UserID: "u3", TeamName: "frontend"},
+	}, nil)
+
+	members, err := uc.GetTeamMembers(context.Background(), []string{"backend", "frontend", "empty"})
+
+	assert.NoError(t, err)
+	assert.Len(t, members["backend"], 2)
+	assert.Len(t, members["frontend"], 1)
+	assert.NotContains(t, members, "empty")
+	userRepo.AssertExpectations(t)
+}
+
+func TestGetUsers_KeyedByID(t *testing.T) {
+	userRepo := new(mockUserRepo)
+	uc := New(userRepo, new(mockPRRepo))
+
+	userRepo.On("GetUsers", mock.Anything, []string{"u1", "missing"}).Return([]entity.User{
+		{UserID: "u1", Username: "Alice"},
+	}, nil)
+
+	users, err := uc.GetUsers(context.Background(), []string{"u1", "missing"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", users["u1"].Username)
+	assert.NotContains(t, users, "missing")
+}
+
+func TestGetAssignedPRs_RepoError(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(new(mockUserRepo), prRepo)
+
+	prRepo.On("GetPRsByReviewers", mock.Anything, []string{"u1"}, entity.PullRequestStatusOpen).Return(nil, assert.AnError)
+
+	_, err := uc.GetAssignedPRs(context.Background(), []string{"u1"}, entity.PullRequestStatusOpen)
+
+	assert.ErrorIs(t, err, assert.AnError)
+}
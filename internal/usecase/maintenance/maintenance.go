@@ -0,0 +1,171 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+// UseCase handles operator maintenance tasks run from the command line.
+type UseCase struct {
+	team        usecase.Team
+	user        usecase.User
+	pullRequest usecase.PullRequest
+}
+
+// New creates a new Maintenance use case instance.
+func New(team usecase.Team, user usecase.User, pullRequest usecase.PullRequest) *UseCase {
+	return &UseCase{
+		team:        team,
+		user:        user,
+		pullRequest: pullRequest,
+	}
+}
+
+// Seed creates the teams and pull requests of a snapshot, leaving existing ones unchanged.
+// Pull requests get freshly assigned reviewers and are then merged or closed to match their status.
+func (uc *UseCase) Seed(ctx context.Context, snapshot entity.Snapshot) (entity.SeedResult, error) {
+	var result entity.SeedResult
+
+	for _, team := range snapshot.Teams {
+		err := uc.team.CreateTeam(ctx, team)
+		switch {
+		case err == nil:
+			result.TeamsCreated++
+		case errors.Is(err, entity.ErrTeamExists):
+			result.TeamsSkipped++
+		default:
+			return result, fmt.Errorf("MaintenanceUseCase - Seed - CreateTeam %s: %w", team.TeamName, err)
+		}
+	}
+
+	for _, pr := range snapshot.PullRequests {
+		_, err := uc.pullRequest.CreatePR(ctx, pr.PullRequestID, pr.PullRequestName, pr.AuthorID)
+		if errors.Is(err, entity.ErrPRExists) {
+			result.PullRequestsSkipped++
+
+			continue
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("MaintenanceUseCase - Seed - CreatePR %s: %w", pr.PullRequestID, err)
+		}
+
+		switch pr.Status {
+		case entity.PullRequestStatusMerged:
+			_, err = uc.pullRequest.MergePR(ctx, pr.PullRequestID)
+		case entity.PullRequestStatusClosed:
+			_, err = uc.pullRequest.ClosePR(ctx, pr.PullRequestID)
+		case entity.PullRequestStatusOpen:
+			// CreatePR leaves the PR open
+		}
+
+		if err != nil {
+			return result, fmt.Errorf("MaintenanceUseCase - Seed - set status of %s: %w", pr.PullRequestID, err)
+		}
+
+		result.PullRequestsCreated++
+	}
+
+	return result, nil
+}
+
+// ReassignUser hands every open review of a user over to other members of their team, deactivating the user
+// first when asked so that they are not picked again. Reviews nobody can take over stay with the user.
+func (uc *UseCase) ReassignUser(ctx context.Context, userID string, deactivate bool) ([]entity.UserReassignment, error) {
+	if deactivate {
+		if _, err := uc.user.SetIsActive(ctx, userID, false); err != nil {
+			return nil, fmt.Errorf("MaintenanceUseCase - ReassignUser - SetIsActive: %w", err)
+		}
+	}
+
+	// Collect the whole queue first, as every reassignment removes a PR from it
+	var prIDs []string
+
+	query := entity.ReviewQueueQuery{
+		Status: entity.PullRequestStatusOpen,
+		Page:   entity.PageRequest{Limit: entity.MaxPageLimit, Order: entity.SortOrderAsc},
+	}
+
+	for {
+		page, err := uc.user.GetUserReviews(ctx, userID, query)
+		if err != nil {
+			return nil, fmt.Errorf("MaintenanceUseCase - ReassignUser - GetUserReviews: %w", err)
+		}
+
+		for _, pr := range page.PullRequests {
+			prIDs = append(prIDs, pr.PullRequestID)
+		}
+
+		if page.NextCursor == "" {
+			break
+		}
+
+		query.Page.Cursor = page.NextCursor
+	}
+
+	results := make([]entity.UserReassignment, 0, len(prIDs))
+
+	for _, prID := range prIDs {
+		_, newReviewerID, err := uc.pullRequest.ReassignReviewer(ctx, prID, userID)
+		switch {
+		case err == nil:
+			results = append(results, entity.UserReassignment{PullRequestID: prID, NewReviewerID: newReviewerID})
+		case errors.Is(err, entity.ErrNoCandidate), errors.Is(err, entity.ErrNotAssigned),
+			errors.Is(err, entity.ErrPRMerged), errors.Is(err, entity.ErrPRClosed):
+			// Nobody to hand over to, or the PR changed since the queue was read
+			results = append(results, entity.UserReassignment{PullRequestID: prID, Skipped: err.Error()})
+		default:
+			return results, fmt.Errorf("MaintenanceUseCase - ReassignUser - ReassignReviewer %s: %w", prID, err)
+		}
+	}
+
+	return results, nil
+}
+
+// Export returns every team with its members and every pull request, oldest first.
+func (uc *UseCase) Export(ctx context.Context) (entity.Snapshot, error) {
+	now := time.Now().UTC()
+	snapshot := entity.Snapshot{
+		ExportedAt:   &now,
+		Teams:        []entity.Team{},
+		PullRequests: []entity.PullRequest{},
+	}
+
+	summaries, err := uc.team.ListTeams(ctx)
+	if err != nil {
+		return snapshot, fmt.Errorf("MaintenanceUseCase - Export - ListTeams: %w", err)
+	}
+
+	for _, summary := range summaries {
+		team, err := uc.team.GetTeam(ctx, summary.TeamName)
+		if err != nil {
+			return snapshot, fmt.Errorf("MaintenanceUseCase - Export - GetTeam %s: %w", summary.TeamName, err)
+		}
+
+		snapshot.Teams = append(snapshot.Teams, team)
+	}
+
+	page := entity.PageRequest{Limit: entity.MaxPageLimit, Order: entity.SortOrderAsc}
+
+	for {
+		prs, err := uc.pullRequest.ListPRs(ctx, entity.PullRequestFilter{}, page)
+		if err != nil {
+			return snapshot, fmt.Errorf("MaintenanceUseCase - Export - ListPRs: %w", err)
+		}
+
+		snapshot.PullRequests = append(snapshot.PullRequests, prs.PullRequests...)
+
+		if prs.NextCursor == "" {
+			break
+		}
+
+		page.Cursor = prs.NextCursor
+	}
+
+	return snapshot, nil
+}
@@ -0,0 +1,337 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/usecase"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTeamUseCase struct {
+	mock.Mock
+}
+
+func (m *mockTeamUseCase) CreateTeam(ctx context.Context, team entity.Team) error {
+	args := m.Called(ctx, team)
+	return args.Error(0)
+}
+
+func (m *mockTeamUseCase) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return entity.Team{}, args.Error(1)
+	}
+	return args.Get(0).(entity.Team), args.Error(1)
+}
+
+func (m *mockTeamUseCase) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamUseCase) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+var _ usecase.Team = (*mockTeamUseCase)(nil)
+
+type mockUserUseCase struct {
+	mock.Mock
+}
+
+func (m *mockUserUseCase) SetIsActive(ctx context.Context, userID string, isActive bool) (entity.User, error) {
+	args := m.Called(ctx, userID, isActive)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserUseCase) GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.ReviewQueuePage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.ReviewQueuePage), args.Error(1)
+}
+
+func (m *mockUserUseCase) GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error) {
+	args := m.Called(ctx, userID, query)
+	if args.Get(0) == nil {
+		return entity.AuthoredPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.AuthoredPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.UserPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.UserPage), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error) {
+	args := m.Called(ctx, settings)
+	return args.Get(0).(entity.DigestSettings), args.Error(1)
+}
+
+func (m *mockUserUseCase) SetRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+var _ usecase.User = (*mockUserUseCase)(nil)
+
+type mockPullRequestUseCase struct {
+	mock.Mock
+}
+
+func (m *mockPullRequestUseCase) CreatePR(ctx context.Context, prID string, prName string, authorID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID, prName, authorID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	args := m.Called(ctx, prID, expand)
+	if args.Get(0) == nil {
+		return entity.PullRequestDetail{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestDetail), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestHistory{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestHistory), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	args := m.Called(ctx, filter, page)
+	if args.Get(0) == nil {
+		return entity.PullRequestPage{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestPage), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	args := m.Called(ctx, prID, oldReviewerID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, "", args.Error(2)
+	}
+	return args.Get(0).(entity.PullRequest), args.String(1), args.Error(2)
+}
+
+func (m *mockPullRequestUseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPullRequestUseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPullRequestUseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+var _ usecase.PullRequest = (*mockPullRequestUseCase)(nil)
+
+var (
+	errDB = errors.New("db down")
+	ctx   = context.Background()
+)
+
+func TestSeed_CreatesAndSkipsExisting(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(teamUC, new(mockUserUseCase), prUC)
+
+	backend := entity.Team{TeamName: "backend", Members: []entity.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}}
+	frontend := entity.Team{TeamName: "frontend"}
+
+	teamUC.On("CreateTeam", ctx, backend).Return(nil)
+	teamUC.On("CreateTeam", ctx, frontend).Return(entity.ErrTeamExists)
+	prUC.On("CreatePR", ctx, "pr-1", "Add search", "u1").Return(entity.PullRequest{}, nil)
+	prUC.On("CreatePR", ctx, "pr-2", "Fix login", "u1").Return(entity.PullRequest{}, nil)
+	prUC.On("MergePR", ctx, "pr-2").Return(entity.PullRequest{}, nil)
+	prUC.On("CreatePR", ctx, "pr-3", "Old work", "u1").Return(entity.PullRequest{}, entity.ErrPRExists)
+
+	result, err := uc.Seed(ctx, entity.Snapshot{
+		Teams: []entity.Team{backend, frontend},
+		PullRequests: []entity.PullRequest{
+			{PullRequestID: "pr-1", PullRequestName: "Add search", AuthorID: "u1", Status: entity.PullRequestStatusOpen},
+			{PullRequestID: "pr-2", PullRequestName: "Fix login", AuthorID: "u1", Status: entity.PullRequestStatusMerged},
+			{PullRequestID: "pr-3", PullRequestName: "Old work", AuthorID: "u1", Status: entity.PullRequestStatusClosed},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.SeedResult{TeamsCreated: 1, TeamsSkipped: 1, PullRequestsCreated: 2, PullRequestsSkipped: 1}, result)
+	prUC.AssertExpectations(t)
+	prUC.AssertNotCalled(t, "ClosePR", mock.Anything, mock.Anything)
+}
+
+func TestSeed_StopsOnError(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(teamUC, new(mockUserUseCase), prUC)
+
+	teamUC.On("CreateTeam", ctx, mock.Anything).Return(errDB)
+
+	result, err := uc.Seed(ctx, entity.Snapshot{
+		Teams:        []entity.Team{{TeamName: "backend"}},
+		PullRequests: []entity.PullRequest{{PullRequestID: "pr-1"}},
+	})
+
+	assert.ErrorIs(t, err, errDB)
+	assert.Equal(t, entity.SeedResult{}, result)
+	prUC.AssertNotCalled(t, "CreatePR", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReassignUser_DeactivatesAndWalksAllPages(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(new(mockTeamUseCase), userUC, prUC)
+
+	firstPage := entity.ReviewQueueQuery{
+		Status: entity.PullRequestStatusOpen,
+		Page:   entity.PageRequest{Limit: entity.MaxPageLimit, Order: entity.SortOrderAsc},
+	}
+	secondPage := firstPage
+	secondPage.Page.Cursor = "next"
+
+	userUC.On("SetIsActive", ctx, "u2", false).Return(entity.User{UserID: "u2"}, nil).Once()
+	userUC.On("GetUserReviews", ctx, "u2", firstPage).Return(entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1"}}},
+		NextCursor:   "next",
+	}, nil).Once()
+	userUC.On("GetUserReviews", ctx, "u2", secondPage).Return(entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-2"}}},
+	}, nil).Once()
+	prUC.On("ReassignReviewer", ctx, "pr-1", "u2").Return(entity.PullRequest{}, "u3", nil).Once()
+	prUC.On("ReassignReviewer", ctx, "pr-2", "u2").Return(entity.PullRequest{}, "", entity.ErrNoCandidate).Once()
+
+	results, err := uc.ReassignUser(ctx, "u2", true)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.UserReassignment{
+		{PullRequestID: "pr-1", NewReviewerID: "u3"},
+		{PullRequestID: "pr-2", Skipped: entity.ErrNoCandidate.Error()},
+	}, results)
+	userUC.AssertExpectations(t)
+	prUC.AssertExpectations(t)
+}
+
+func TestReassignUser_UnknownUser(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(new(mockTeamUseCase), userUC, prUC)
+
+	userUC.On("SetIsActive", ctx, "ghost", false).Return(nil, entity.ErrNotFound)
+
+	results, err := uc.ReassignUser(ctx, "ghost", true)
+
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+	assert.Nil(t, results)
+	userUC.AssertNotCalled(t, "GetUserReviews", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReassignUser_StopsOnUnexpectedError(t *testing.T) {
+	userUC := new(mockUserUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(new(mockTeamUseCase), userUC, prUC)
+
+	userUC.On("GetUserReviews", ctx, "u2", mock.Anything).Return(entity.ReviewQueuePage{
+		PullRequests: []entity.ReviewQueueItem{
+			{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1"}},
+			{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-2"}},
+		},
+	}, nil)
+	prUC.On("ReassignReviewer", ctx, "pr-1", "u2").Return(nil, "", errDB).Once()
+
+	results, err := uc.ReassignUser(ctx, "u2", false)
+
+	assert.ErrorIs(t, err, errDB)
+	assert.Empty(t, results)
+	userUC.AssertNotCalled(t, "SetIsActive", mock.Anything, mock.Anything, mock.Anything)
+	prUC.AssertNotCalled(t, "ReassignReviewer", ctx, "pr-2", "u2")
+}
+
+func TestExport(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(teamUC, new(mockUserUseCase), prUC)
+
+	backend := entity.Team{TeamName: "backend", Members: []entity.TeamMember{{UserID: "u1", Username: "Alice", IsActive: true}}}
+	firstPage := entity.PageRequest{Limit: entity.MaxPageLimit, Order: entity.SortOrderAsc}
+	secondPage := firstPage
+	secondPage.Cursor = "next"
+
+	teamUC.On("ListTeams", ctx).Return([]entity.TeamSummary{{TeamName: "backend"}}, nil)
+	teamUC.On("GetTeam", ctx, "backend").Return(backend, nil)
+	prUC.On("ListPRs", ctx, entity.PullRequestFilter{}, firstPage).Return(entity.PullRequestPage{
+		PullRequests: []entity.PullRequest{{PullRequestID: "pr-1"}},
+		NextCursor:   "next",
+	}, nil)
+	prUC.On("ListPRs", ctx, entity.PullRequestFilter{}, secondPage).Return(entity.PullRequestPage{
+		PullRequests: []entity.PullRequest{{PullRequestID: "pr-2"}},
+	}, nil)
+
+	snapshot, err := uc.Export(ctx)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, snapshot.ExportedAt)
+	assert.Equal(t, []entity.Team{backend}, snapshot.Teams)
+	assert.Equal(t, []entity.PullRequest{{PullRequestID: "pr-1"}, {PullRequestID: "pr-2"}}, snapshot.PullRequests)
+}
+
+func TestExport_ListTeamsError(t *testing.T) {
+	teamUC := new(mockTeamUseCase)
+	prUC := new(mockPullRequestUseCase)
+	uc := New(teamUC, new(mockUserUseCase), prUC)
+
+	teamUC.On("ListTeams", ctx).Return(nil, errDB)
+
+	_, err := uc.Export(ctx)
+
+	assert.ErrorIs(t, err, errDB)
+	prUC.AssertNotCalled(t, "ListPRs", mock.Anything, mock.Anything, mock.Anything)
+}
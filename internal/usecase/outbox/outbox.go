@@ -0,0 +1,122 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/finstape/pr-reviews/internal/usecase"
+)
+
+const (
+	// _relayLockKey identifies the advisory lock that lets only one instance relay at a time,
+	// which keeps events in commit order.
+	_relayLockKey int64 = 0x70725f6f7574 // "pr_out"
+
+	_defaultBatchSize = 100
+)
+
+// UseCase relays events from the transactional outbox to the configured sinks.
+type UseCase struct {
+	outboxRepo repo.OutboxRepo
+	lockRepo   repo.LockRepo
+	sinks      []usecase.EventPublisher
+	batchSize  int
+}
+
+// New creates a new Outbox use case instance; every relayed event is handed to each of the sinks.
+func New(outboxRepo repo.OutboxRepo, lockRepo repo.LockRepo, batchSize int, sinks ...usecase.EventPublisher) *UseCase {
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+
+	return &UseCase{
+		outboxRepo: outboxRepo,
+		lockRepo:   lockRepo,
+		sinks:      sinks,
+		batchSize:  batchSize,
+	}
+}
+
+// RelayEvents publishes one batch of outbox events in the order they were committed.
+// Delivery is at least once: an event is retried until every sink has accepted it in the same pass,
+// so sinks must tolerate seeing an event id again.
+func (uc *UseCase) RelayEvents(ctx context.Context) (entity.RelayResult, error) {
+	var result entity.RelayResult
+
+	_, err := uc.lockRepo.WithTryLock(ctx, _relayLockKey, func(ctx context.Context) error {
+		var err error
+		result, err = uc.relay(ctx)
+
+		return err
+	})
+	if err != nil {
+		return result, fmt.Errorf("OutboxUseCase - RelayEvents - WithTryLock: %w", err)
+	}
+
+	return result, nil
+}
+
+// relay publishes a batch of events, stopping at the first failure so that later events are not delivered ahead of it
+func (uc *UseCase) relay(ctx context.Context) (entity.RelayResult, error) {
+	var result entity.RelayResult
+
+	events, err := uc.outboxRepo.GetUnpublishedEvents(ctx, uc.batchSize)
+	if err != nil {
+		return result, fmt.Errorf("GetUnpublishedEvents: %w", err)
+	}
+
+	for _, outboxEvent := range events {
+		if err := uc.publish(ctx, outboxEvent.Event()); err != nil {
+			result.Failed++
+
+			if markErr := uc.outboxRepo.MarkFailed(ctx, outboxEvent.OutboxID, err.Error()); markErr != nil {
+				err = errors.Join(err, fmt.Errorf("MarkFailed: %w", markErr))
+			}
+
+			return result, fmt.Errorf("publish %d: %w", outboxEvent.OutboxID, err)
+		}
+
+		if err := uc.outboxRepo.MarkPublished(ctx, outboxEvent.OutboxID); err != nil {
+			return result, fmt.Errorf("MarkPublished %d: %w", outboxEvent.OutboxID, err)
+		}
+
+		result.Published++
+	}
+
+	return result, nil
+}
+
+// publish hands the event to every sink
+func (uc *UseCase) publish(ctx context.Context, event entity.Event) error {
+	for _, sink := range uc.sinks {
+		if err := sink.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bestEffortSink hands events to a sink whose failures must not hold back the outbox
+type bestEffortSink struct {
+	sink    usecase.EventPublisher
+	onError func(event entity.Event, err error)
+}
+
+// BestEffort wraps a sink that talks to a system outside our control: its errors are passed to onError
+// and the event counts as accepted, so an outage there does not stall the other sinks.
+func BestEffort(sink usecase.EventPublisher, onError func(event entity.Event, err error)) usecase.EventPublisher {
+	return bestEffortSink{sink: sink, onError: onError}
+}
+
+// Publish implements usecase.EventPublisher
+func (s bestEffortSink) Publish(ctx context.Context, event entity.Event) error {
+	if err := s.sink.Publish(ctx, event); err != nil {
+		s.onError(event, err)
+	}
+
+	return nil
+}
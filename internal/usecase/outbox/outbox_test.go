@@ -0,0 +1,200 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockOutboxRepo struct {
+	mock.Mock
+}
+
+func (m *mockOutboxRepo) GetUnpublishedEvents(ctx context.Context, limit int) ([]entity.OutboxEvent, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OutboxEvent), args.Error(1)
+}
+
+func (m *mockOutboxRepo) MarkPublished(ctx context.Context, outboxID int64) error {
+	args := m.Called(ctx, outboxID)
+	return args.Error(0)
+}
+
+func (m *mockOutboxRepo) MarkFailed(ctx context.Context, outboxID int64, lastError string) error {
+	args := m.Called(ctx, outboxID, lastError)
+	return args.Error(0)
+}
+
+var _ repo.OutboxRepo = (*mockOutboxRepo)(nil)
+
+type mockLockRepo struct {
+	mock.Mock
+}
+
+func (m *mockLockRepo) WithTryLock(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	args := m.Called(ctx, key)
+	if !args.Bool(0) {
+		return false, args.Error(1)
+	}
+	return true, fn(ctx)
+}
+
+var _ repo.LockRepo = (*mockLockRepo)(nil)
+
+type mockSink struct {
+	mock.Mock
+}
+
+func (m *mockSink) Publish(ctx context.Context, event entity.Event) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func outboxEvents() []entity.OutboxEvent {
+	createdAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	return []entity.OutboxEvent{
+		{OutboxID: 1, Type: entity.EventPRCreated, Payload: []byte(`{"pull_request":{}}`), CreatedAt: createdAt},
+		{OutboxID: 2, Type: entity.EventReviewerAssigned, Payload: []byte(`{"pull_request_id":"pr-1","reviewer_id":"u2"}`), CreatedAt: createdAt},
+		{OutboxID: 3, Type: entity.EventReviewerAssigned, Payload: []byte(`{"pull_request_id":"pr-1","reviewer_id":"u3"}`), CreatedAt: createdAt},
+	}
+}
+
+func eventWithID(eventID string) interface{} {
+	return mock.MatchedBy(func(event entity.Event) bool { return event.EventID == eventID })
+}
+
+func TestRelayEvents_PublishesToEverySink(t *testing.T) {
+	outboxRepo := new(mockOutboxRepo)
+	lockRepo := new(mockLockRepo)
+	first, second := new(mockSink), new(mockSink)
+
+	uc := New(outboxRepo, lockRepo, 10, first, second)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _relayLockKey).Return(true, nil)
+	outboxRepo.On("GetUnpublishedEvents", ctx, 10).Return(outboxEvents(), nil)
+	first.On("Publish", ctx, mock.Anything).Return(nil).Times(3)
+	second.On("Publish", ctx, mock.Anything).Return(nil).Times(3)
+	outboxRepo.On("MarkPublished", ctx, int64(1)).Return(nil)
+	outboxRepo.On("MarkPublished", ctx, int64(2)).Return(nil)
+	outboxRepo.On("MarkPublished", ctx, int64(3)).Return(nil)
+
+	result, err := uc.RelayEvents(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.RelayResult{Published: 3}, result)
+	outboxRepo.AssertExpectations(t)
+	first.AssertExpectations(t)
+	second.AssertExpectations(t)
+}
+
+func TestRelayEvents_EventShape(t *testing.T) {
+	outboxRepo := new(mockOutboxRepo)
+	lockRepo := new(mockLockRepo)
+	sink := new(mockSink)
+
+	uc := New(outboxRepo, lockRepo, 10, sink)
+
+	ctx := context.Background()
+	stored := outboxEvents()[1]
+
+	lockRepo.On("WithTryLock", ctx, _relayLockKey).Return(true, nil)
+	outboxRepo.On("GetUnpublishedEvents", ctx, 10).Return([]entity.OutboxEvent{stored}, nil)
+	outboxRepo.On("MarkPublished", ctx, int64(2)).Return(nil)
+
+	var published entity.Event
+	sink.On("Publish", ctx, mock.Anything).Run(func(args mock.Arguments) { published = args.Get(1).(entity.Event) }).Return(nil)
+
+	_, err := uc.RelayEvents(ctx)
+	assert.NoError(t, err)
+
+	// The event id is stable across retries and the payload is passed through untouched
+	assert.Equal(t, "evt-2", published.EventID)
+	assert.Equal(t, entity.EventReviewerAssigned, published.Type)
+	assert.Equal(t, stored.CreatedAt, published.OccurredAt)
+
+	body, err := json.Marshal(published)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"event_id":"evt-2","type":"reviewer.assigned","occurred_at":"2026-10-18T12:00:00Z","data":{"pull_request_id":"pr-1","reviewer_id":"u2"}}`, string(body))
+}
+
+func TestRelayEvents_StopsAtFirstFailure(t *testing.T) {
+	outboxRepo := new(mockOutboxRepo)
+	lockRepo := new(mockLockRepo)
+	sink := new(mockSink)
+
+	uc := New(outboxRepo, lockRepo, 10, sink)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _relayLockKey).Return(true, nil)
+	outboxRepo.On("GetUnpublishedEvents", ctx, 10).Return(outboxEvents(), nil)
+	sink.On("Publish", ctx, eventWithID("evt-1")).Return(nil)
+	sink.On("Publish", ctx, eventWithID("evt-2")).Return(errors.New("sink unavailable"))
+	outboxRepo.On("MarkPublished", ctx, int64(1)).Return(nil)
+	outboxRepo.On("MarkFailed", ctx, int64(2), "sink unavailable").Return(nil)
+
+	result, err := uc.RelayEvents(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, entity.RelayResult{Published: 1, Failed: 1}, result)
+	sink.AssertNotCalled(t, "Publish", ctx, eventWithID("evt-3"))
+	outboxRepo.AssertExpectations(t)
+}
+
+func TestRelayEvents_LockHeldElsewhere(t *testing.T) {
+	outboxRepo := new(mockOutboxRepo)
+	lockRepo := new(mockLockRepo)
+
+	uc := New(outboxRepo, lockRepo, 0)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _relayLockKey).Return(false, nil)
+
+	result, err := uc.RelayEvents(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.RelayResult{}, result)
+	outboxRepo.AssertNotCalled(t, "GetUnpublishedEvents", mock.Anything, mock.Anything)
+}
+
+func TestRelayEvents_BestEffortSinkDoesNotBlock(t *testing.T) {
+	outboxRepo := new(mockOutboxRepo)
+	lockRepo := new(mockLockRepo)
+	flaky, webhooks := new(mockSink), new(mockSink)
+
+	var failed []string
+	uc := New(outboxRepo, lockRepo, 10, BestEffort(flaky, func(event entity.Event, _ error) {
+		failed = append(failed, event.EventID)
+	}), webhooks)
+
+	ctx := context.Background()
+
+	lockRepo.On("WithTryLock", ctx, _relayLockKey).Return(true, nil)
+	outboxRepo.On("GetUnpublishedEvents", ctx, 10).Return(outboxEvents(), nil)
+	flaky.On("Publish", ctx, eventWithID("evt-2")).Return(errors.New("code host unavailable"))
+	flaky.On("Publish", ctx, mock.Anything).Return(nil)
+	webhooks.On("Publish", ctx, mock.Anything).Return(nil).Times(3)
+	outboxRepo.On("MarkPublished", ctx, mock.Anything).Return(nil).Times(3)
+
+	result, err := uc.RelayEvents(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.RelayResult{Published: 3}, result)
+	assert.Equal(t, []string{"evt-2"}, failed)
+	webhooks.AssertExpectations(t)
+	outboxRepo.AssertExpectations(t)
+}
@@ -2,7 +2,9 @@ package pullrequest
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
@@ -72,6 +74,77 @@ func (uc *UseCase) CreatePR(ctx context.Context, prID string, prName string, aut
 	return pr, nil
 }
 
+// GetPR retrieves a PR with its reviewers, optionally embedding author and reviewer users
+func (uc *UseCase) GetPR(ctx context.Context, prID string, expand entity.PullRequestExpand) (entity.PullRequestDetail, error) {
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		return entity.PullRequestDetail{}, fmt.Errorf("PullRequestUseCase - GetPR - GetPR: %w", err)
+	}
+
+	if pr.AssignedReviewers == nil {
+		pr.AssignedReviewers = []string{}
+	}
+
+	detail := entity.PullRequestDetail{PullRequest: pr}
+
+	if expand.Author {
+		author, err := uc.userRepo.GetUser(ctx, pr.AuthorID)
+		if err != nil {
+			return entity.PullRequestDetail{}, fmt.Errorf("PullRequestUseCase - GetPR - GetUser author: %w", err)
+		}
+
+		detail.Author = &author
+	}
+
+	if expand.Reviewers {
+		reviewers, err := uc.userRepo.GetUsers(ctx, pr.AssignedReviewers)
+		if err != nil {
+			return entity.PullRequestDetail{}, fmt.Errorf("PullRequestUseCase - GetPR - GetUsers reviewers: %w", err)
+		}
+
+		detail.Reviewers = reviewers
+	}
+
+	return detail, nil
+}
+
+// ListPRs retrieves a page of PRs matching the filter ordered by creation time (newest first by default)
+func (uc *UseCase) ListPRs(ctx context.Context, filter entity.PullRequestFilter, page entity.PageRequest) (entity.PullRequestPage, error) {
+	scope := entity.NewPageScope(page.NormalizedOrder(), filter)
+
+	after, err := entity.DecodeCursor(page.Cursor, scope)
+	if err != nil {
+		return entity.PullRequestPage{}, err
+	}
+
+	limit := page.NormalizedLimit()
+
+	// Fetch one extra row to know whether there is a next page
+	prs, err := uc.prRepo.ListPRs(ctx, filter, page.NormalizedOrder(), after, limit+1)
+	if err != nil {
+		return entity.PullRequestPage{}, fmt.Errorf("PullRequestUseCase - ListPRs - ListPRs: %w", err)
+	}
+
+	prs, nextCursor := entity.TrimPage(prs, limit, scope, entity.PullRequestCursor)
+
+	return entity.PullRequestPage{PullRequests: prs, NextCursor: nextCursor}, nil
+}
+
+// GetPRHistory builds the ordered timeline of a PR: creation, reviewer assignments and replacements,
+// submitted reviews and the final merge or close
+func (uc *UseCase) GetPRHistory(ctx context.Context, prID string) (entity.PullRequestHistory, error) {
+	record, err := uc.prRepo.GetPRRecord(ctx, prID)
+	if err != nil {
+		return entity.PullRequestHistory{}, fmt.Errorf("PullRequestUseCase - GetPRHistory - GetPRRecord: %w", err)
+	}
+
+	return entity.PullRequestHistory{
+		PullRequestID: record.PullRequest.PullRequestID,
+		Status:        record.PullRequest.Status,
+		Events:        historyEvents(record),
+	}, nil
+}
+
 // MergePR marks a PR as merged (idempotent)
 func (uc *UseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest, error) {
 	// Get PR
@@ -102,17 +175,119 @@ func (uc *UseCase) MergePR(ctx context.Context, prID string) (entity.PullRequest
 	return pr, nil
 }
 
+// ClosePR marks an open PR as closed without merging it (idempotent)
+func (uc *UseCase) ClosePR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ClosePR - GetPR: %w", err)
+	}
+
+	switch pr.Status {
+	case entity.PullRequestStatusClosed:
+		return pr, nil
+	case entity.PullRequestStatusMerged:
+		return entity.PullRequest{}, entity.ErrPRMerged
+	}
+
+	err = uc.prRepo.UpdatePRStatus(ctx, prID, entity.PullRequestStatusClosed, nil)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ClosePR - UpdatePRStatus: %w", err)
+	}
+
+	pr, err = uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ClosePR - GetPR after update: %w", err)
+	}
+
+	return pr, nil
+}
+
+// ReopenPR marks a closed PR as open again, keeping its reviewers (idempotent)
+func (uc *UseCase) ReopenPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ReopenPR - GetPR: %w", err)
+	}
+
+	switch pr.Status {
+	case entity.PullRequestStatusOpen:
+		return pr, nil
+	case entity.PullRequestStatusMerged:
+		return entity.PullRequest{}, entity.ErrPRMerged
+	}
+
+	err = uc.prRepo.UpdatePRStatus(ctx, prID, entity.PullRequestStatusOpen, nil)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ReopenPR - UpdatePRStatus: %w", err)
+	}
+
+	pr, err = uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		return entity.PullRequest{}, fmt.Errorf("PullRequestUseCase - ReopenPR - GetPR after update: %w", err)
+	}
+
+	return pr, nil
+}
+
 // ReassignReviewer replaces one reviewer with another from the same team
 func (uc *UseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	return uc.reassignReviewer(ctx, prID, oldReviewerID, entity.ReassignmentManual)
+}
+
+// AutoReassignReviewer replaces a stale reviewer like ReassignReviewer, recording the reassignment as automatic
+func (uc *UseCase) AutoReassignReviewer(ctx context.Context, prID string, oldReviewerID string) (entity.PullRequest, string, error) {
+	return uc.reassignReviewer(ctx, prID, oldReviewerID, entity.ReassignmentAutomatic)
+}
+
+// RecordReview stores the outcome of a review submitted by an assigned reviewer on an open PR
+func (uc *UseCase) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("PullRequestUseCase - RecordReview - GetPR: %w", err)
+	}
+
+	switch pr.Status {
+	case entity.PullRequestStatusMerged:
+		return entity.ErrPRMerged
+	case entity.PullRequestStatusClosed:
+		return entity.ErrPRClosed
+	}
+
+	err = uc.prRepo.RecordReview(ctx, prID, reviewerID, outcome)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotAssigned) {
+			return entity.ErrNotAssigned
+		}
+
+		return fmt.Errorf("PullRequestUseCase - RecordReview - RecordReview: %w", err)
+	}
+
+	return nil
+}
+
+// reassignReviewer picks a random active replacement from the old reviewer's team and records the reassignment reason
+func (uc *UseCase) reassignReviewer(
+	ctx context.Context,
+	prID string,
+	oldReviewerID string,
+	reason entity.ReassignmentReason,
+) (entity.PullRequest, string, error) {
 	// Get PR
 	pr, err := uc.prRepo.GetPR(ctx, prID)
 	if err != nil {
 		return entity.PullRequest{}, "", fmt.Errorf("PullRequestUseCase - ReassignReviewer - GetPR: %w", err)
 	}
 
-	// Check if PR is merged
-	if pr.Status == entity.PullRequestStatusMerged {
+	// Check if PR is still open
+	switch pr.Status {
+	case entity.PullRequestStatusMerged:
 		return entity.PullRequest{}, "", entity.ErrPRMerged
+	case entity.PullRequestStatusClosed:
+		return entity.PullRequest{}, "", entity.ErrPRClosed
 	}
 
 	// Verify old reviewer is assigned
@@ -169,7 +344,7 @@ func (uc *UseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewe
 	newReviewerID := selected[0]
 
 	// Reassign
-	err = uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID)
+	err = uc.prRepo.ReassignReviewer(ctx, prID, oldReviewerID, newReviewerID, reason)
 	if err != nil {
 		return entity.PullRequest{}, "", fmt.Errorf("PullRequestUseCase - ReassignReviewer - ReassignReviewer: %w", err)
 	}
@@ -183,3 +358,67 @@ func (uc *UseCase) ReassignReviewer(ctx context.Context, prID string, oldReviewe
 	return pr, newReviewerID, nil
 }
 
+// _historyRank orders events recorded at the same instant, e.g. within one transaction: a replaced reviewer
+// is unassigned before the replacement is assigned, and the merge or close comes last
+var _historyRank = map[entity.HistoryEventType]int{
+	entity.HistoryCreated:            0,
+	entity.HistoryReviewerUnassigned: 1,
+	entity.HistoryReviewerAssigned:   2,
+	entity.HistoryReviewSubmitted:    3,
+	entity.HistoryMerged:             4,
+	entity.HistoryClosed:             4,
+}
+
+// historyEvents flattens a PR record into events ordered by time; events at the same instant keep
+// the order of assignments
+func historyEvents(record entity.PullRequestRecord) []entity.HistoryEvent {
+	pr := record.PullRequest
+	events := make([]entity.HistoryEvent, 0, 2+3*len(record.Assignments))
+
+	if pr.CreatedAt != nil {
+		events = append(events, entity.HistoryEvent{Type: entity.HistoryCreated, OccurredAt: *pr.CreatedAt, UserID: pr.AuthorID})
+	}
+
+	for _, a := range record.Assignments {
+		if a.AssignedAt != nil {
+			events = append(events, entity.HistoryEvent{Type: entity.HistoryReviewerAssigned, OccurredAt: *a.AssignedAt, UserID: a.ReviewerID})
+		}
+
+		if a.ReviewedAt != nil {
+			events = append(events, entity.HistoryEvent{
+				Type:          entity.HistoryReviewSubmitted,
+				OccurredAt:    *a.ReviewedAt,
+				UserID:        a.ReviewerID,
+				ReviewOutcome: a.ReviewOutcome,
+			})
+		}
+
+		if a.UnassignedAt != nil {
+			events = append(events, entity.HistoryEvent{
+				Type:       entity.HistoryReviewerUnassigned,
+				OccurredAt: *a.UnassignedAt,
+				UserID:     a.ReviewerID,
+				ReplacedBy: a.ReplacedBy,
+				Reason:     a.Reason,
+			})
+		}
+	}
+
+	if pr.MergedAt != nil {
+		events = append(events, entity.HistoryEvent{Type: entity.HistoryMerged, OccurredAt: *pr.MergedAt})
+	}
+
+	if record.ClosedAt != nil {
+		events = append(events, entity.HistoryEvent{Type: entity.HistoryClosed, OccurredAt: *record.ClosedAt})
+	}
+
+	slices.SortStableFunc(events, func(a, b entity.HistoryEvent) int {
+		if c := a.OccurredAt.Compare(b.OccurredAt); c != 0 {
+			return c
+		}
+
+		return _historyRank[a.Type] - _historyRank[b.Type]
+	})
+
+	return events
+}
@@ -29,6 +29,14 @@ func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest
 	return args.Get(0).(entity.PullRequest), args.Error(1)
 }
 
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
 func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
 	args := m.Called(ctx, prID)
 	return args.Bool(0), args.Error(1)
@@ -47,8 +55,8 @@ func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string,
 	return args.Get(0).([]string), args.Error(1)
 }
 
-func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string) error {
-	args := m.Called(ctx, prID, oldReviewerID, newReviewerID)
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
 	return args.Error(0)
 }
 
@@ -60,6 +68,72 @@ func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([
 	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
 }
 
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
 var _ repo.PullRequestRepo = (*mockPRRepo)(nil)
 
 type mockUserRepo struct {
@@ -79,6 +153,32 @@ func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User,
 	return args.Get(0).(entity.User), args.Error(1)
 }
 
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
 func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
 	args := m.Called(ctx, userID, isActive)
 	return args.Error(0)
@@ -92,12 +192,30 @@ func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string
 	return args.Get(0).([]entity.User), args.Error(1)
 }
 
-func (m *mockUserRepo) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
 }
 
 var _ repo.UserRepo = (*mockUserRepo)(nil)
@@ -124,6 +242,34 @@ func (m *mockTeamRepo) TeamExists(ctx context.Context, teamName string) (bool, e
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockTeamRepo) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamRepo) SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error {
+	args := m.Called(ctx, teamName, slaSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error {
+	args := m.Called(ctx, teamName, thresholdSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetChatWebhookURL(ctx context.Context, teamName string, url *string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetChatWebhookURL(ctx context.Context, teamName string) (string, error) {
+	args := m.Called(ctx, teamName)
+	return args.String(0), args.Error(1)
+}
+
 var _ repo.TeamRepo = (*mockTeamRepo)(nil)
 
 func TestCreatePR_Success(t *testing.T) {
@@ -296,6 +442,102 @@ func TestMergePR_Idempotent(t *testing.T) {
 	prRepo.AssertNotCalled(t, "UpdatePRStatus")
 }
 
+func TestClosePR_Success(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+	ctx := context.Background()
+	pr := entity.PullRequest{PullRequestID: "pr-1", Status: entity.PullRequestStatusOpen}
+	closedPR := pr
+	closedPR.Status = entity.PullRequestStatusClosed
+
+	prRepo.On("GetPR", ctx, "pr-1").Return(pr, nil).Once()
+	prRepo.On("UpdatePRStatus", ctx, "pr-1", entity.PullRequestStatusClosed, (*entity.Time)(nil)).Return(nil)
+	prRepo.On("GetPR", ctx, "pr-1").Return(closedPR, nil).Once()
+
+	result, err := uc.ClosePR(ctx, "pr-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PullRequestStatusClosed, result.Status)
+	assert.Nil(t, result.MergedAt)
+	prRepo.AssertExpectations(t)
+}
+
+func TestClosePR_NotOpen(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      entity.PullRequestStatus
+		expectedErr error
+	}{
+		{name: "already closed", status: entity.PullRequestStatusClosed},
+		{name: "merged", status: entity.PullRequestStatusMerged, expectedErr: entity.ErrPRMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := new(mockPRRepo)
+			uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+			ctx := context.Background()
+
+			prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", Status: tt.status}, nil)
+
+			_, err := uc.ClosePR(ctx, "pr-1")
+
+			assert.Equal(t, tt.expectedErr, err)
+			prRepo.AssertNotCalled(t, "UpdatePRStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestReopenPR_Success(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+	ctx := context.Background()
+	pr := entity.PullRequest{PullRequestID: "pr-1", Status: entity.PullRequestStatusClosed, AssignedReviewers: []string{"u2"}}
+	reopenedPR := pr
+	reopenedPR.Status = entity.PullRequestStatusOpen
+
+	prRepo.On("GetPR", ctx, "pr-1").Return(pr, nil).Once()
+	prRepo.On("UpdatePRStatus", ctx, "pr-1", entity.PullRequestStatusOpen, (*entity.Time)(nil)).Return(nil)
+	prRepo.On("GetPR", ctx, "pr-1").Return(reopenedPR, nil).Once()
+
+	result, err := uc.ReopenPR(ctx, "pr-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.PullRequestStatusOpen, result.Status)
+	assert.Equal(t, []string{"u2"}, result.AssignedReviewers)
+	prRepo.AssertExpectations(t)
+}
+
+func TestReopenPR_NotClosed(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      entity.PullRequestStatus
+		expectedErr error
+	}{
+		{name: "already open", status: entity.PullRequestStatusOpen},
+		{name: "merged", status: entity.PullRequestStatusMerged, expectedErr: entity.ErrPRMerged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := new(mockPRRepo)
+			uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+			ctx := context.Background()
+
+			prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", Status: tt.status}, nil)
+
+			_, err := uc.ReopenPR(ctx, "pr-1")
+
+			assert.Equal(t, tt.expectedErr, err)
+			prRepo.AssertNotCalled(t, "UpdatePRStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestReassignReviewer_Success(t *testing.T) {
 	prRepo := new(mockPRRepo)
 	userRepo := new(mockUserRepo)
@@ -335,7 +577,7 @@ func TestReassignReviewer_Success(t *testing.T) {
 	prRepo.On("GetPR", ctx, prID).Return(pr, nil).Once()
 	userRepo.On("GetUser", ctx, oldReviewerID).Return(oldReviewer, nil)
 	userRepo.On("GetActiveTeamMembers", ctx, "team1", oldReviewerID).Return(candidates, nil)
-	prRepo.On("ReassignReviewer", ctx, prID, oldReviewerID, newReviewerID).Return(nil)
+	prRepo.On("ReassignReviewer", ctx, prID, oldReviewerID, newReviewerID, entity.ReassignmentManual).Return(nil)
 	prRepo.On("GetPR", ctx, prID).Return(updatedPR, nil).Once()
 
 	result, newID, err := uc.ReassignReviewer(ctx, prID, oldReviewerID)
@@ -349,6 +591,42 @@ func TestReassignReviewer_Success(t *testing.T) {
 	userRepo.AssertExpectations(t)
 }
 
+func TestAutoReassignReviewer_RecordsAutomaticReason(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+	prID := "pr-1"
+
+	pr := entity.PullRequest{
+		PullRequestID:     prID,
+		PullRequestName:   "Test PR",
+		AuthorID:          "u1",
+		Status:            entity.PullRequestStatusOpen,
+		AssignedReviewers: []string{"u2"},
+	}
+
+	updatedPR := pr
+	updatedPR.AssignedReviewers = []string{"u3"}
+
+	prRepo.On("GetPR", ctx, prID).Return(pr, nil).Once()
+	userRepo.On("GetUser", ctx, "u2").Return(entity.User{UserID: "u2", TeamName: "team1", IsActive: true}, nil)
+	userRepo.On("GetActiveTeamMembers", ctx, "team1", "u2").Return([]entity.User{{UserID: "u3", TeamName: "team1", IsActive: true}}, nil)
+	prRepo.On("ReassignReviewer", ctx, prID, "u2", "u3", entity.ReassignmentAutomatic).Return(nil)
+	prRepo.On("GetPR", ctx, prID).Return(updatedPR, nil).Once()
+
+	_, newID, err := uc.AutoReassignReviewer(ctx, prID, "u2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "u3", newID)
+
+	prRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
 func TestReassignReviewer_MergedPR(t *testing.T) {
 	prRepo := new(mockPRRepo)
 	userRepo := new(mockUserRepo)
@@ -445,3 +723,302 @@ func TestReassignReviewer_NoCandidate(t *testing.T) {
 	assert.Equal(t, entity.ErrNoCandidate, err)
 }
 
+func TestGetPR_WithExpand(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+	prID := "pr-1"
+
+	now := time.Now()
+	pr := entity.PullRequest{
+		PullRequestID:     prID,
+		PullRequestName:   "Test PR",
+		AuthorID:          "u1",
+		Status:            entity.PullRequestStatusOpen,
+		AssignedReviewers: []string{"u2", "u3"},
+		CreatedAt:         &now,
+	}
+
+	author := entity.User{UserID: "u1", Username: "Author", TeamName: "team1", IsActive: true}
+	reviewers := []entity.User{
+		{UserID: "u2", Username: "Reviewer1", TeamName: "team1", IsActive: true},
+		{UserID: "u3", Username: "Reviewer2", TeamName: "team1", IsActive: true},
+	}
+
+	prRepo.On("GetPR", ctx, prID).Return(pr, nil)
+	userRepo.On("GetUser", ctx, "u1").Return(author, nil)
+	userRepo.On("GetUsers", ctx, []string{"u2", "u3"}).Return(reviewers, nil)
+
+	result, err := uc.GetPR(ctx, prID, entity.PullRequestExpand{Author: true, Reviewers: true})
+
+	assert.NoError(t, err)
+	assert.Equal(t, prID, result.PullRequestID)
+	assert.Equal(t, &author, result.Author)
+	assert.Equal(t, reviewers, result.Reviewers)
+
+	prRepo.AssertExpectations(t)
+	userRepo.AssertExpectations(t)
+}
+
+func TestGetPR_WithoutExpand(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+	prID := "pr-1"
+
+	pr := entity.PullRequest{
+		PullRequestID:   prID,
+		PullRequestName: "Test PR",
+		AuthorID:        "u1",
+		Status:          entity.PullRequestStatusOpen,
+	}
+
+	prRepo.On("GetPR", ctx, prID).Return(pr, nil)
+
+	result, err := uc.GetPR(ctx, prID, entity.PullRequestExpand{})
+
+	assert.NoError(t, err)
+	assert.Nil(t, result.Author)
+	assert.Nil(t, result.Reviewers)
+	assert.Equal(t, []string{}, result.AssignedReviewers)
+	userRepo.AssertNotCalled(t, "GetUser")
+	userRepo.AssertNotCalled(t, "GetUsers")
+}
+
+func TestGetPR_NotFound(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+
+	prRepo.On("GetPR", ctx, "pr-99").Return(entity.PullRequest{}, entity.ErrNotFound)
+
+	_, err := uc.GetPR(ctx, "pr-99", entity.PullRequestExpand{Author: true})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+}
+
+func TestGetPRHistory_Timeline(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+	ctx := context.Background()
+	created := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	reviewed := created.Add(time.Hour)
+	reassigned := created.Add(2 * time.Hour)
+	merged := created.Add(3 * time.Hour)
+	approved := entity.ReviewOutcomeApproved
+
+	record := entity.PullRequestRecord{
+		PullRequest: entity.PullRequest{
+			PullRequestID: "pr-1",
+			AuthorID:      "u1",
+			Status:        entity.PullRequestStatusMerged,
+			CreatedAt:     &created,
+			MergedAt:      &merged,
+		},
+		Assignments: []entity.AssignmentRecord{
+			{ReviewerID: "u2", AssignedAt: &created, ReviewOutcome: &approved, ReviewedAt: &reviewed},
+			{ReviewerID: "u3", AssignedAt: &created, UnassignedAt: &reassigned, ReplacedBy: "u4", Reason: entity.ReassignmentAutomatic},
+			{ReviewerID: "u4", AssignedAt: &reassigned},
+		},
+	}
+
+	prRepo.On("GetPRRecord", ctx, "pr-1").Return(record, nil)
+
+	history, err := uc.GetPRHistory(ctx, "pr-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "pr-1", history.PullRequestID)
+	assert.Equal(t, entity.PullRequestStatusMerged, history.Status)
+	assert.Equal(t, []entity.HistoryEvent{
+		{Type: entity.HistoryCreated, OccurredAt: created, UserID: "u1"},
+		{Type: entity.HistoryReviewerAssigned, OccurredAt: created, UserID: "u2"},
+		{Type: entity.HistoryReviewerAssigned, OccurredAt: created, UserID: "u3"},
+		{Type: entity.HistoryReviewSubmitted, OccurredAt: reviewed, UserID: "u2", ReviewOutcome: &approved},
+		{Type: entity.HistoryReviewerUnassigned, OccurredAt: reassigned, UserID: "u3", ReplacedBy: "u4", Reason: entity.ReassignmentAutomatic},
+		{Type: entity.HistoryReviewerAssigned, OccurredAt: reassigned, UserID: "u4"},
+		{Type: entity.HistoryMerged, OccurredAt: merged},
+	}, history.Events)
+}
+
+func TestGetPRHistory_Closed(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+	ctx := context.Background()
+	created := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+	closed := created.Add(time.Hour)
+
+	prRepo.On("GetPRRecord", ctx, "pr-1").Return(entity.PullRequestRecord{
+		PullRequest: entity.PullRequest{PullRequestID: "pr-1", AuthorID: "u1", Status: entity.PullRequestStatusClosed, CreatedAt: &created},
+		ClosedAt:    &closed,
+	}, nil)
+
+	history, err := uc.GetPRHistory(ctx, "pr-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []entity.HistoryEvent{
+		{Type: entity.HistoryCreated, OccurredAt: created, UserID: "u1"},
+		{Type: entity.HistoryClosed, OccurredAt: closed},
+	}, history.Events)
+}
+
+func TestGetPRHistory_NotFound(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+	ctx := context.Background()
+
+	prRepo.On("GetPRRecord", ctx, "pr-99").Return(entity.PullRequestRecord{}, entity.ErrNotFound)
+
+	_, err := uc.GetPRHistory(ctx, "pr-99")
+
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+}
+
+func TestListPRs_NextCursor(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+	filter := entity.PullRequestFilter{Status: entity.PullRequestStatusOpen, TeamName: "team1"}
+
+	t1 := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+	t2 := t1.Add(-time.Hour)
+	t3 := t1.Add(-2 * time.Hour)
+	prs := []entity.PullRequest{
+		{PullRequestID: "pr-3", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{}, CreatedAt: &t1},
+		{PullRequestID: "pr-2", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{}, CreatedAt: &t2},
+		{PullRequestID: "pr-1", AuthorID: "u1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{}, CreatedAt: &t3},
+	}
+
+	prRepo.On("ListPRs", ctx, filter, entity.SortOrderDesc, (*entity.Cursor)(nil), 3).Return(prs, nil).Once()
+
+	page, err := uc.ListPRs(ctx, filter, entity.PageRequest{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	// The cursor must point right after the last returned PR
+	cursor, err := entity.DecodeCursor(page.NextCursor, entity.NewPageScope(entity.SortOrderDesc, filter))
+	assert.NoError(t, err)
+	assert.Equal(t, "pr-2", cursor.ID)
+	assert.True(t, t2.Equal(cursor.Time))
+
+	prRepo.On("ListPRs", ctx, filter, entity.SortOrderDesc, cursor, 3).Return(prs[2:], nil).Once()
+
+	next := page.NextCursor
+	page, err = uc.ListPRs(ctx, filter, entity.PageRequest{Limit: 2, Cursor: next})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 1)
+	assert.Empty(t, page.NextCursor)
+
+	// The cursor only resumes the listing it came from
+	_, err = uc.ListPRs(ctx, filter, entity.PageRequest{Limit: 2, Cursor: next, Order: entity.SortOrderAsc})
+	assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+
+	_, err = uc.ListPRs(ctx, entity.PullRequestFilter{Status: entity.PullRequestStatusMerged, TeamName: "team1"}, entity.PageRequest{Limit: 2, Cursor: next})
+	assert.ErrorIs(t, err, entity.ErrInvalidCursor)
+
+	prRepo.AssertExpectations(t)
+}
+
+func TestListPRs_DefaultLimit(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+
+	prRepo.On("ListPRs", ctx, entity.PullRequestFilter{}, entity.SortOrderDesc, (*entity.Cursor)(nil), entity.DefaultPageLimit+1).Return([]entity.PullRequest{}, nil)
+
+	page, err := uc.ListPRs(ctx, entity.PullRequestFilter{}, entity.PageRequest{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, page.PullRequests)
+	assert.Empty(t, page.NextCursor)
+	prRepo.AssertExpectations(t)
+}
+
+func TestListPRs_InvalidCursor(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	_, err := uc.ListPRs(context.Background(), entity.PullRequestFilter{}, entity.PageRequest{Cursor: "not-a-cursor"})
+
+	assert.Equal(t, entity.ErrInvalidCursor, err)
+	prRepo.AssertNotCalled(t, "ListPRs")
+}
+
+func TestRecordReview_Success(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	userRepo := new(mockUserRepo)
+	teamRepo := new(mockTeamRepo)
+
+	uc := New(prRepo, userRepo, teamRepo)
+
+	ctx := context.Background()
+	pr := entity.PullRequest{PullRequestID: "pr-1", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u2"}}
+
+	prRepo.On("GetPR", ctx, "pr-1").Return(pr, nil)
+	prRepo.On("RecordReview", ctx, "pr-1", "u2", entity.ReviewOutcomeApproved).Return(nil)
+
+	err := uc.RecordReview(ctx, "pr-1", "u2", entity.ReviewOutcomeApproved)
+
+	assert.NoError(t, err)
+	prRepo.AssertExpectations(t)
+}
+
+func TestRecordReview_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      entity.PullRequestStatus
+		getErr      error
+		recordErr   error
+		expectedErr error
+	}{
+		{name: "PR not found", getErr: entity.ErrNotFound, expectedErr: entity.ErrNotFound},
+		{name: "merged PR", status: entity.PullRequestStatusMerged, expectedErr: entity.ErrPRMerged},
+		{name: "closed PR", status: entity.PullRequestStatusClosed, expectedErr: entity.ErrPRClosed},
+		{name: "not assigned", status: entity.PullRequestStatusOpen, recordErr: entity.ErrNotAssigned, expectedErr: entity.ErrNotAssigned},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prRepo := new(mockPRRepo)
+			uc := New(prRepo, new(mockUserRepo), new(mockTeamRepo))
+
+			ctx := context.Background()
+
+			prRepo.On("GetPR", ctx, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", Status: tt.status}, tt.getErr)
+			prRepo.On("RecordReview", ctx, "pr-1", "u2", entity.ReviewOutcomeCommented).Return(tt.recordErr)
+
+			err := uc.RecordReview(ctx, "pr-1", "u2", entity.ReviewOutcomeCommented)
+
+			assert.Equal(t, tt.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+// UseCase handles review SLA business logic.
+type UseCase struct {
+	teamRepo   repo.TeamRepo
+	prRepo     repo.PullRequestRepo
+	defaultSLA time.Duration
+}
+
+// New creates a new SLA use case instance; defaultSLA applies to teams without their own SLA.
+func New(teamRepo repo.TeamRepo, prRepo repo.PullRequestRepo, defaultSLA time.Duration) *UseCase {
+	return &UseCase{
+		teamRepo:   teamRepo,
+		prRepo:     prRepo,
+		defaultSLA: defaultSLA,
+	}
+}
+
+// SetTeamSLA sets the team's review SLA; a zero SLA resets it to the service default
+func (uc *UseCase) SetTeamSLA(ctx context.Context, teamName string, sla time.Duration) error {
+	var slaSeconds *int
+	if sla > 0 {
+		seconds := int(sla.Seconds())
+		slaSeconds = &seconds
+	}
+
+	err := uc.teamRepo.SetReviewSLA(ctx, teamName, slaSeconds)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("SLAUseCase - SetTeamSLA - SetReviewSLA: %w", err)
+	}
+
+	return nil
+}
+
+// SetTeamEscalation sets the age after which the team's stale reviews are reassigned; zero resets it to the service default
+func (uc *UseCase) SetTeamEscalation(ctx context.Context, teamName string, threshold time.Duration) error {
+	var thresholdSeconds *int
+	if threshold > 0 {
+		seconds := int(threshold.Seconds())
+		thresholdSeconds = &seconds
+	}
+
+	err := uc.teamRepo.SetEscalationThreshold(ctx, teamName, thresholdSeconds)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("SLAUseCase - SetTeamEscalation - SetEscalationThreshold: %w", err)
+	}
+
+	return nil
+}
+
+// GetOverdueReviews returns reviewer assignments past their team's SLA, optionally restricted to a team
+func (uc *UseCase) GetOverdueReviews(ctx context.Context, teamName string) ([]entity.OverdueReview, error) {
+	if teamName != "" {
+		exists, err := uc.teamRepo.TeamExists(ctx, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("SLAUseCase - GetOverdueReviews - TeamExists: %w", err)
+		}
+
+		if !exists {
+			return nil, entity.ErrNotFound
+		}
+	}
+
+	reviews, err := uc.prRepo.GetOverdueReviews(ctx, uc.defaultSLA, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("SLAUseCase - GetOverdueReviews - GetOverdueReviews: %w", err)
+	}
+
+	return reviews, nil
+}
+
+// CountOverdueByTeam returns the number of overdue reviews of every team
+func (uc *UseCase) CountOverdueByTeam(ctx context.Context) ([]entity.OverdueCount, error) {
+	counts, err := uc.prRepo.CountOverdueReviews(ctx, uc.defaultSLA)
+	if err != nil {
+		return nil, fmt.Errorf("SLAUseCase - CountOverdueByTeam - CountOverdueReviews: %w", err)
+	}
+
+	return counts, nil
+}
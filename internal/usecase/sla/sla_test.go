@@ -0,0 +1,327 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockTeamRepo struct {
+	mock.Mock
+}
+
+func (m *mockTeamRepo) CreateTeam(ctx context.Context, team entity.Team) error {
+	args := m.Called(ctx, team)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetTeam(ctx context.Context, teamName string) (entity.Team, error) {
+	args := m.Called(ctx, teamName)
+	if args.Get(0) == nil {
+		return entity.Team{}, args.Error(1)
+	}
+	return args.Get(0).(entity.Team), args.Error(1)
+}
+
+func (m *mockTeamRepo) TeamExists(ctx context.Context, teamName string) (bool, error) {
+	args := m.Called(ctx, teamName)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockTeamRepo) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamRepo) SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error {
+	args := m.Called(ctx, teamName, slaSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error {
+	args := m.Called(ctx, teamName, thresholdSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetChatWebhookURL(ctx context.Context, teamName string, url *string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetChatWebhookURL(ctx context.Context, teamName string) (string, error) {
+	args := m.Called(ctx, teamName)
+	return args.String(0), args.Error(1)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+var (
+	_ repo.TeamRepo        = (*mockTeamRepo)(nil)
+	_ repo.PullRequestRepo = (*mockPRRepo)(nil)
+)
+
+func TestSetTeamSLA_Success(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	uc := New(teamRepo, new(mockPRRepo), 24*time.Hour)
+
+	ctx := context.Background()
+	seconds := 7200
+
+	teamRepo.On("SetReviewSLA", ctx, "backend", &seconds).Return(nil)
+
+	err := uc.SetTeamSLA(ctx, "backend", 2*time.Hour)
+
+	assert.NoError(t, err)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestSetTeamSLA_ResetToDefault(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	uc := New(teamRepo, new(mockPRRepo), 24*time.Hour)
+
+	ctx := context.Background()
+
+	teamRepo.On("SetReviewSLA", ctx, "backend", (*int)(nil)).Return(nil)
+
+	err := uc.SetTeamSLA(ctx, "backend", 0)
+
+	assert.NoError(t, err)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestSetTeamSLA_TeamNotFound(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	uc := New(teamRepo, new(mockPRRepo), 24*time.Hour)
+
+	ctx := context.Background()
+
+	teamRepo.On("SetReviewSLA", ctx, "ghost", mock.Anything).Return(entity.ErrNotFound)
+
+	err := uc.SetTeamSLA(ctx, "ghost", time.Hour)
+
+	assert.Equal(t, entity.ErrNotFound, err)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestSetTeamEscalation_Success(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	uc := New(teamRepo, new(mockPRRepo), 24*time.Hour)
+
+	ctx := context.Background()
+	seconds := 172800
+
+	teamRepo.On("SetEscalationThreshold", ctx, "backend", &seconds).Return(nil)
+
+	err := uc.SetTeamEscalation(ctx, "backend", 48*time.Hour)
+
+	assert.NoError(t, err)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestSetTeamEscalation_TeamNotFound(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	uc := New(teamRepo, new(mockPRRepo), 24*time.Hour)
+
+	ctx := context.Background()
+
+	teamRepo.On("SetEscalationThreshold", ctx, "ghost", (*int)(nil)).Return(entity.ErrNotFound)
+
+	err := uc.SetTeamEscalation(ctx, "ghost", 0)
+
+	assert.Equal(t, entity.ErrNotFound, err)
+	teamRepo.AssertExpectations(t)
+}
+
+func TestGetOverdueReviews_AllTeams(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(teamRepo, prRepo, 24*time.Hour)
+
+	ctx := context.Background()
+	reviews := []entity.OverdueReview{
+		{PullRequestShort: entity.PullRequestShort{PullRequestID: "pr-1"}, ReviewerID: "u2", TeamName: "backend", SLASeconds: 86400, OverdueSeconds: 60},
+	}
+
+	prRepo.On("GetOverdueReviews", ctx, 24*time.Hour, "").Return(reviews, nil)
+
+	result, err := uc.GetOverdueReviews(ctx, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, reviews, result)
+	teamRepo.AssertNotCalled(t, "TeamExists", mock.Anything, mock.Anything)
+	prRepo.AssertExpectations(t)
+}
+
+func TestGetOverdueReviews_UnknownTeam(t *testing.T) {
+	teamRepo := new(mockTeamRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(teamRepo, prRepo, 24*time.Hour)
+
+	ctx := context.Background()
+
+	teamRepo.On("TeamExists", ctx, "ghost").Return(false, nil)
+
+	_, err := uc.GetOverdueReviews(ctx, "ghost")
+
+	assert.Equal(t, entity.ErrNotFound, err)
+	prRepo.AssertNotCalled(t, "GetOverdueReviews", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCountOverdueByTeam(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(new(mockTeamRepo), prRepo, time.Hour)
+
+	ctx := context.Background()
+	counts := []entity.OverdueCount{{TeamName: "backend", Count: 2}, {TeamName: "frontend", Count: 0}}
+
+	prRepo.On("CountOverdueReviews", ctx, time.Hour).Return(counts, nil)
+
+	result, err := uc.CountOverdueByTeam(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, counts, result)
+	prRepo.AssertExpectations(t)
+}
+
+func TestCountOverdueByTeam_RepoError(t *testing.T) {
+	prRepo := new(mockPRRepo)
+	uc := New(new(mockTeamRepo), prRepo, time.Hour)
+
+	ctx := context.Background()
+
+	prRepo.On("CountOverdueReviews", ctx, time.Hour).Return(nil, errors.New("db down"))
+
+	_, err := uc.CountOverdueByTeam(ctx)
+
+	assert.Error(t, err)
+	prRepo.AssertExpectations(t)
+}
@@ -0,0 +1,85 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+// DefaultWindow is the length of the time window used when no start is given.
+const DefaultWindow = 30 * 24 * time.Hour
+
+// UseCase handles review statistics business logic.
+type UseCase struct {
+	statsRepo repo.StatsRepo
+}
+
+// New creates a new Stats use case instance.
+func New(statsRepo repo.StatsRepo) *UseCase {
+	return &UseCase{
+		statsRepo: statsRepo,
+	}
+}
+
+// GetReviewerStats returns review metrics per reviewer, optionally restricted to a team
+func (uc *UseCase) GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) (entity.ReviewerStatsReport, error) {
+	window, err := resolveWindow(window)
+	if err != nil {
+		return entity.ReviewerStatsReport{}, err
+	}
+
+	stats, err := uc.statsRepo.GetReviewerStats(ctx, window, teamName)
+	if err != nil {
+		return entity.ReviewerStatsReport{}, fmt.Errorf("StatsUseCase - GetReviewerStats - GetReviewerStats: %w", err)
+	}
+
+	return entity.ReviewerStatsReport{
+		From:      window.From,
+		To:        window.To,
+		Reviewers: stats,
+	}, nil
+}
+
+// GetTeamStats returns review metrics aggregated per team
+func (uc *UseCase) GetTeamStats(ctx context.Context, window entity.TimeWindow) (entity.TeamStatsReport, error) {
+	window, err := resolveWindow(window)
+	if err != nil {
+		return entity.TeamStatsReport{}, err
+	}
+
+	stats, err := uc.statsRepo.GetTeamStats(ctx, window)
+	if err != nil {
+		return entity.TeamStatsReport{}, fmt.Errorf("StatsUseCase - GetTeamStats - GetTeamStats: %w", err)
+	}
+
+	return entity.TeamStatsReport{
+		From:  window.From,
+		To:    window.To,
+		Teams: stats,
+	}, nil
+}
+
+// resolveWindow fills in missing window bounds and validates the result:
+// a missing end defaults to now, a missing start to DefaultWindow before the end. Bounds are converted to UTC,
+// since the compared TIMESTAMP columns store UTC and pgx drops the offset.
+func resolveWindow(window entity.TimeWindow) (entity.TimeWindow, error) {
+	if window.To.IsZero() {
+		window.To = time.Now()
+	}
+
+	if window.From.IsZero() {
+		window.From = window.To.Add(-DefaultWindow)
+	}
+
+	window.From = window.From.UTC()
+	window.To = window.To.UTC()
+
+	if !window.From.Before(window.To) {
+		return entity.TimeWindow{}, entity.ErrInvalidWindow
+	}
+
+	return window, nil
+}
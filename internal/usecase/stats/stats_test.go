@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockStatsRepo struct {
+	mock.Mock
+}
+
+func (m *mockStatsRepo) GetReviewerStats(ctx context.Context, window entity.TimeWindow, teamName string) ([]entity.ReviewerStats, error) {
+	args := m.Called(ctx, window, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.ReviewerStats), args.Error(1)
+}
+
+func (m *mockStatsRepo) GetTeamStats(ctx context.Context, window entity.TimeWindow) ([]entity.TeamStats, error) {
+	args := m.Called(ctx, window)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamStats), args.Error(1)
+}
+
+var _ repo.StatsRepo = (*mockStatsRepo)(nil)
+
+func TestGetReviewerStats_ExplicitWindow(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+	window := entity.TimeWindow{
+		From: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+	stats := []entity.ReviewerStats{{UserID: "u1", ReviewStats: entity.ReviewStats{Assignments: 2}}}
+
+	statsRepo.On("GetReviewerStats", ctx, window, "backend").Return(stats, nil)
+
+	report, err := uc.GetReviewerStats(ctx, window, "backend")
+
+	assert.NoError(t, err)
+	assert.Equal(t, window.From, report.From)
+	assert.Equal(t, window.To, report.To)
+	assert.Equal(t, stats, report.Reviewers)
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetReviewerStats_WindowConvertedToUTC(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+	moscow := time.FixedZone("MSK", 3*60*60)
+	window := entity.TimeWindow{
+		From: time.Date(2026, 9, 1, 3, 0, 0, 0, moscow),
+		To:   time.Date(2026, 10, 1, 3, 0, 0, 0, moscow),
+	}
+	utcWindow := entity.TimeWindow{
+		From: time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	statsRepo.On("GetReviewerStats", ctx, utcWindow, "").Return([]entity.ReviewerStats{}, nil)
+
+	report, err := uc.GetReviewerStats(ctx, window, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, utcWindow.From, report.From)
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetReviewerStats_DefaultWindow(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+
+	statsRepo.On("GetReviewerStats", ctx, mock.MatchedBy(func(w entity.TimeWindow) bool {
+		return w.To.Sub(w.From) == DefaultWindow && time.Since(w.To) < time.Minute
+	}), "").Return([]entity.ReviewerStats{}, nil)
+
+	report, err := uc.GetReviewerStats(ctx, entity.TimeWindow{}, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultWindow, report.To.Sub(report.From))
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetReviewerStats_RepoError(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+
+	statsRepo.On("GetReviewerStats", ctx, mock.Anything, "").Return(nil, errors.New("db down"))
+
+	_, err := uc.GetReviewerStats(ctx, entity.TimeWindow{}, "")
+
+	assert.Error(t, err)
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetTeamStats_DefaultsStartFromEnd(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+	to := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	expectedWindow := entity.TimeWindow{From: to.Add(-DefaultWindow), To: to}
+
+	statsRepo.On("GetTeamStats", ctx, expectedWindow).Return([]entity.TeamStats{{TeamName: "backend"}}, nil)
+
+	report, err := uc.GetTeamStats(ctx, entity.TimeWindow{To: to})
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedWindow.From, report.From)
+	assert.Len(t, report.Teams, 1)
+	statsRepo.AssertExpectations(t)
+}
+
+func TestGetTeamStats_InvalidWindow(t *testing.T) {
+	statsRepo := new(mockStatsRepo)
+	uc := New(statsRepo)
+
+	ctx := context.Background()
+	window := entity.TimeWindow{
+		From: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	_, err := uc.GetTeamStats(ctx, window)
+
+	assert.ErrorIs(t, err, entity.ErrInvalidWindow)
+	statsRepo.AssertNotCalled(t, "GetTeamStats", mock.Anything, mock.Anything)
+}
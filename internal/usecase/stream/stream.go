@@ -0,0 +1,206 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+const _defaultBufferSize = 64
+
+// subscriber is a live stream consumer on this instance
+type subscriber struct {
+	filter entity.StreamFilter
+	events chan entity.StreamEvent
+}
+
+// UseCase streams review activity to live subscribers on every instance.
+// The instance relaying the outbox annotates events with the users and teams they concern and broadcasts them,
+// every instance listens and fans them out to its own subscribers.
+type UseCase struct {
+	userRepo   repo.UserRepo
+	prRepo     repo.PullRequestRepo
+	eventBus   repo.EventBusRepo
+	bufferSize int
+
+	mu          sync.Mutex
+	subscribers map[*subscriber]struct{}
+}
+
+// New creates a new Stream use case instance; bufferSize is how many events a subscriber may lag behind.
+func New(userRepo repo.UserRepo, prRepo repo.PullRequestRepo, eventBus repo.EventBusRepo, bufferSize int) *UseCase {
+	if bufferSize <= 0 {
+		bufferSize = _defaultBufferSize
+	}
+
+	return &UseCase{
+		userRepo:    userRepo,
+		prRepo:      prRepo,
+		eventBus:    eventBus,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+// Publish broadcasts reviewer.assigned, reviewer.reassigned, review.submitted and pull_request.merged events
+// together with the users and teams they concern; other events are skipped.
+func (uc *UseCase) Publish(ctx context.Context, event entity.Event) error {
+	userIDs, err := uc.participants(ctx, event)
+	if err != nil {
+		return fmt.Errorf("StreamUseCase - Publish - %w", err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	users, err := uc.userRepo.GetUsers(ctx, userIDs)
+	if err != nil {
+		return fmt.Errorf("StreamUseCase - Publish - GetUsers: %w", err)
+	}
+
+	teamNames := make([]string, 0, len(users))
+	for _, user := range users {
+		if user.TeamName != "" && !slices.Contains(teamNames, user.TeamName) {
+			teamNames = append(teamNames, user.TeamName)
+		}
+	}
+
+	err = uc.eventBus.Notify(ctx, entity.StreamEvent{Event: event, UserIDs: userIDs, TeamNames: teamNames})
+	if err != nil {
+		return fmt.Errorf("StreamUseCase - Publish - Notify %s: %w", event.EventID, err)
+	}
+
+	return nil
+}
+
+// Listen fans broadcast events out to the subscribers on this instance until ctx is done.
+func (uc *UseCase) Listen(ctx context.Context) error {
+	if err := uc.eventBus.Listen(ctx, uc.broadcast); err != nil {
+		return fmt.Errorf("StreamUseCase - Listen - %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe registers a subscriber for the events passing filter and returns its channel with a function
+// that unsubscribes. The channel is closed on unsubscribe, or early when the subscriber falls too far behind.
+func (uc *UseCase) Subscribe(filter entity.StreamFilter) (<-chan entity.StreamEvent, func()) {
+	sub := &subscriber{
+		filter: filter,
+		events: make(chan entity.StreamEvent, uc.bufferSize),
+	}
+
+	uc.mu.Lock()
+	uc.subscribers[sub] = struct{}{}
+	uc.mu.Unlock()
+
+	return sub.events, func() {
+		uc.mu.Lock()
+		defer uc.mu.Unlock()
+
+		uc.remove(sub)
+	}
+}
+
+// broadcast hands the event to every matching subscriber without waiting on any of them
+func (uc *UseCase) broadcast(event entity.StreamEvent) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	for sub := range uc.subscribers {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			// Dropping a subscriber that cannot keep up ends its stream, so the client reconnects and catches up
+			uc.remove(sub)
+		}
+	}
+}
+
+// remove unregisters the subscriber and closes its channel once; the caller holds mu
+func (uc *UseCase) remove(sub *subscriber) {
+	if _, ok := uc.subscribers[sub]; !ok {
+		return
+	}
+
+	delete(uc.subscribers, sub)
+	close(sub.events)
+}
+
+// participants returns the users a streamed event concerns: the PR author and the reviewers involved.
+// It returns nil for events that are not streamed.
+func (uc *UseCase) participants(ctx context.Context, event entity.Event) ([]string, error) {
+	var (
+		prID    string
+		userIDs []string
+	)
+
+	switch event.Type {
+	case entity.EventReviewerAssigned:
+		var data entity.ReviewerAssignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return nil, err
+		}
+
+		prID, userIDs = data.PullRequestID, []string{data.ReviewerID}
+	case entity.EventReviewerReassigned:
+		var data entity.ReviewerReassignedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return nil, err
+		}
+
+		prID, userIDs = data.PullRequestID, []string{data.OldReviewerID, data.NewReviewerID}
+	case entity.EventReviewSubmitted:
+		var data entity.ReviewSubmittedEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return nil, err
+		}
+
+		prID, userIDs = data.PullRequestID, []string{data.ReviewerID}
+	case entity.EventPRMerged:
+		var data entity.PullRequestEventData
+		if err := decodeEventData(event, &data); err != nil {
+			return nil, err
+		}
+
+		return append([]string{data.PullRequest.AuthorID}, data.PullRequest.AssignedReviewers...), nil
+	default:
+		return nil, nil
+	}
+
+	pr, err := uc.prRepo.GetPR(ctx, prID)
+	if errors.Is(err, entity.ErrNotFound) {
+		return userIDs, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("GetPR: %w", err)
+	}
+
+	return append([]string{pr.AuthorID}, userIDs...), nil
+}
+
+// decodeEventData converts event data into its payload type; relayed events carry it as raw JSON
+func decodeEventData(event entity.Event, v any) error {
+	raw, err := json.Marshal(event.Data)
+	if err != nil {
+		return fmt.Errorf("Marshal %s data: %w", event.Type, err)
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("Unmarshal %s data: %w", event.Type, err)
+	}
+
+	return nil
+}
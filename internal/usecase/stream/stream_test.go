@@ -0,0 +1,351 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mockUserRepo struct {
+	mock.Mock
+}
+
+func (m *mockUserRepo) CreateOrUpdateUser(ctx context.Context, user entity.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return entity.User{}, args.Error(1)
+	}
+	return args.Get(0).(entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
+	args := m.Called(ctx, userID, isActive)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string, excludeUserID string) ([]entity.User, error) {
+	args := m.Called(ctx, teamName, excludeUserID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
+}
+
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
+type mockEventBus struct {
+	mock.Mock
+}
+
+func (m *mockEventBus) Notify(ctx context.Context, event entity.StreamEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *mockEventBus) Listen(ctx context.Context, handle func(event entity.StreamEvent)) error {
+	args := m.Called(ctx, handle)
+	return args.Error(0)
+}
+
+func rawEvent(t *testing.T, eventType entity.EventType, data any) entity.Event {
+	t.Helper()
+
+	payload, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	return entity.Event{EventID: "evt-1", Type: eventType, OccurredAt: time.Now(), Data: json.RawMessage(payload)}
+}
+
+func TestPublish_ReviewerAssigned(t *testing.T) {
+	userRepo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	eventBus := new(mockEventBus)
+	uc := New(userRepo, prRepo, eventBus, 0)
+
+	event := rawEvent(t, entity.EventReviewerAssigned, entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"})
+
+	prRepo.On("GetPR", mock.Anything, "pr-1").Return(entity.PullRequest{PullRequestID: "pr-1", AuthorID: "u1"}, nil)
+	userRepo.On("GetUsers", mock.Anything, []string{"u1", "u2"}).Return([]entity.User{
+		{UserID: "u1", TeamName: "backend"},
+		{UserID: "u2", TeamName: "backend"},
+	}, nil)
+	eventBus.On("Notify", mock.Anything, entity.StreamEvent{
+		Event:     event,
+		UserIDs:   []string{"u1", "u2"},
+		TeamNames: []string{"backend"},
+	}).Return(nil)
+
+	err := uc.Publish(context.Background(), event)
+
+	assert.NoError(t, err)
+	eventBus.AssertExpectations(t)
+}
+
+func TestPublish_PRMerged(t *testing.T) {
+	userRepo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	eventBus := new(mockEventBus)
+	uc := New(userRepo, prRepo, eventBus, 0)
+
+	event := rawEvent(t, entity.EventPRMerged, entity.PullRequestEventData{PullRequest: entity.PullRequest{
+		PullRequestID:     "pr-1",
+		AuthorID:          "u1",
+		AssignedReviewers: []string{"u2", "u3"},
+	}})
+
+	userRepo.On("GetUsers", mock.Anything, []string{"u1", "u2", "u3"}).Return([]entity.User{
+		{UserID: "u1", TeamName: "backend"},
+		{UserID: "u2", TeamName: "backend"},
+		{UserID: "u3", TeamName: "frontend"},
+	}, nil)
+	eventBus.On("Notify", mock.Anything, entity.StreamEvent{
+		Event:     event,
+		UserIDs:   []string{"u1", "u2", "u3"},
+		TeamNames: []string{"backend", "frontend"},
+	}).Return(nil)
+
+	err := uc.Publish(context.Background(), event)
+
+	assert.NoError(t, err)
+	prRepo.AssertNotCalled(t, "GetPR", mock.Anything, mock.Anything)
+	eventBus.AssertExpectations(t)
+}
+
+func TestPublish_SkipsOtherEvents(t *testing.T) {
+	eventBus := new(mockEventBus)
+	uc := New(new(mockUserRepo), new(mockPRRepo), eventBus, 0)
+
+	err := uc.Publish(context.Background(), rawEvent(t, entity.EventPRCreated, entity.PullRequestEventData{}))
+
+	assert.NoError(t, err)
+	eventBus.AssertNotCalled(t, "Notify", mock.Anything, mock.Anything)
+}
+
+func TestSubscribe_FiltersEvents(t *testing.T) {
+	eventBus := new(mockEventBus)
+	uc := New(new(mockUserRepo), new(mockPRRepo), eventBus, 0)
+
+	teamEvents, unsubscribeTeam := uc.Subscribe(entity.StreamFilter{TeamName: "backend"})
+	defer unsubscribeTeam()
+	userEvents, unsubscribeUser := uc.Subscribe(entity.StreamFilter{UserID: "u3"})
+	defer unsubscribeUser()
+
+	backend := entity.StreamEvent{Event: entity.Event{EventID: "evt-1"}, UserIDs: []string{"u1", "u2"}, TeamNames: []string{"backend"}}
+	frontend := entity.StreamEvent{Event: entity.Event{EventID: "evt-2"}, UserIDs: []string{"u3"}, TeamNames: []string{"frontend"}}
+
+	eventBus.On("Listen", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		handle := args.Get(1).(func(event entity.StreamEvent))
+		handle(backend)
+		handle(frontend)
+	}).Return(nil)
+
+	err := uc.Listen(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, backend, <-teamEvents)
+	assert.Equal(t, frontend, <-userEvents)
+	assert.Empty(t, teamEvents)
+	assert.Empty(t, userEvents)
+}
+
+func TestSubscribe_DropsLaggingSubscriber(t *testing.T) {
+	uc := New(new(mockUserRepo), new(mockPRRepo), new(mockEventBus), 1)
+
+	events, unsubscribe := uc.Subscribe(entity.StreamFilter{})
+
+	uc.broadcast(entity.StreamEvent{Event: entity.Event{EventID: "evt-1"}})
+	uc.broadcast(entity.StreamEvent{Event: entity.Event{EventID: "evt-2"}})
+
+	event, ok := <-events
+	assert.True(t, ok)
+	assert.Equal(t, "evt-1", event.EventID)
+
+	_, ok = <-events
+	assert.False(t, ok, "a subscriber that fell behind must be closed")
+
+	// Unsubscribing after being dropped is harmless
+	unsubscribe()
+}
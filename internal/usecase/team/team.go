@@ -2,6 +2,7 @@ package team
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/finstape/pr-reviews/internal/entity"
@@ -51,3 +52,32 @@ func (uc *UseCase) GetTeam(ctx context.Context, teamName string) (entity.Team, e
 	return team, nil
 }
 
+// ListTeams retrieves all teams with member statistics
+func (uc *UseCase) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	teams, err := uc.teamRepo.ListTeams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("TeamUseCase - ListTeams - ListTeams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// SetChatWebhook sets the incoming-webhook URL of the team's chat channel; an empty URL disables chat notifications
+func (uc *UseCase) SetChatWebhook(ctx context.Context, teamName string, url string) error {
+	var webhookURL *string
+	if url != "" {
+		webhookURL = &url
+	}
+
+	err := uc.teamRepo.SetChatWebhookURL(ctx, teamName, webhookURL)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("TeamUseCase - SetChatWebhook - SetChatWebhookURL: %w", err)
+	}
+
+	return nil
+}
+
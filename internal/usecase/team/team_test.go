@@ -31,6 +31,34 @@ func (m *mockTeamRepo) TeamExists(ctx context.Context, teamName string) (bool, e
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *mockTeamRepo) ListTeams(ctx context.Context) ([]entity.TeamSummary, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.TeamSummary), args.Error(1)
+}
+
+func (m *mockTeamRepo) SetReviewSLA(ctx context.Context, teamName string, slaSeconds *int) error {
+	args := m.Called(ctx, teamName, slaSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetEscalationThreshold(ctx context.Context, teamName string, thresholdSeconds *int) error {
+	args := m.Called(ctx, teamName, thresholdSeconds)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) SetChatWebhookURL(ctx context.Context, teamName string, url *string) error {
+	args := m.Called(ctx, teamName, url)
+	return args.Error(0)
+}
+
+func (m *mockTeamRepo) GetChatWebhookURL(ctx context.Context, teamName string) (string, error) {
+	args := m.Called(ctx, teamName)
+	return args.String(0), args.Error(1)
+}
+
 func TestCreateTeam_Success(t *testing.T) {
 	repo := new(mockTeamRepo)
 	uc := New(repo)
@@ -93,3 +121,56 @@ func TestGetTeam_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestListTeams_Success(t *testing.T) {
+	repo := new(mockTeamRepo)
+	uc := New(repo)
+
+	ctx := context.Background()
+	expectedTeams := []entity.TeamSummary{
+		{TeamName: "backend", MemberCount: 3, ActiveCount: 2},
+		{TeamName: "frontend", MemberCount: 1, ActiveCount: 1},
+	}
+
+	repo.On("ListTeams", ctx).Return(expectedTeams, nil)
+
+	teams, err := uc.ListTeams(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedTeams, teams)
+	repo.AssertExpectations(t)
+}
+
+func TestSetChatWebhook(t *testing.T) {
+	url := "https://chat.example.com/hooks/abc"
+
+	tests := []struct {
+		name    string
+		url     string
+		stored  *string
+		repoErr error
+		wantErr error
+	}{
+		{name: "set", url: url, stored: &url},
+		{name: "empty url clears", url: "", stored: nil},
+		{name: "unknown team", url: url, stored: &url, repoErr: entity.ErrNotFound, wantErr: entity.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mockTeamRepo)
+			uc := New(repo)
+
+			ctx := context.Background()
+			repo.On("SetChatWebhookURL", ctx, "backend", tt.stored).Return(tt.repoErr)
+
+			err := uc.SetChatWebhook(ctx, "backend", tt.url)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
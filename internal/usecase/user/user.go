@@ -2,7 +2,9 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/finstape/pr-reviews/internal/repo"
@@ -11,12 +13,14 @@ import (
 // UseCase handles user business logic.
 type UseCase struct {
 	userRepo repo.UserRepo
+	prRepo   repo.PullRequestRepo
 }
 
 // New creates a new User use case instance.
-func New(userRepo repo.UserRepo) *UseCase {
+func New(userRepo repo.UserRepo, prRepo repo.PullRequestRepo) *UseCase {
 	return &UseCase{
 		userRepo: userRepo,
+		prRepo:   prRepo,
 	}
 }
 
@@ -40,19 +44,190 @@ func (uc *UseCase) SetIsActive(ctx context.Context, userID string, isActive bool
 	return user, nil
 }
 
-// GetUserReviews retrieves all PRs where user is a reviewer
-func (uc *UseCase) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
+// SetDigestSettings sets the user's digest email address and whether the daily digest is sent
+func (uc *UseCase) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) (entity.DigestSettings, error) {
+	err := uc.userRepo.SetDigestSettings(ctx, settings)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.DigestSettings{}, entity.ErrNotFound
+		}
+
+		return entity.DigestSettings{}, fmt.Errorf("UserUseCase - SetDigestSettings - SetDigestSettings: %w", err)
+	}
+
+	return settings, nil
+}
+
+// SetRole changes what the user may change, e.g. promotes a member to team lead
+func (uc *UseCase) SetRole(ctx context.Context, userID string, role entity.Role) error {
+	err := uc.userRepo.SetUserRole(ctx, userID, role)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("UserUseCase - SetRole - SetUserRole: %w", err)
+	}
+
+	return nil
+}
+
+// ListUsers retrieves a page of users matching the filter ordered by user_id
+func (uc *UseCase) ListUsers(ctx context.Context, filter entity.UserFilter, page entity.PageRequest) (entity.UserPage, error) {
+	scope := entity.NewPageScope("", filter)
+
+	after, err := entity.DecodeCursor(page.Cursor, scope)
+	if err != nil {
+		return entity.UserPage{}, err
+	}
+
+	limit := page.NormalizedLimit()
+
+	// Fetch one extra row to know whether there is a next page
+	users, err := uc.userRepo.ListUsers(ctx, filter, after, limit+1)
+	if err != nil {
+		return entity.UserPage{}, fmt.Errorf("UserUseCase - ListUsers - ListUsers: %w", err)
+	}
+
+	users, nextCursor := entity.TrimPage(users, limit, scope, entity.UserCursor)
+
+	return entity.UserPage{Users: users, NextCursor: nextCursor}, nil
+}
+
+// GetUserReviews retrieves a page of PRs where user is a reviewer
+func (uc *UseCase) GetUserReviews(ctx context.Context, userID string, query entity.ReviewQueueQuery) (entity.ReviewQueuePage, error) {
+	filter := entity.PullRequestFilter{
+		Status:     query.Status,
+		ReviewerID: userID,
+	}
+	scope := entity.NewPageScope(query.Page.NormalizedOrder(), filter)
+
+	after, err := entity.DecodeCursor(query.Page.Cursor, scope)
+	if err != nil {
+		return entity.ReviewQueuePage{}, err
+	}
+
+	// Verify user exists
+	_, err = uc.userRepo.GetUser(ctx, userID)
+	if err != nil {
+		return entity.ReviewQueuePage{}, fmt.Errorf("UserUseCase - GetUserReviews - GetUser: %w", err)
+	}
+
+	limit := query.Page.NormalizedLimit()
+
+	// Fetch one extra row to know whether there is a next page
+	prs, err := uc.prRepo.ListPRs(ctx, filter, query.Page.NormalizedOrder(), after, limit+1)
+	if err != nil {
+		return entity.ReviewQueuePage{}, fmt.Errorf("UserUseCase - GetUserReviews - ListPRs: %w", err)
+	}
+
+	prs, nextCursor := entity.TrimPage(prs, limit, scope, entity.PullRequestCursor)
+	result := entity.ReviewQueuePage{NextCursor: nextCursor}
+
+	now := time.Now()
+	result.PullRequests = make([]entity.ReviewQueueItem, 0, len(prs))
+	for _, pr := range prs {
+		item := entity.ReviewQueueItem{
+			PullRequestShort: entity.PullRequestShort{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				Status:          pr.Status,
+			},
+			CreatedAt: pr.CreatedAt,
+		}
+
+		if query.IncludeReviewers {
+			item.AssignedReviewers = pr.AssignedReviewers
+		}
+
+		if query.IncludeAge && pr.CreatedAt != nil {
+			age := int64(now.Sub(*pr.CreatedAt).Seconds())
+			item.AgeSeconds = &age
+		}
+
+		result.PullRequests = append(result.PullRequests, item)
+	}
+
+	return result, nil
+}
+
+// GetAuthoredPRs retrieves a page of PRs authored by user with their current reviewers
+func (uc *UseCase) GetAuthoredPRs(ctx context.Context, userID string, query entity.AuthoredQuery) (entity.AuthoredPage, error) {
+	filter := entity.PullRequestFilter{
+		Status:   query.Status,
+		AuthorID: userID,
+	}
+	scope := entity.NewPageScope(query.Page.NormalizedOrder(), filter)
+
+	after, err := entity.DecodeCursor(query.Page.Cursor, scope)
+	if err != nil {
+		return entity.AuthoredPage{}, err
+	}
+
 	// Verify user exists
-	_, err := uc.userRepo.GetUser(ctx, userID)
+	_, err = uc.userRepo.GetUser(ctx, userID)
 	if err != nil {
-		return nil, fmt.Errorf("UserUseCase - GetUserReviews - GetUser: %w", err)
+		return entity.AuthoredPage{}, fmt.Errorf("UserUseCase - GetAuthoredPRs - GetUser: %w", err)
 	}
 
-	prs, err := uc.userRepo.GetUserReviews(ctx, userID)
+	limit := query.Page.NormalizedLimit()
+
+	// Fetch one extra row to know whether there is a next page
+	prs, err := uc.prRepo.ListPRs(ctx, filter, query.Page.NormalizedOrder(), after, limit+1)
 	if err != nil {
-		return nil, fmt.Errorf("UserUseCase - GetUserReviews - GetUserReviews: %w", err)
+		return entity.AuthoredPage{}, fmt.Errorf("UserUseCase - GetAuthoredPRs - ListPRs: %w", err)
+	}
+
+	prs, nextCursor := entity.TrimPage(prs, limit, scope, entity.PullRequestCursor)
+
+	prIDs := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		prIDs = append(prIDs, pr.PullRequestID)
 	}
 
-	return prs, nil
+	assignments, err := uc.prRepo.GetReviewerAssignments(ctx, prIDs)
+	if err != nil {
+		return entity.AuthoredPage{}, fmt.Errorf("UserUseCase - GetAuthoredPRs - GetReviewerAssignments: %w", err)
+	}
+
+	result := entity.AuthoredPage{
+		PullRequests: make([]entity.AuthoredPullRequest, 0, len(prs)),
+		NextCursor:   nextCursor,
+	}
+	for _, pr := range prs {
+		reviewers := assignments[pr.PullRequestID]
+		if reviewers == nil {
+			reviewers = []entity.ReviewerAssignment{}
+		}
+
+		result.PullRequests = append(result.PullRequests, entity.AuthoredPullRequest{
+			PullRequestShort: entity.PullRequestShort{
+				PullRequestID:   pr.PullRequestID,
+				PullRequestName: pr.PullRequestName,
+				AuthorID:        pr.AuthorID,
+				Status:          pr.Status,
+			},
+			CreatedAt:    pr.CreatedAt,
+			MergedAt:     pr.MergedAt,
+			ReviewStatus: reviewStatus(pr.Status, len(reviewers)),
+			Reviewers:    reviewers,
+		})
+	}
+
+	return result, nil
 }
 
+// reviewStatus derives the review progress of a PR from its status and number of assigned reviewers
+func reviewStatus(status entity.PullRequestStatus, reviewerCount int) entity.ReviewStatus {
+	switch {
+	case status == entity.PullRequestStatusMerged:
+		return entity.ReviewStatusMerged
+	case status == entity.PullRequestStatusClosed:
+		return entity.ReviewStatusClosed
+	case reviewerCount == 0:
+		return entity.ReviewStatusUnassigned
+	default:
+		return entity.ReviewStatusPending
+	}
+}
@@ -50,7 +50,7 @@ func TestSetIsActive_TableDriven(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			repo := new(mockUserRepo)
-			uc := New(repo)
+			uc := New(repo, new(mockPRRepo))
 			ctx := context.Background()
 
 			if tt.userExists {
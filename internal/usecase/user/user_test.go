@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/finstape/pr-reviews/internal/entity"
 	"github.com/stretchr/testify/assert"
@@ -26,6 +27,32 @@ func (m *mockUserRepo) GetUser(ctx context.Context, userID string) (entity.User,
 	return args.Get(0).(entity.User), args.Error(1)
 }
 
+func (m *mockUserRepo) GetUserRole(ctx context.Context, userID string) (entity.Role, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(entity.Role), args.Error(1)
+}
+
+func (m *mockUserRepo) SetUserRole(ctx context.Context, userID string, role entity.Role) error {
+	args := m.Called(ctx, userID, role)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetUsers(ctx context.Context, userIDs []string) ([]entity.User, error) {
+	args := m.Called(ctx, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) GetUsersByTeams(ctx context.Context, teamNames []string) ([]entity.User, error) {
+	args := m.Called(ctx, teamNames)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
 func (m *mockUserRepo) SetIsActive(ctx context.Context, userID string, isActive bool) error {
 	args := m.Called(ctx, userID, isActive)
 	return args.Error(0)
@@ -39,17 +66,157 @@ func (m *mockUserRepo) GetActiveTeamMembers(ctx context.Context, teamName string
 	return args.Get(0).([]entity.User), args.Error(1)
 }
 
-func (m *mockUserRepo) GetUserReviews(ctx context.Context, userID string) ([]entity.PullRequestShort, error) {
-	args := m.Called(ctx, userID)
+func (m *mockUserRepo) ListUsers(ctx context.Context, filter entity.UserFilter, after *entity.Cursor, limit int) ([]entity.User, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.User), args.Error(1)
+}
+
+func (m *mockUserRepo) SetDigestSettings(ctx context.Context, settings entity.DigestSettings) error {
+	args := m.Called(ctx, settings)
+	return args.Error(0)
+}
+
+func (m *mockUserRepo) GetDigestRecipients(ctx context.Context, day time.Time, limit int) ([]entity.DigestRecipient, error) {
+	args := m.Called(ctx, day, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.DigestRecipient), args.Error(1)
+}
+
+func (m *mockUserRepo) MarkDigestSent(ctx context.Context, userID string, day time.Time) error {
+	args := m.Called(ctx, userID, day)
+	return args.Error(0)
+}
+
+type mockPRRepo struct {
+	mock.Mock
+}
+
+func (m *mockPRRepo) CreatePR(ctx context.Context, pr entity.PullRequest, reviewerIDs []string) error {
+	args := m.Called(ctx, pr, reviewerIDs)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPR(ctx context.Context, prID string) (entity.PullRequest, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequest{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRRecord(ctx context.Context, prID string) (entity.PullRequestRecord, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return entity.PullRequestRecord{}, args.Error(1)
+	}
+	return args.Get(0).(entity.PullRequestRecord), args.Error(1)
+}
+
+func (m *mockPRRepo) PRExists(ctx context.Context, prID string) (bool, error) {
+	args := m.Called(ctx, prID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockPRRepo) UpdatePRStatus(ctx context.Context, prID string, status entity.PullRequestStatus, mergedAt *entity.Time) error {
+	args := m.Called(ctx, prID, status, mergedAt)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRReviewers(ctx context.Context, prID string) ([]string, error) {
+	args := m.Called(ctx, prID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *mockPRRepo) ReassignReviewer(ctx context.Context, prID string, oldReviewerID string, newReviewerID string, reason entity.ReassignmentReason) error {
+	args := m.Called(ctx, prID, oldReviewerID, newReviewerID, reason)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetPRsByReviewer(ctx context.Context, reviewerID string) ([]entity.PullRequestShort, error) {
+	args := m.Called(ctx, reviewerID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
 	return args.Get(0).([]entity.PullRequestShort), args.Error(1)
 }
 
+func (m *mockPRRepo) ListPRs(ctx context.Context, filter entity.PullRequestFilter, order entity.SortOrder, after *entity.Cursor, limit int) ([]entity.PullRequest, error) {
+	args := m.Called(ctx, filter, order, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetReviewerAssignments(ctx context.Context, prIDs []string) (map[string][]entity.ReviewerAssignment, error) {
+	args := m.Called(ctx, prIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.ReviewerAssignment), args.Error(1)
+}
+
+func (m *mockPRRepo) GetPRsByReviewers(ctx context.Context, reviewerIDs []string, status entity.PullRequestStatus) (map[string][]entity.PullRequest, error) {
+	args := m.Called(ctx, reviewerIDs, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string][]entity.PullRequest), args.Error(1)
+}
+
+func (m *mockPRRepo) GetOverdueReviews(ctx context.Context, defaultSLA time.Duration, teamName string) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, teamName)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) CountOverdueReviews(ctx context.Context, defaultSLA time.Duration) ([]entity.OverdueCount, error) {
+	args := m.Called(ctx, defaultSLA)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueCount), args.Error(1)
+}
+
+func (m *mockPRRepo) GetStaleReviews(ctx context.Context, defaultThreshold time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultThreshold, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) RecordReview(ctx context.Context, prID string, reviewerID string, outcome entity.ReviewOutcome) error {
+	args := m.Called(ctx, prID, reviewerID, outcome)
+	return args.Error(0)
+}
+
+func (m *mockPRRepo) GetUnnotifiedOverdueReviews(ctx context.Context, defaultSLA time.Duration, limit int) ([]entity.OverdueReview, error) {
+	args := m.Called(ctx, defaultSLA, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.OverdueReview), args.Error(1)
+}
+
+func (m *mockPRRepo) MarkOverdueNotified(ctx context.Context, prID string, reviewerID string) error {
+	args := m.Called(ctx, prID, reviewerID)
+	return args.Error(0)
+}
+
 func TestSetIsActive_Success(t *testing.T) {
 	repo := new(mockUserRepo)
-	uc := New(repo)
+	uc := New(repo, new(mockPRRepo))
 
 	ctx := context.Background()
 	userID := "u1"
@@ -73,7 +240,8 @@ func TestSetIsActive_Success(t *testing.T) {
 
 func TestGetUserReviews_Success(t *testing.T) {
 	repo := new(mockUserRepo)
-	uc := New(repo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
 
 	ctx := context.Background()
 	userID := "u1"
@@ -84,43 +252,153 @@ func TestGetUserReviews_Success(t *testing.T) {
 		IsActive: true,
 	}
 
-	expectedPRs := []entity.PullRequestShort{
+	createdAt := time.Now().Add(-time.Hour)
+	prs := []entity.PullRequest{
 		{
-			PullRequestID:   "pr-1",
-			PullRequestName: "Test PR",
-			AuthorID:        "u2",
-			Status:          entity.PullRequestStatusOpen,
+			PullRequestID:     "pr-1",
+			PullRequestName:   "Test PR",
+			AuthorID:          "u2",
+			Status:            entity.PullRequestStatusOpen,
+			AssignedReviewers: []string{"u1", "u3"},
+			CreatedAt:         &createdAt,
 		},
 	}
 
+	filter := entity.PullRequestFilter{Status: entity.PullRequestStatusOpen, ReviewerID: userID}
+
 	repo.On("GetUser", ctx, userID).Return(user, nil)
-	repo.On("GetUserReviews", ctx, userID).Return(expectedPRs, nil)
+	prRepo.On("ListPRs", ctx, filter, entity.SortOrderDesc, (*entity.Cursor)(nil), entity.DefaultPageLimit+1).Return(prs, nil)
 
-	prs, err := uc.GetUserReviews(ctx, userID)
+	page, err := uc.GetUserReviews(ctx, userID, entity.ReviewQueueQuery{Status: entity.PullRequestStatusOpen})
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedPRs, prs)
+	assert.Len(t, page.PullRequests, 1)
+	assert.Equal(t, "pr-1", page.PullRequests[0].PullRequestID)
+	assert.Nil(t, page.PullRequests[0].AssignedReviewers)
+	assert.Nil(t, page.PullRequests[0].AgeSeconds)
+	assert.Empty(t, page.NextCursor)
 	repo.AssertExpectations(t)
+	prRepo.AssertExpectations(t)
+}
+
+func TestGetUserReviews_IncludeAndPaginate(t *testing.T) {
+	repo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
+
+	ctx := context.Background()
+	userID := "u1"
+
+	older := time.Now().Add(-2 * time.Hour)
+	newer := time.Now().Add(-time.Hour)
+	prs := []entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "u2", Status: entity.PullRequestStatusMerged, AssignedReviewers: []string{"u1"}, CreatedAt: &older},
+		{PullRequestID: "pr-2", AuthorID: "u2", Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u1"}, CreatedAt: &newer},
+	}
+
+	filter := entity.PullRequestFilter{ReviewerID: userID}
+
+	repo.On("GetUser", ctx, userID).Return(entity.User{UserID: userID}, nil)
+	prRepo.On("ListPRs", ctx, filter, entity.SortOrderAsc, (*entity.Cursor)(nil), 2).Return(prs, nil)
+
+	page, err := uc.GetUserReviews(ctx, userID, entity.ReviewQueueQuery{
+		IncludeReviewers: true,
+		IncludeAge:       true,
+		Page:             entity.PageRequest{Limit: 1, Order: entity.SortOrderAsc},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 1)
+	assert.Equal(t, []string{"u1"}, page.PullRequests[0].AssignedReviewers)
+	assert.NotNil(t, page.PullRequests[0].AgeSeconds)
+	assert.GreaterOrEqual(t, *page.PullRequests[0].AgeSeconds, int64(2*time.Hour/time.Second))
+	assert.NotEmpty(t, page.NextCursor)
+	prRepo.AssertExpectations(t)
 }
 
 func TestGetUserReviews_UserNotFound(t *testing.T) {
 	repo := new(mockUserRepo)
-	uc := New(repo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
 
 	ctx := context.Background()
 	userID := "u99"
 
 	repo.On("GetUser", ctx, userID).Return(entity.User{}, entity.ErrNotFound)
 
-	_, err := uc.GetUserReviews(ctx, userID)
+	_, err := uc.GetUserReviews(ctx, userID, entity.ReviewQueueQuery{})
 
 	assert.Error(t, err)
 	repo.AssertExpectations(t)
+	prRepo.AssertNotCalled(t, "ListPRs")
+}
+
+func TestGetUserReviews_InvalidCursor(t *testing.T) {
+	repo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
+
+	_, err := uc.GetUserReviews(context.Background(), "u1", entity.ReviewQueueQuery{Page: entity.PageRequest{Cursor: "%%%"}})
+
+	assert.Equal(t, entity.ErrInvalidCursor, err)
+	repo.AssertNotCalled(t, "GetUser")
+}
+
+func TestGetUserReviews_CursorOfAnotherListing(t *testing.T) {
+	repo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
+
+	ctx := context.Background()
+	createdAt := time.Now()
+	prs := []entity.PullRequest{
+		{PullRequestID: "pr-1", AuthorID: "u2", Status: entity.PullRequestStatusOpen, CreatedAt: &createdAt},
+		{PullRequestID: "pr-2", AuthorID: "u2", Status: entity.PullRequestStatusOpen, CreatedAt: &createdAt},
+	}
+
+	repo.On("GetUser", ctx, "u1").Return(entity.User{UserID: "u1"}, nil)
+	prRepo.On("ListPRs", ctx, entity.PullRequestFilter{Status: entity.PullRequestStatusOpen, ReviewerID: "u1"}, entity.SortOrderAsc, (*entity.Cursor)(nil), 2).Return(prs, nil)
+
+	page, err := uc.GetUserReviews(ctx, "u1", entity.ReviewQueueQuery{
+		Status: entity.PullRequestStatusOpen,
+		Page:   entity.PageRequest{Limit: 1, Order: entity.SortOrderAsc},
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, page.NextCursor)
+
+	tests := []struct {
+		name   string
+		userID string
+		query  entity.ReviewQueueQuery
+	}{
+		{name: "another order", userID: "u1", query: entity.ReviewQueueQuery{
+			Status: entity.PullRequestStatusOpen,
+			Page:   entity.PageRequest{Limit: 1, Order: entity.SortOrderDesc, Cursor: page.NextCursor},
+		}},
+		{name: "another status", userID: "u1", query: entity.ReviewQueueQuery{
+			Status: entity.PullRequestStatusMerged,
+			Page:   entity.PageRequest{Limit: 1, Order: entity.SortOrderAsc, Cursor: page.NextCursor},
+		}},
+		{name: "another reviewer", userID: "u3", query: entity.ReviewQueueQuery{
+			Status: entity.PullRequestStatusOpen,
+			Page:   entity.PageRequest{Limit: 1, Order: entity.SortOrderAsc, Cursor: page.NextCursor},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.GetUserReviews(ctx, tt.userID, tt.query)
+
+			assert.Equal(t, entity.ErrInvalidCursor, err)
+		})
+	}
+
+	prRepo.AssertNumberOfCalls(t, "ListPRs", 1)
 }
 
 func TestSetIsActive_UserNotFound(t *testing.T) {
 	repo := new(mockUserRepo)
-	uc := New(repo)
+	uc := New(repo, new(mockPRRepo))
 
 	ctx := context.Background()
 	userID := "u99"
@@ -133,3 +411,162 @@ func TestSetIsActive_UserNotFound(t *testing.T) {
 	repo.AssertExpectations(t)
 }
 
+func TestGetAuthoredPRs_Success(t *testing.T) {
+	repo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
+
+	ctx := context.Background()
+	userID := "u1"
+
+	createdAt := time.Now().Add(-time.Hour)
+	mergedAt := time.Now()
+	prs := []entity.PullRequest{
+		{PullRequestID: "pr-3", AuthorID: userID, Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{"u2"}, CreatedAt: &createdAt},
+		{PullRequestID: "pr-2", AuthorID: userID, Status: entity.PullRequestStatusOpen, AssignedReviewers: []string{}, CreatedAt: &createdAt},
+		{PullRequestID: "pr-1", AuthorID: userID, Status: entity.PullRequestStatusMerged, AssignedReviewers: []string{"u3"}, CreatedAt: &createdAt, MergedAt: &mergedAt},
+	}
+
+	assignedAt := createdAt.Add(time.Minute)
+	assignments := map[string][]entity.ReviewerAssignment{
+		"pr-3": {{UserID: "u2", Username: "Bob", IsActive: false, AssignedAt: &assignedAt}},
+		"pr-1": {{UserID: "u3", Username: "Carol", IsActive: true, AssignedAt: &assignedAt}},
+	}
+
+	repo.On("GetUser", ctx, userID).Return(entity.User{UserID: userID}, nil)
+	prRepo.On("ListPRs", ctx, entity.PullRequestFilter{AuthorID: userID}, entity.SortOrderDesc, (*entity.Cursor)(nil), entity.DefaultPageLimit+1).Return(prs, nil)
+	prRepo.On("GetReviewerAssignments", ctx, []string{"pr-3", "pr-2", "pr-1"}).Return(assignments, nil)
+
+	page, err := uc.GetAuthoredPRs(ctx, userID, entity.AuthoredQuery{})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.PullRequests, 3)
+	assert.Empty(t, page.NextCursor)
+
+	assert.Equal(t, entity.ReviewStatusPending, page.PullRequests[0].ReviewStatus)
+	assert.Equal(t, assignments["pr-3"], page.PullRequests[0].Reviewers)
+	assert.Equal(t, entity.ReviewStatusUnassigned, page.PullRequests[1].ReviewStatus)
+	assert.Equal(t, []entity.ReviewerAssignment{}, page.PullRequests[1].Reviewers)
+	assert.Equal(t, entity.ReviewStatusMerged, page.PullRequests[2].ReviewStatus)
+	assert.Equal(t, &mergedAt, page.PullRequests[2].MergedAt)
+
+	repo.AssertExpectations(t)
+	prRepo.AssertExpectations(t)
+}
+
+func TestGetAuthoredPRs_UserNotFound(t *testing.T) {
+	repo := new(mockUserRepo)
+	prRepo := new(mockPRRepo)
+	uc := New(repo, prRepo)
+
+	ctx := context.Background()
+
+	repo.On("GetUser", ctx, "u99").Return(entity.User{}, entity.ErrNotFound)
+
+	_, err := uc.GetAuthoredPRs(ctx, "u99", entity.AuthoredQuery{})
+
+	assert.ErrorIs(t, err, entity.ErrNotFound)
+	prRepo.AssertNotCalled(t, "ListPRs")
+}
+
+func TestListUsers_Paginate(t *testing.T) {
+	repo := new(mockUserRepo)
+	uc := New(repo, new(mockPRRepo))
+
+	ctx := context.Background()
+	isActive := true
+	filter := entity.UserFilter{TeamName: "backend", IsActive: &isActive}
+	users := []entity.User{
+		{UserID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+		{UserID: "u2", Username: "Bob", TeamName: "backend", IsActive: true},
+		{UserID: "u3", Username: "Carol", TeamName: "backend", IsActive: true},
+	}
+
+	repo.On("ListUsers", ctx, filter, (*entity.Cursor)(nil), 3).Return(users, nil).Once()
+
+	page, err := uc.ListUsers(ctx, filter, entity.PageRequest{Limit: 2})
+
+	assert.NoError(t, err)
+	assert.Equal(t, users[:2], page.Users)
+	assert.NotEmpty(t, page.NextCursor)
+
+	repo.On("ListUsers", ctx, filter, &entity.Cursor{ID: "u2", PageScope: entity.NewPageScope("", filter)}, 3).Return(users[2:], nil).Once()
+
+	page, err = uc.ListUsers(ctx, filter, entity.PageRequest{Limit: 2, Cursor: page.NextCursor})
+
+	assert.NoError(t, err)
+	assert.Equal(t, users[2:], page.Users)
+	assert.Empty(t, page.NextCursor)
+	repo.AssertExpectations(t)
+}
+
+func TestReviewStatus(t *testing.T) {
+	assert.Equal(t, entity.ReviewStatusMerged, reviewStatus(entity.PullRequestStatusMerged, 1))
+	assert.Equal(t, entity.ReviewStatusClosed, reviewStatus(entity.PullRequestStatusClosed, 2))
+	assert.Equal(t, entity.ReviewStatusUnassigned, reviewStatus(entity.PullRequestStatusOpen, 0))
+	assert.Equal(t, entity.ReviewStatusPending, reviewStatus(entity.PullRequestStatusOpen, 1))
+}
+
+func TestSetDigestSettings(t *testing.T) {
+	settings := entity.DigestSettings{UserID: "u1", Email: "alice@example.com", DigestEnabled: true}
+
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "success"},
+		{name: "user not found", repoErr: entity.ErrNotFound, wantErr: entity.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mockUserRepo)
+			uc := New(repo, new(mockPRRepo))
+
+			ctx := context.Background()
+			repo.On("SetDigestSettings", ctx, settings).Return(tt.repoErr)
+
+			result, err := uc.SetDigestSettings(ctx, settings)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, settings, result)
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSetRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{name: "success"},
+		{name: "user not found", repoErr: entity.ErrNotFound, wantErr: entity.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(mockUserRepo)
+			uc := New(repo, new(mockPRRepo))
+
+			ctx := context.Background()
+			repo.On("SetUserRole", ctx, "u1", entity.RoleTeamLead).Return(tt.repoErr)
+
+			err := uc.SetRole(ctx, "u1", entity.RoleTeamLead)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			repo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+)
+
+const (
+	// _claimLease keeps a claimed delivery away from other instances while it is being sent.
+	_claimLease = time.Minute
+
+	_defaultBatchSize = 50
+	_secretBytes      = 32
+	_idBytes          = 8
+)
+
+// RetryPolicy describes how failed deliveries are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts after which a delivery is marked FAILED
+	MaxAttempts int
+	// BaseBackoff is the delay before the first retry; each further retry doubles it
+	BaseBackoff time.Duration
+	// MaxBackoff caps the delay between retries
+	MaxBackoff time.Duration
+}
+
+// Backoff returns the delay before the retry that follows the given attempt number (starting at 1).
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	delay := p.BaseBackoff
+	for i := 1; i < attempt && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return delay
+}
+
+// UseCase handles webhook subscriptions and delivery of events to them.
+type UseCase struct {
+	webhookRepo repo.WebhookRepo
+	sender      repo.WebhookSender
+	retry       RetryPolicy
+	batchSize   int
+}
+
+// New creates a new Webhook use case instance.
+func New(webhookRepo repo.WebhookRepo, sender repo.WebhookSender, retry RetryPolicy, batchSize int) *UseCase {
+	if batchSize <= 0 {
+		batchSize = _defaultBatchSize
+	}
+
+	return &UseCase{
+		webhookRepo: webhookRepo,
+		sender:      sender,
+		retry:       retry,
+		batchSize:   batchSize,
+	}
+}
+
+// CreateWebhook registers a subscriber URL and returns it together with its generated signing secret
+func (uc *UseCase) CreateWebhook(ctx context.Context, url string, eventTypes []entity.EventType) (entity.Webhook, error) {
+	webhookID, err := randomHex(_idBytes)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookUseCase - CreateWebhook - randomHex id: %w", err)
+	}
+
+	secret, err := randomHex(_secretBytes)
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookUseCase - CreateWebhook - randomHex secret: %w", err)
+	}
+
+	if eventTypes == nil {
+		eventTypes = []entity.EventType{}
+	}
+
+	webhook, err := uc.webhookRepo.CreateWebhook(ctx, entity.Webhook{
+		WebhookID:  "wh-" + webhookID,
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		IsActive:   true,
+	})
+	if err != nil {
+		return entity.Webhook{}, fmt.Errorf("WebhookUseCase - CreateWebhook - CreateWebhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// ListWebhooks retrieves all webhook subscriptions; secrets are not included
+func (uc *UseCase) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	webhooks, err := uc.webhookRepo.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - ListWebhooks - ListWebhooks: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+// DeleteWebhook removes a webhook subscription and its delivery history
+func (uc *UseCase) DeleteWebhook(ctx context.Context, webhookID string) error {
+	err := uc.webhookRepo.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		if errors.Is(err, entity.ErrNotFound) {
+			return entity.ErrNotFound
+		}
+
+		return fmt.Errorf("WebhookUseCase - DeleteWebhook - DeleteWebhook: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeliveries retrieves the latest deliveries of a webhook, optionally filtered by status
+func (uc *UseCase) ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	limit = entity.PageRequest{Limit: limit}.NormalizedLimit()
+
+	deliveries, err := uc.webhookRepo.ListDeliveries(ctx, webhookID, status, limit)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookUseCase - ListDeliveries - ListDeliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// Publish queues the event for every active webhook subscribed to its type.
+// A missing event id and occurrence time are filled in.
+func (uc *UseCase) Publish(ctx context.Context, event entity.Event) error {
+	if event.EventID == "" {
+		eventID, err := randomHex(_idBytes * 2)
+		if err != nil {
+			return fmt.Errorf("WebhookUseCase - Publish - randomHex: %w", err)
+		}
+
+		event.EventID = "evt-" + eventID
+	}
+
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("WebhookUseCase - Publish - Marshal: %w", err)
+	}
+
+	_, err = uc.webhookRepo.EnqueueDeliveries(ctx, event.EventID, event.Type, payload)
+	if err != nil {
+		return fmt.Errorf("WebhookUseCase - Publish - EnqueueDeliveries: %w", err)
+	}
+
+	return nil
+}
+
+// DeliverPending sends one batch of due deliveries, rescheduling failures with exponential backoff
+// until the retry policy gives up on them
+func (uc *UseCase) DeliverPending(ctx context.Context) (entity.DeliveryResult, error) {
+	var result entity.DeliveryResult
+
+	tasks, err := uc.webhookRepo.ClaimDueDeliveries(ctx, uc.batchSize, _claimLease)
+	if err != nil {
+		return result, fmt.Errorf("WebhookUseCase - DeliverPending - ClaimDueDeliveries: %w", err)
+	}
+
+	var errs []error
+	for _, task := range tasks {
+		delivery, retryIn := uc.attempt(ctx, task)
+
+		switch delivery.Status {
+		case entity.DeliveryStatusSucceeded:
+			result.Succeeded++
+		case entity.DeliveryStatusFailed:
+			result.Failed++
+		default:
+			result.Retrying++
+		}
+
+		if err := uc.webhookRepo.UpdateDelivery(ctx, delivery, retryIn); err != nil {
+			errs = append(errs, fmt.Errorf("WebhookUseCase - DeliverPending - UpdateDelivery %d: %w", task.DeliveryID, err))
+		}
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// attempt sends a single delivery and returns its new state together with the delay before the next attempt
+func (uc *UseCase) attempt(ctx context.Context, task entity.WebhookDeliveryTask) (entity.WebhookDelivery, time.Duration) {
+	delivery := task.WebhookDelivery
+	delivery.Attempts++
+	delivery.LastError = nil
+	delivery.LastStatusCode = nil
+
+	statusCode, err := uc.sender.Send(ctx, entity.WebhookRequest{
+		URL:        task.URL,
+		Secret:     task.Secret,
+		EventType:  task.EventType,
+		DeliveryID: task.DeliveryID,
+		Payload:    task.Payload,
+	})
+
+	if statusCode != 0 {
+		delivery.LastStatusCode = &statusCode
+	}
+
+	if err == nil {
+		delivery.Status = entity.DeliveryStatusSucceeded
+
+		return delivery, 0
+	}
+
+	message := err.Error()
+	delivery.LastError = &message
+
+	if uc.retry.MaxAttempts > 0 && delivery.Attempts >= uc.retry.MaxAttempts {
+		delivery.Status = entity.DeliveryStatusFailed
+
+		return delivery, 0
+	}
+
+	delivery.Status = entity.DeliveryStatusPending
+
+	return delivery, uc.retry.Backoff(delivery.Attempts)
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,229 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/finstape/pr-reviews/internal/entity"
+	"github.com/finstape/pr-reviews/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type mockWebhookRepo struct {
+	mock.Mock
+}
+
+func (m *mockWebhookRepo) CreateWebhook(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	args := m.Called(ctx, webhook)
+	return args.Get(0).(entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepo) ListWebhooks(ctx context.Context) ([]entity.Webhook, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.Webhook), args.Error(1)
+}
+
+func (m *mockWebhookRepo) DeleteWebhook(ctx context.Context, webhookID string) error {
+	args := m.Called(ctx, webhookID)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepo) EnqueueDeliveries(ctx context.Context, eventID string, eventType entity.EventType, payload []byte) (int64, error) {
+	args := m.Called(ctx, eventID, eventType, payload)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockWebhookRepo) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]entity.WebhookDeliveryTask, error) {
+	args := m.Called(ctx, limit, lease)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.WebhookDeliveryTask), args.Error(1)
+}
+
+func (m *mockWebhookRepo) UpdateDelivery(ctx context.Context, delivery entity.WebhookDelivery, retryIn time.Duration) error {
+	args := m.Called(ctx, delivery, retryIn)
+	return args.Error(0)
+}
+
+func (m *mockWebhookRepo) ListDeliveries(ctx context.Context, webhookID string, status entity.DeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]entity.WebhookDelivery), args.Error(1)
+}
+
+var _ repo.WebhookRepo = (*mockWebhookRepo)(nil)
+
+type mockWebhookSender struct {
+	mock.Mock
+}
+
+func (m *mockWebhookSender) Send(ctx context.Context, request entity.WebhookRequest) (int, error) {
+	args := m.Called(ctx, request)
+	return args.Int(0), args.Error(1)
+}
+
+var _ repo.WebhookSender = (*mockWebhookSender)(nil)
+
+var testRetry = RetryPolicy{MaxAttempts: 3, BaseBackoff: 10 * time.Second, MaxBackoff: time.Minute}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		expected time.Duration
+	}{
+		{attempt: 1, expected: 10 * time.Second},
+		{attempt: 2, expected: 20 * time.Second},
+		{attempt: 3, expected: 40 * time.Second},
+		{attempt: 4, expected: time.Minute},
+		{attempt: 30, expected: time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, testRetry.Backoff(tt.attempt), "attempt %d", tt.attempt)
+	}
+}
+
+func TestCreateWebhook_GeneratesIDAndSecret(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	uc := New(webhookRepo, new(mockWebhookSender), testRetry, 10)
+
+	ctx := context.Background()
+
+	webhookRepo.On("CreateWebhook", ctx, mock.MatchedBy(func(w entity.Webhook) bool {
+		return w.URL == "http://example.com/hook" && w.IsActive && len(w.Secret) == 64 && len(w.WebhookID) > 3 &&
+			len(w.EventTypes) == 1 && w.EventTypes[0] == entity.EventPRMerged
+	})).Return(entity.Webhook{WebhookID: "wh-1", Secret: "secret"}, nil)
+
+	webhook, err := uc.CreateWebhook(ctx, "http://example.com/hook", []entity.EventType{entity.EventPRMerged})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "wh-1", webhook.WebhookID)
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestDeleteWebhook_NotFound(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	uc := New(webhookRepo, new(mockWebhookSender), testRetry, 10)
+
+	ctx := context.Background()
+
+	webhookRepo.On("DeleteWebhook", ctx, "wh-404").Return(entity.ErrNotFound)
+
+	err := uc.DeleteWebhook(ctx, "wh-404")
+
+	assert.Equal(t, entity.ErrNotFound, err)
+}
+
+func TestListDeliveries_NormalizesLimit(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	uc := New(webhookRepo, new(mockWebhookSender), testRetry, 10)
+
+	ctx := context.Background()
+
+	webhookRepo.On("ListDeliveries", ctx, "wh-1", entity.DeliveryStatusFailed, entity.DefaultPageLimit).Return([]entity.WebhookDelivery{}, nil)
+
+	_, err := uc.ListDeliveries(ctx, "wh-1", entity.DeliveryStatusFailed, 0)
+
+	assert.NoError(t, err)
+	webhookRepo.AssertExpectations(t)
+}
+
+func TestPublish_EnqueuesSerializedEvent(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	uc := New(webhookRepo, new(mockWebhookSender), testRetry, 10)
+
+	ctx := context.Background()
+	event := entity.Event{
+		Type: entity.EventReviewerAssigned,
+		Data: entity.ReviewerAssignedEventData{PullRequestID: "pr-1", ReviewerID: "u2"},
+	}
+
+	var payload []byte
+	webhookRepo.On("EnqueueDeliveries", ctx, mock.AnythingOfType("string"), entity.EventReviewerAssigned, mock.Anything).
+		Run(func(args mock.Arguments) { payload = args.Get(3).([]byte) }).
+		Return(int64(2), nil)
+
+	err := uc.Publish(ctx, event)
+	assert.NoError(t, err)
+
+	var body map[string]interface{}
+	assert.NoError(t, json.Unmarshal(payload, &body))
+	assert.Equal(t, "reviewer.assigned", body["type"])
+	assert.NotEmpty(t, body["event_id"])
+	assert.NotEmpty(t, body["occurred_at"])
+	assert.Equal(t, map[string]interface{}{"pull_request_id": "pr-1", "reviewer_id": "u2"}, body["data"])
+}
+
+func TestDeliverPending(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	sender := new(mockWebhookSender)
+	uc := New(webhookRepo, sender, testRetry, 10)
+
+	ctx := context.Background()
+	task := func(id int64, attempts int) entity.WebhookDeliveryTask {
+		return entity.WebhookDeliveryTask{
+			WebhookDelivery: entity.WebhookDelivery{DeliveryID: id, EventType: entity.EventPRCreated, Status: entity.DeliveryStatusPending, Attempts: attempts},
+			URL:             "http://example.com/hook",
+			Secret:          "secret",
+			Payload:         []byte(`{}`),
+		}
+	}
+	sent := func(id int64) interface{} {
+		return mock.MatchedBy(func(r entity.WebhookRequest) bool { return r.DeliveryID == id })
+	}
+
+	webhookRepo.On("ClaimDueDeliveries", ctx, 10, _claimLease).Return([]entity.WebhookDeliveryTask{task(1, 0), task(2, 0), task(3, 2)}, nil)
+	sender.On("Send", ctx, sent(1)).Return(200, nil)
+	sender.On("Send", ctx, sent(2)).Return(503, errors.New("unexpected response status: 503"))
+	sender.On("Send", ctx, sent(3)).Return(0, errors.New("connection refused"))
+
+	webhookRepo.On("UpdateDelivery", ctx, mock.MatchedBy(func(d entity.WebhookDelivery) bool {
+		return d.DeliveryID == 1 && d.Status == entity.DeliveryStatusSucceeded && d.Attempts == 1 && *d.LastStatusCode == 200 && d.LastError == nil
+	}), time.Duration(0)).Return(nil)
+	webhookRepo.On("UpdateDelivery", ctx, mock.MatchedBy(func(d entity.WebhookDelivery) bool {
+		return d.DeliveryID == 2 && d.Status == entity.DeliveryStatusPending && d.Attempts == 1 && *d.LastStatusCode == 503 && d.LastError != nil
+	}), 10*time.Second).Return(nil)
+	webhookRepo.On("UpdateDelivery", ctx, mock.MatchedBy(func(d entity.WebhookDelivery) bool {
+		return d.DeliveryID == 3 && d.Status == entity.DeliveryStatusFailed && d.Attempts == 3 && d.LastStatusCode == nil
+	}), time.Duration(0)).Return(nil)
+
+	result, err := uc.DeliverPending(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, entity.DeliveryResult{Succeeded: 1, Retrying: 1, Failed: 1}, result)
+	webhookRepo.AssertExpectations(t)
+	sender.AssertExpectations(t)
+}
+
+func TestDeliverPending_UpdateErrorContinues(t *testing.T) {
+	webhookRepo := new(mockWebhookRepo)
+	sender := new(mockWebhookSender)
+	uc := New(webhookRepo, sender, testRetry, 10)
+
+	ctx := context.Background()
+	tasks := []entity.WebhookDeliveryTask{
+		{WebhookDelivery: entity.WebhookDelivery{DeliveryID: 1}},
+		{WebhookDelivery: entity.WebhookDelivery{DeliveryID: 2}},
+	}
+
+	webhookRepo.On("ClaimDueDeliveries", ctx, 10, _claimLease).Return(tasks, nil)
+	sender.On("Send", ctx, mock.Anything).Return(204, nil)
+	webhookRepo.On("UpdateDelivery", ctx, mock.MatchedBy(func(d entity.WebhookDelivery) bool { return d.DeliveryID == 1 }), mock.Anything).Return(errors.New("db down"))
+	webhookRepo.On("UpdateDelivery", ctx, mock.MatchedBy(func(d entity.WebhookDelivery) bool { return d.DeliveryID == 2 }), mock.Anything).Return(nil)
+
+	result, err := uc.DeliverPending(ctx)
+
+	assert.Error(t, err)
+	assert.Equal(t, 2, result.Succeeded)
+	webhookRepo.AssertExpectations(t)
+}
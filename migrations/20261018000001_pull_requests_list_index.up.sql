@@ -0,0 +1,2 @@
+-- Support keyset pagination over pull requests (newest first)
+CREATE INDEX IF NOT EXISTS idx_pull_requests_created_at_id ON pull_requests(created_at DESC, pull_request_id DESC);
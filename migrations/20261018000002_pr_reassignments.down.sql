@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
+DROP INDEX IF EXISTS idx_pr_reviewers_created_at;
+DROP INDEX IF EXISTS idx_pr_reassignments_reassigned_at;
+DROP INDEX IF EXISTS idx_pr_reassignments_old_reviewer_id;
+DROP INDEX IF EXISTS idx_pr_reassignments_pull_request_id;
+
+DROP TABLE IF EXISTS pr_reassignments;
@@ -0,0 +1,15 @@
+-- Create pr_reassignments table (log of reviewers replaced on a PR)
+CREATE TABLE IF NOT EXISTS pr_reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    old_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE RESTRICT,
+    new_reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE RESTRICT,
+    assigned_at TIMESTAMP,
+    reassigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_pull_request_id ON pr_reassignments(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_old_reviewer_id ON pr_reassignments(old_reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reassignments_reassigned_at ON pr_reassignments(reassigned_at);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_created_at ON pr_reviewers(created_at);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at ON pull_requests(merged_at);
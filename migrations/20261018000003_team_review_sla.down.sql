@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer_id_created_at;
+ALTER TABLE teams DROP COLUMN IF EXISTS review_sla_seconds;
@@ -0,0 +1,5 @@
+-- Per-team review SLA; NULL falls back to the service default
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS review_sla_seconds INTEGER CHECK (review_sla_seconds > 0);
+
+-- Support overdue review lookups on open pull requests
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer_id_created_at ON pr_reviewers(reviewer_id, created_at);
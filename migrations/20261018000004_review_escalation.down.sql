@@ -0,0 +1,2 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS escalation_seconds;
+ALTER TABLE pr_reassignments DROP COLUMN IF EXISTS reason;
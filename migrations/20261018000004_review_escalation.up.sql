@@ -0,0 +1,5 @@
+-- Record whether a reassignment was requested manually or made by the escalation sweep
+ALTER TABLE pr_reassignments ADD COLUMN IF NOT EXISTS reason VARCHAR(20) NOT NULL DEFAULT 'MANUAL' CHECK (reason IN ('MANUAL', 'AUTOMATIC'));
+
+-- Per-team escalation threshold; NULL falls back to the service default
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS escalation_seconds INTEGER CHECK (escalation_seconds > 0);
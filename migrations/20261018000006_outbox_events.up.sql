@@ -0,0 +1,12 @@
+-- Create outbox_events table (domain events written in the same transaction as the change they describe)
+CREATE TABLE IF NOT EXISTS outbox_events (
+    outbox_id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    published_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_unpublished ON outbox_events(outbox_id) WHERE published_at IS NULL;
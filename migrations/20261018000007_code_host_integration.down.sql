@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS user_identities;
+
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS reviewed_at;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS review_outcome;
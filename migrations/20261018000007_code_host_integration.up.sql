@@ -0,0 +1,13 @@
+-- Record reviews submitted on the code host; reviewed assignments no longer count as overdue
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS review_outcome VARCHAR(20) CHECK (review_outcome IN ('APPROVED', 'CHANGES_REQUESTED', 'COMMENTED'));
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMP;
+
+-- Create user_identities table (maps code host accounts to users)
+CREATE TABLE IF NOT EXISTS user_identities (
+    provider VARCHAR(20) NOT NULL,
+    login VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (provider, login),
+    UNIQUE (provider, user_id)
+);
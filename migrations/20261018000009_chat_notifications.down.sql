@@ -0,0 +1,2 @@
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS overdue_notified_at;
+ALTER TABLE teams DROP COLUMN IF EXISTS chat_webhook_url;
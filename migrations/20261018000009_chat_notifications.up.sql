@@ -0,0 +1,5 @@
+-- Per-team chat channel incoming-webhook URL; NULL disables chat notifications for the team
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS chat_webhook_url TEXT;
+
+-- When the reviewer's team channel was told the review is overdue; NULL until then
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS overdue_notified_at TIMESTAMP;
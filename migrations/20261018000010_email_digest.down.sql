@@ -0,0 +1,3 @@
+ALTER TABLE users DROP COLUMN IF EXISTS digest_sent_on;
+ALTER TABLE users DROP COLUMN IF EXISTS digest_opt_out;
+ALTER TABLE users DROP COLUMN IF EXISTS email;
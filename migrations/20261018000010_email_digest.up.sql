@@ -0,0 +1,8 @@
+-- Email address for the daily review digest; NULL means the user gets no digest
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email VARCHAR(255);
+
+-- Per-user opt-out of the daily review digest
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_opt_out BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- UTC day the last digest was handled for the user, so each user gets at most one per day
+ALTER TABLE users ADD COLUMN IF NOT EXISTS digest_sent_on DATE;
@@ -0,0 +1,11 @@
+-- Create api_keys table (credentials for the HTTP API; only a SHA-256 hash of the token is stored)
+CREATE TABLE IF NOT EXISTS api_keys (
+    key_id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    token_prefix VARCHAR(16) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
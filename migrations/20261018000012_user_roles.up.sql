@@ -0,0 +1,4 @@
+-- What a user may change: team leads and admins manage teams, other users' activity and merges,
+-- members only act as themselves, bots are service accounts acting on behalf of users
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'member'
+    CHECK (role IN ('admin', 'team_lead', 'member', 'bot'));
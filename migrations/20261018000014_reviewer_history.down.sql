@@ -0,0 +1,12 @@
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS closed_at;
+
+-- Dropped first, so that removing the replaced assignments keeps the reassignment log
+DROP INDEX IF EXISTS idx_pr_reassignments_assignment_id;
+ALTER TABLE pr_reassignments DROP COLUMN IF EXISTS assignment_id;
+
+DELETE FROM pr_reviewers WHERE unassigned_at IS NOT NULL;
+DROP INDEX IF EXISTS idx_pr_reviewers_current;
+ALTER TABLE pr_reviewers DROP CONSTRAINT IF EXISTS pr_reviewers_pkey;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS assignment_id;
+ALTER TABLE pr_reviewers DROP COLUMN IF EXISTS unassigned_at;
+ALTER TABLE pr_reviewers ADD PRIMARY KEY (pull_request_id, reviewer_id);
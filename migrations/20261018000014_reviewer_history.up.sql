@@ -0,0 +1,40 @@
+-- Keep replaced reviewers: a reassignment marks the assignment with unassigned_at instead of deleting it,
+-- so a reviewer may appear on a PR several times, but has at most one current assignment
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS unassigned_at TIMESTAMP;
+ALTER TABLE pr_reviewers ADD COLUMN IF NOT EXISTS assignment_id BIGSERIAL;
+ALTER TABLE pr_reviewers DROP CONSTRAINT IF EXISTS pr_reviewers_pkey;
+ALTER TABLE pr_reviewers ADD PRIMARY KEY (assignment_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pr_reviewers_current ON pr_reviewers(pull_request_id, reviewer_id) WHERE unassigned_at IS NULL;
+
+-- Each reassignment references the assignment it ended
+ALTER TABLE pr_reassignments ADD COLUMN IF NOT EXISTS assignment_id BIGINT;
+
+-- Restore the assignments replaced so far from the reassignment log, numbered in log order; their reviews are lost
+UPDATE pr_reassignments ra
+SET assignment_id = numbered.assignment_id
+FROM (
+    SELECT id, nextval(pg_get_serial_sequence('pr_reviewers', 'assignment_id')) AS assignment_id
+    FROM pr_reassignments
+    ORDER BY id
+) numbered
+WHERE ra.id = numbered.id;
+
+INSERT INTO pr_reviewers (assignment_id, pull_request_id, reviewer_id, created_at, unassigned_at)
+SELECT assignment_id, pull_request_id, old_reviewer_id, assigned_at, reassigned_at
+FROM pr_reassignments;
+
+ALTER TABLE pr_reassignments ALTER COLUMN assignment_id SET NOT NULL;
+ALTER TABLE pr_reassignments ADD CONSTRAINT pr_reassignments_assignment_id_fkey
+    FOREIGN KEY (assignment_id) REFERENCES pr_reviewers(assignment_id) ON DELETE CASCADE;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_pr_reassignments_assignment_id ON pr_reassignments(assignment_id);
+
+-- Time a PR was closed without merging; for PRs closed earlier it is taken from the audit log when recorded there
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+
+UPDATE pull_requests pr
+SET closed_at = (
+    SELECT MAX(a.created_at) FROM audit_log a
+    WHERE a.entity_type = 'pull_request' AND a.entity_id = pr.pull_request_id AND a.action = 'pull_request.closed'
+)
+WHERE pr.status = 'CLOSED';
@@ -0,0 +1,124 @@
+// Package grpcserver implements gRPC server.
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+)
+
+const (
+	_defaultAddr            = ":81"
+	_defaultShutdownTimeout = 3 * time.Second
+)
+
+// Logger interface for grpcserver
+type Logger interface {
+	Info(message string, args ...interface{})
+	Error(message interface{}, args ...interface{})
+}
+
+// Server -.
+type Server struct {
+	ctx context.Context
+	eg  *errgroup.Group
+
+	App    *grpc.Server
+	notify chan error
+
+	address         string
+	shutdownTimeout time.Duration
+	interceptors    []grpc.UnaryServerInterceptor
+
+	logger Logger
+}
+
+// New -.
+func New(l Logger, opts ...Option) *Server {
+	group, ctx := errgroup.WithContext(context.Background())
+	group.SetLimit(1) // Run only one goroutine
+
+	s := &Server{
+		ctx:             ctx,
+		eg:              group,
+		App:             nil,
+		notify:          make(chan error, 1),
+		address:         _defaultAddr,
+		shutdownTimeout: _defaultShutdownTimeout,
+		logger:          l,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.App = grpc.NewServer(grpc.ChainUnaryInterceptor(s.interceptors...))
+
+	return s
+}
+
+// Start -.
+func (s *Server) Start() {
+	s.eg.Go(func() error {
+		listener, err := net.Listen("tcp", s.address)
+		if err != nil {
+			s.notify <- fmt.Errorf("failed to listen: %w", err)
+
+			close(s.notify)
+
+			return err
+		}
+
+		err = s.App.Serve(listener)
+		if err != nil {
+			s.notify <- err
+
+			close(s.notify)
+
+			return err
+		}
+
+		return nil
+	})
+
+	s.logger.Info("grpc server - Server - Started")
+}
+
+// Notify -.
+func (s *Server) Notify() <-chan error {
+	return s.notify
+}
+
+// Shutdown waits for running calls to finish, cancelling those still running after the shutdown timeout.
+func (s *Server) Shutdown() error {
+	stopped := make(chan struct{})
+	go func() {
+		s.App.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(s.shutdownTimeout):
+		s.logger.Error("grpc server - Server - Shutdown - timeout, stopping running calls")
+		s.App.Stop()
+		<-stopped
+	}
+
+	// Wait for all goroutines to finish and get any error
+	err := s.eg.Wait()
+	if err != nil {
+		s.logger.Error(err, "grpc server - Server - Shutdown - s.eg.Wait")
+
+		return err
+	}
+
+	s.logger.Info("grpc server - Server - Shutdown")
+
+	return nil
+}
@@ -43,3 +43,11 @@ func ShutdownTimeout(timeout time.Duration) Option {
 	}
 }
 
+
+// LongLived lets responses to requests under pathPrefix be written for up to timeout instead of the write timeout,
+// e.g. Server-Sent Events streams.
+func LongLived(pathPrefix string, timeout time.Duration) Option {
+	return func(s *Server) {
+		s.longLived = append(s.longLived, longLivedPath{prefix: []byte(pathPrefix), timeout: timeout})
+	}
+}
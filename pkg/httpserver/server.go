@@ -2,12 +2,14 @@
 package httpserver
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"time"
 
 	"github.com/goccy/go-json"
 	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -37,10 +39,17 @@ type Server struct {
 	readTimeout     time.Duration
 	writeTimeout    time.Duration
 	shutdownTimeout time.Duration
+	longLived       []longLivedPath
 
 	logger Logger
 }
 
+// longLivedPath is a path prefix whose responses get their own write timeout
+type longLivedPath struct {
+	prefix  []byte
+	timeout time.Duration
+}
+
 // New -.
 func New(l Logger, opts ...Option) *Server {
 	group, ctx := errgroup.WithContext(context.Background())
@@ -71,11 +80,26 @@ func New(l Logger, opts ...Option) *Server {
 		JSONEncoder:  json.Marshal,
 	})
 
+	if len(s.longLived) > 0 {
+		app.Server().HeaderReceived = s.requestConfig
+	}
+
 	s.App = app
 
 	return s
 }
 
+// requestConfig overrides the write timeout for requests under a long-lived path prefix
+func (s *Server) requestConfig(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+	for _, path := range s.longLived {
+		if bytes.HasPrefix(header.RequestURI(), path.prefix) {
+			return fasthttp.RequestConfig{WriteTimeout: path.timeout}
+		}
+	}
+
+	return fasthttp.RequestConfig{}
+}
+
 // Start -.
 func (s *Server) Start() {
 	s.eg.Go(func() error {
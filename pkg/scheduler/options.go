@@ -0,0 +1,27 @@
+package scheduler
+
+import "time"
+
+// Option -.
+type Option func(*Scheduler)
+
+// Interval -.
+func Interval(interval time.Duration) Option {
+	return func(s *Scheduler) {
+		s.interval = interval
+	}
+}
+
+// Timeout -. A non-positive timeout lets the job run until shutdown.
+func Timeout(timeout time.Duration) Option {
+	return func(s *Scheduler) {
+		s.timeout = timeout
+	}
+}
+
+// Name -.
+func Name(name string) Option {
+	return func(s *Scheduler) {
+		s.name = name
+	}
+}
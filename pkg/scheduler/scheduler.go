@@ -0,0 +1,104 @@
+// Package scheduler implements periodic background jobs.
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	_defaultInterval = time.Minute
+	_defaultTimeout  = 30 * time.Second
+	_defaultName     = "job"
+)
+
+// Logger interface for scheduler
+type Logger interface {
+	Info(message string, args ...interface{})
+	Error(message interface{}, args ...interface{})
+}
+
+// Job -.
+type Job func(ctx context.Context) error
+
+// Scheduler runs a job at a fixed interval until shut down.
+type Scheduler struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	job      Job
+	name     string
+	interval time.Duration
+	timeout  time.Duration
+
+	logger Logger
+}
+
+// New -.
+func New(l Logger, job Job, opts ...Option) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := &Scheduler{
+		ctx:      ctx,
+		cancel:   cancel,
+		job:      job,
+		name:     _defaultName,
+		interval: _defaultInterval,
+		timeout:  _defaultTimeout,
+		logger:   l,
+	}
+
+	// Custom options
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Start -.
+func (s *Scheduler) Start() {
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-ticker.C:
+				s.run()
+			}
+		}
+	}()
+
+	s.logger.Info("scheduler - %s - Started, interval: %s", s.name, s.interval)
+}
+
+// Shutdown stops scheduling and waits for a running job to finish.
+func (s *Scheduler) Shutdown() {
+	s.cancel()
+	s.wg.Wait()
+
+	s.logger.Info("scheduler - %s - Shutdown", s.name)
+}
+
+func (s *Scheduler) run() {
+	ctx := s.ctx
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(s.ctx, s.timeout)
+		defer cancel()
+	}
+
+	if err := s.job(ctx); err != nil {
+		s.logger.Error(fmt.Errorf("scheduler - %s - job: %w", s.name, err))
+	}
+}
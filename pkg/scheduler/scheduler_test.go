@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type nopLogger struct {
+	errors atomic.Int32
+}
+
+func (l *nopLogger) Info(string, ...interface{}) {}
+
+func (l *nopLogger) Error(interface{}, ...interface{}) {
+	l.errors.Add(1)
+}
+
+func TestScheduler_RunsJobPeriodically(t *testing.T) {
+	var runs atomic.Int32
+
+	s := New(&nopLogger{}, func(context.Context) error {
+		runs.Add(1)
+		return nil
+	}, Interval(10*time.Millisecond))
+
+	s.Start()
+	assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, 5*time.Millisecond)
+	s.Shutdown()
+
+	stopped := runs.Load()
+	time.Sleep(30 * time.Millisecond)
+	assert.Equal(t, stopped, runs.Load(), "job must not run after shutdown")
+}
+
+func TestScheduler_LogsJobErrors(t *testing.T) {
+	l := &nopLogger{}
+
+	s := New(l, func(context.Context) error {
+		return errors.New("boom")
+	}, Interval(10*time.Millisecond))
+
+	s.Start()
+	assert.Eventually(t, func() bool { return l.errors.Load() >= 1 }, time.Second, 5*time.Millisecond)
+	s.Shutdown()
+}
+
+func TestScheduler_ShutdownCancelsRunningJob(t *testing.T) {
+	started := make(chan struct{})
+	var once sync.Once
+
+	s := New(&nopLogger{}, func(ctx context.Context) error {
+		once.Do(func() { close(started) })
+		<-ctx.Done()
+		return ctx.Err()
+	}, Interval(time.Millisecond), Timeout(time.Minute))
+
+	s.Start()
+	<-started
+
+	done := make(chan struct{})
+	go func() {
+		s.Shutdown()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("shutdown did not cancel the running job")
+	}
+}
+
+func TestScheduler_NonPositiveTimeoutRunsWithoutDeadline(t *testing.T) {
+	var hasDeadline atomic.Bool
+	ran := make(chan struct{})
+	var once sync.Once
+
+	s := New(&nopLogger{}, func(ctx context.Context) error {
+		_, ok := ctx.Deadline()
+		hasDeadline.Store(ok)
+		once.Do(func() { close(ran) })
+		return nil
+	}, Interval(time.Millisecond), Timeout(0))
+
+	s.Start()
+	<-ran
+	s.Shutdown()
+
+	assert.False(t, hasDeadline.Load())
+}
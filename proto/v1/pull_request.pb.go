@@ -0,0 +1,772 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/v1/pull_request.proto
+
+package prreviewsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type GetPullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ExpandAuthor    bool                   `protobuf:"varint,2,opt,name=expand_author,json=expandAuthor,proto3" json:"expand_author,omitempty"`
+	ExpandReviewers bool                   `protobuf:"varint,3,opt,name=expand_reviewers,json=expandReviewers,proto3" json:"expand_reviewers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *GetPullRequestRequest) GetExpandAuthor() bool {
+	if x != nil {
+		return x.ExpandAuthor
+	}
+	return false
+}
+
+func (x *GetPullRequestRequest) GetExpandReviewers() bool {
+	if x != nil {
+		return x.ExpandReviewers
+	}
+	return false
+}
+
+type GetPullRequestResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	// Set with expand_author.
+	Author *User `protobuf:"bytes,2,opt,name=author,proto3" json:"author,omitempty"`
+	// Set with expand_reviewers.
+	Reviewers     []*User `protobuf:"bytes,3,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *GetPullRequestResponse) GetAuthor() *User {
+	if x != nil {
+		return x.Author
+	}
+	return nil
+}
+
+func (x *GetPullRequestResponse) GetReviewers() []*User {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type ListPullRequestsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Status     PullRequestStatus      `protobuf:"varint,1,opt,name=status,proto3,enum=prreviews.v1.PullRequestStatus" json:"status,omitempty"`
+	AuthorId   string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId string                 `protobuf:"bytes,3,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	TeamName   string                 `protobuf:"bytes,4,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Case-insensitive substring of the pull request name.
+	NameContains string                 `protobuf:"bytes,5,opt,name=name_contains,json=nameContains,proto3" json:"name_contains,omitempty"`
+	CreatedFrom  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	MergedFrom   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"`
+	MergedTo     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_to,json=mergedTo,proto3" json:"merged_to,omitempty"`
+	Order        SortOrder              `protobuf:"varint,10,opt,name=order,proto3,enum=prreviews.v1.SortOrder" json:"order,omitempty"`
+	// 1-100, 50 when unset.
+	Limit         int32  `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPullRequestsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPullRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListPullRequestsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Empty on the last page.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MergePullRequestResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequest   *PullRequest           `protobuf:"bytes,1,opt,name=pull_request,json=pullRequest,proto3" json:"pull_request,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_proto_v1_pull_request_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_pull_request_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_pull_request_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReassignReviewerResponse) GetPullRequest() *PullRequest {
+	if x != nil {
+		return x.PullRequest
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+var File_proto_v1_pull_request_proto protoreflect.FileDescriptor
+
+const file_proto_v1_pull_request_proto_rawDesc = "" +
+	"\n" +
+	"\x1bproto/v1/pull_request.proto\x12\fprreviews.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14proto/v1/types.proto\"\x8b\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"Y\n" +
+	"\x19CreatePullRequestResponse\x12<\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x19.prreviews.v1.PullRequestR\vpullRequest\"\x8f\x01\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\rexpand_author\x18\x02 \x01(\bR\fexpandAuthor\x12)\n" +
+	"\x10expand_reviewers\x18\x03 \x01(\bR\x0fexpandReviewers\"\xb4\x01\n" +
+	"\x16GetPullRequestResponse\x12<\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x19.prreviews.v1.PullRequestR\vpullRequest\x12*\n" +
+	"\x06author\x18\x02 \x01(\v2\x12.prreviews.v1.UserR\x06author\x120\n" +
+	"\treviewers\x18\x03 \x03(\v2\x12.prreviews.v1.UserR\treviewers\"\x9f\x04\n" +
+	"\x17ListPullRequestsRequest\x127\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1f.prreviews.v1.PullRequestStatusR\x06status\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x03 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12#\n" +
+	"\rname_contains\x18\x05 \x01(\tR\fnameContains\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12;\n" +
+	"\vmerged_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mergedFrom\x127\n" +
+	"\tmerged_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedTo\x12-\n" +
+	"\x05order\x18\n" +
+	" \x01(\x0e2\x17.prreviews.v1.SortOrderR\x05order\x12\x14\n" +
+	"\x05limit\x18\v \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\f \x01(\tR\x06cursor\"{\n" +
+	"\x18ListPullRequestsResponse\x12>\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x19.prreviews.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"X\n" +
+	"\x18MergePullRequestResponse\x12<\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x19.prreviews.v1.PullRequestR\vpullRequest\"a\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"y\n" +
+	"\x18ReassignReviewerResponse\x12<\n" +
+	"\fpull_request\x18\x01 \x01(\v2\x19.prreviews.v1.PullRequestR\vpullRequest\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy2\x80\x04\n" +
+	"\x12PullRequestService\x12d\n" +
+	"\x11CreatePullRequest\x12&.prreviews.v1.CreatePullRequestRequest\x1a'.prreviews.v1.CreatePullRequestResponse\x12[\n" +
+	"\x0eGetPullRequest\x12#.prreviews.v1.GetPullRequestRequest\x1a$.prreviews.v1.GetPullRequestResponse\x12a\n" +
+	"\x10ListPullRequests\x12%.prreviews.v1.ListPullRequestsRequest\x1a&.prreviews.v1.ListPullRequestsResponse\x12a\n" +
+	"\x10MergePullRequest\x12%.prreviews.v1.MergePullRequestRequest\x1a&.prreviews.v1.MergePullRequestResponse\x12a\n" +
+	"\x10ReassignReviewer\x12%.prreviews.v1.ReassignReviewerRequest\x1a&.prreviews.v1.ReassignReviewerResponseB5Z3github.com/finstape/pr-reviews/proto/v1;prreviewsv1b\x06proto3"
+
+var (
+	file_proto_v1_pull_request_proto_rawDescOnce sync.Once
+	file_proto_v1_pull_request_proto_rawDescData []byte
+)
+
+func file_proto_v1_pull_request_proto_rawDescGZIP() []byte {
+	file_proto_v1_pull_request_proto_rawDescOnce.Do(func() {
+		file_proto_v1_pull_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_pull_request_proto_rawDesc), len(file_proto_v1_pull_request_proto_rawDesc)))
+	})
+	return file_proto_v1_pull_request_proto_rawDescData
+}
+
+var file_proto_v1_pull_request_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_v1_pull_request_proto_goTypes = []any{
+	(*CreatePullRequestRequest)(nil),  // 0: prreviews.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil), // 1: prreviews.v1.CreatePullRequestResponse
+	(*GetPullRequestRequest)(nil),     // 2: prreviews.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),    // 3: prreviews.v1.GetPullRequestResponse
+	(*ListPullRequestsRequest)(nil),   // 4: prreviews.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),  // 5: prreviews.v1.ListPullRequestsResponse
+	(*MergePullRequestRequest)(nil),   // 6: prreviews.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),  // 7: prreviews.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),   // 8: prreviews.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),  // 9: prreviews.v1.ReassignReviewerResponse
+	(*PullRequest)(nil),               // 10: prreviews.v1.PullRequest
+	(*User)(nil),                      // 11: prreviews.v1.User
+	(PullRequestStatus)(0),            // 12: prreviews.v1.PullRequestStatus
+	(*timestamppb.Timestamp)(nil),     // 13: google.protobuf.Timestamp
+	(SortOrder)(0),                    // 14: prreviews.v1.SortOrder
+}
+var file_proto_v1_pull_request_proto_depIdxs = []int32{
+	10, // 0: prreviews.v1.CreatePullRequestResponse.pull_request:type_name -> prreviews.v1.PullRequest
+	10, // 1: prreviews.v1.GetPullRequestResponse.pull_request:type_name -> prreviews.v1.PullRequest
+	11, // 2: prreviews.v1.GetPullRequestResponse.author:type_name -> prreviews.v1.User
+	11, // 3: prreviews.v1.GetPullRequestResponse.reviewers:type_name -> prreviews.v1.User
+	12, // 4: prreviews.v1.ListPullRequestsRequest.status:type_name -> prreviews.v1.PullRequestStatus
+	13, // 5: prreviews.v1.ListPullRequestsRequest.created_from:type_name -> google.protobuf.Timestamp
+	13, // 6: prreviews.v1.ListPullRequestsRequest.created_to:type_name -> google.protobuf.Timestamp
+	13, // 7: prreviews.v1.ListPullRequestsRequest.merged_from:type_name -> google.protobuf.Timestamp
+	13, // 8: prreviews.v1.ListPullRequestsRequest.merged_to:type_name -> google.protobuf.Timestamp
+	14, // 9: prreviews.v1.ListPullRequestsRequest.order:type_name -> prreviews.v1.SortOrder
+	10, // 10: prreviews.v1.ListPullRequestsResponse.pull_requests:type_name -> prreviews.v1.PullRequest
+	10, // 11: prreviews.v1.MergePullRequestResponse.pull_request:type_name -> prreviews.v1.PullRequest
+	10, // 12: prreviews.v1.ReassignReviewerResponse.pull_request:type_name -> prreviews.v1.PullRequest
+	0,  // 13: prreviews.v1.PullRequestService.CreatePullRequest:input_type -> prreviews.v1.CreatePullRequestRequest
+	2,  // 14: prreviews.v1.PullRequestService.GetPullRequest:input_type -> prreviews.v1.GetPullRequestRequest
+	4,  // 15: prreviews.v1.PullRequestService.ListPullRequests:input_type -> prreviews.v1.ListPullRequestsRequest
+	6,  // 16: prreviews.v1.PullRequestService.MergePullRequest:input_type -> prreviews.v1.MergePullRequestRequest
+	8,  // 17: prreviews.v1.PullRequestService.ReassignReviewer:input_type -> prreviews.v1.ReassignReviewerRequest
+	1,  // 18: prreviews.v1.PullRequestService.CreatePullRequest:output_type -> prreviews.v1.CreatePullRequestResponse
+	3,  // 19: prreviews.v1.PullRequestService.GetPullRequest:output_type -> prreviews.v1.GetPullRequestResponse
+	5,  // 20: prreviews.v1.PullRequestService.ListPullRequests:output_type -> prreviews.v1.ListPullRequestsResponse
+	7,  // 21: prreviews.v1.PullRequestService.MergePullRequest:output_type -> prreviews.v1.MergePullRequestResponse
+	9,  // 22: prreviews.v1.PullRequestService.ReassignReviewer:output_type -> prreviews.v1.ReassignReviewerResponse
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_pull_request_proto_init() }
+func file_proto_v1_pull_request_proto_init() {
+	if File_proto_v1_pull_request_proto != nil {
+		return
+	}
+	file_proto_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_pull_request_proto_rawDesc), len(file_proto_v1_pull_request_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_pull_request_proto_goTypes,
+		DependencyIndexes: file_proto_v1_pull_request_proto_depIdxs,
+		MessageInfos:      file_proto_v1_pull_request_proto_msgTypes,
+	}.Build()
+	File_proto_v1_pull_request_proto = out.File
+	file_proto_v1_pull_request_proto_goTypes = nil
+	file_proto_v1_pull_request_proto_depIdxs = nil
+}
@@ -0,0 +1,698 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/v1/team.proto
+
+package prreviewsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_proto_v1_team_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_proto_v1_team_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type TeamSummary struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	TeamName    string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MemberCount int32                  `protobuf:"varint,2,opt,name=member_count,json=memberCount,proto3" json:"member_count,omitempty"`
+	ActiveCount int32                  `protobuf:"varint,3,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`
+	// Unset when the service default applies.
+	ReviewSlaSeconds *int64 `protobuf:"varint,4,opt,name=review_sla_seconds,json=reviewSlaSeconds,proto3,oneof" json:"review_sla_seconds,omitempty"`
+	// Unset when the service default applies.
+	EscalationSeconds     *int64 `protobuf:"varint,5,opt,name=escalation_seconds,json=escalationSeconds,proto3,oneof" json:"escalation_seconds,omitempty"`
+	ChatWebhookConfigured bool   `protobuf:"varint,6,opt,name=chat_webhook_configured,json=chatWebhookConfigured,proto3" json:"chat_webhook_configured,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *TeamSummary) Reset() {
+	*x = TeamSummary{}
+	mi := &file_proto_v1_team_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamSummary) ProtoMessage() {}
+
+func (x *TeamSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamSummary.ProtoReflect.Descriptor instead.
+func (*TeamSummary) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TeamSummary) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamSummary) GetMemberCount() int32 {
+	if x != nil {
+		return x.MemberCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetReviewSlaSeconds() int64 {
+	if x != nil && x.ReviewSlaSeconds != nil {
+		return *x.ReviewSlaSeconds
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetEscalationSeconds() int64 {
+	if x != nil && x.EscalationSeconds != nil {
+		return *x.EscalationSeconds
+	}
+	return 0
+}
+
+func (x *TeamSummary) GetChatWebhookConfigured() bool {
+	if x != nil {
+		return x.ChatWebhookConfigured
+	}
+	return false
+}
+
+type CreateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_proto_v1_team_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamRequest) ProtoMessage() {}
+
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type CreateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_proto_v1_team_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeamResponse) ProtoMessage() {}
+
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_proto_v1_team_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_proto_v1_team_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_proto_v1_team_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{7}
+}
+
+type ListTeamsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Teams         []*TeamSummary         `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_proto_v1_team_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsResponse) ProtoMessage() {}
+
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListTeamsResponse) GetTeams() []*TeamSummary {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type SetChatWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChatWebhookRequest) Reset() {
+	*x = SetChatWebhookRequest{}
+	mi := &file_proto_v1_team_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatWebhookRequest) ProtoMessage() {}
+
+func (x *SetChatWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatWebhookRequest.ProtoReflect.Descriptor instead.
+func (*SetChatWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetChatWebhookRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetChatWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type SetChatWebhookResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	TeamName              string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	ChatWebhookConfigured bool                   `protobuf:"varint,2,opt,name=chat_webhook_configured,json=chatWebhookConfigured,proto3" json:"chat_webhook_configured,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SetChatWebhookResponse) Reset() {
+	*x = SetChatWebhookResponse{}
+	mi := &file_proto_v1_team_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChatWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChatWebhookResponse) ProtoMessage() {}
+
+func (x *SetChatWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_team_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChatWebhookResponse.ProtoReflect.Descriptor instead.
+func (*SetChatWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_team_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetChatWebhookResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetChatWebhookResponse) GetChatWebhookConfigured() bool {
+	if x != nil {
+		return x.ChatWebhookConfigured
+	}
+	return false
+}
+
+var File_proto_v1_team_proto protoreflect.FileDescriptor
+
+const file_proto_v1_team_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v1/team.proto\x12\fprreviews.v1\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"W\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x122\n" +
+	"\amembers\x18\x02 \x03(\v2\x18.prreviews.v1.TeamMemberR\amembers\"\xbd\x02\n" +
+	"\vTeamSummary\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12!\n" +
+	"\fmember_count\x18\x02 \x01(\x05R\vmemberCount\x12!\n" +
+	"\factive_count\x18\x03 \x01(\x05R\vactiveCount\x121\n" +
+	"\x12review_sla_seconds\x18\x04 \x01(\x03H\x00R\x10reviewSlaSeconds\x88\x01\x01\x122\n" +
+	"\x12escalation_seconds\x18\x05 \x01(\x03H\x01R\x11escalationSeconds\x88\x01\x01\x126\n" +
+	"\x17chat_webhook_configured\x18\x06 \x01(\bR\x15chatWebhookConfiguredB\x15\n" +
+	"\x13_review_sla_secondsB\x15\n" +
+	"\x13_escalation_seconds\";\n" +
+	"\x11CreateTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prreviews.v1.TeamR\x04team\"<\n" +
+	"\x12CreateTeamResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prreviews.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"9\n" +
+	"\x0fGetTeamResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prreviews.v1.TeamR\x04team\"\x12\n" +
+	"\x10ListTeamsRequest\"D\n" +
+	"\x11ListTeamsResponse\x12/\n" +
+	"\x05teams\x18\x01 \x03(\v2\x19.prreviews.v1.TeamSummaryR\x05teams\"F\n" +
+	"\x15SetChatWebhookRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\"m\n" +
+	"\x16SetChatWebhookResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x126\n" +
+	"\x17chat_webhook_configured\x18\x02 \x01(\bR\x15chatWebhookConfigured2\xd1\x02\n" +
+	"\vTeamService\x12O\n" +
+	"\n" +
+	"CreateTeam\x12\x1f.prreviews.v1.CreateTeamRequest\x1a .prreviews.v1.CreateTeamResponse\x12F\n" +
+	"\aGetTeam\x12\x1c.prreviews.v1.GetTeamRequest\x1a\x1d.prreviews.v1.GetTeamResponse\x12L\n" +
+	"\tListTeams\x12\x1e.prreviews.v1.ListTeamsRequest\x1a\x1f.prreviews.v1.ListTeamsResponse\x12[\n" +
+	"\x0eSetChatWebhook\x12#.prreviews.v1.SetChatWebhookRequest\x1a$.prreviews.v1.SetChatWebhookResponseB5Z3github.com/finstape/pr-reviews/proto/v1;prreviewsv1b\x06proto3"
+
+var (
+	file_proto_v1_team_proto_rawDescOnce sync.Once
+	file_proto_v1_team_proto_rawDescData []byte
+)
+
+func file_proto_v1_team_proto_rawDescGZIP() []byte {
+	file_proto_v1_team_proto_rawDescOnce.Do(func() {
+		file_proto_v1_team_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_team_proto_rawDesc), len(file_proto_v1_team_proto_rawDesc)))
+	})
+	return file_proto_v1_team_proto_rawDescData
+}
+
+var file_proto_v1_team_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_v1_team_proto_goTypes = []any{
+	(*TeamMember)(nil),             // 0: prreviews.v1.TeamMember
+	(*Team)(nil),                   // 1: prreviews.v1.Team
+	(*TeamSummary)(nil),            // 2: prreviews.v1.TeamSummary
+	(*CreateTeamRequest)(nil),      // 3: prreviews.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),     // 4: prreviews.v1.CreateTeamResponse
+	(*GetTeamRequest)(nil),         // 5: prreviews.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),        // 6: prreviews.v1.GetTeamResponse
+	(*ListTeamsRequest)(nil),       // 7: prreviews.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),      // 8: prreviews.v1.ListTeamsResponse
+	(*SetChatWebhookRequest)(nil),  // 9: prreviews.v1.SetChatWebhookRequest
+	(*SetChatWebhookResponse)(nil), // 10: prreviews.v1.SetChatWebhookResponse
+}
+var file_proto_v1_team_proto_depIdxs = []int32{
+	0,  // 0: prreviews.v1.Team.members:type_name -> prreviews.v1.TeamMember
+	1,  // 1: prreviews.v1.CreateTeamRequest.team:type_name -> prreviews.v1.Team
+	1,  // 2: prreviews.v1.CreateTeamResponse.team:type_name -> prreviews.v1.Team
+	1,  // 3: prreviews.v1.GetTeamResponse.team:type_name -> prreviews.v1.Team
+	2,  // 4: prreviews.v1.ListTeamsResponse.teams:type_name -> prreviews.v1.TeamSummary
+	3,  // 5: prreviews.v1.TeamService.CreateTeam:input_type -> prreviews.v1.CreateTeamRequest
+	5,  // 6: prreviews.v1.TeamService.GetTeam:input_type -> prreviews.v1.GetTeamRequest
+	7,  // 7: prreviews.v1.TeamService.ListTeams:input_type -> prreviews.v1.ListTeamsRequest
+	9,  // 8: prreviews.v1.TeamService.SetChatWebhook:input_type -> prreviews.v1.SetChatWebhookRequest
+	4,  // 9: prreviews.v1.TeamService.CreateTeam:output_type -> prreviews.v1.CreateTeamResponse
+	6,  // 10: prreviews.v1.TeamService.GetTeam:output_type -> prreviews.v1.GetTeamResponse
+	8,  // 11: prreviews.v1.TeamService.ListTeams:output_type -> prreviews.v1.ListTeamsResponse
+	10, // 12: prreviews.v1.TeamService.SetChatWebhook:output_type -> prreviews.v1.SetChatWebhookResponse
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_team_proto_init() }
+func file_proto_v1_team_proto_init() {
+	if File_proto_v1_team_proto != nil {
+		return
+	}
+	file_proto_v1_team_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_team_proto_rawDesc), len(file_proto_v1_team_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_team_proto_goTypes,
+		DependencyIndexes: file_proto_v1_team_proto_depIdxs,
+		MessageInfos:      file_proto_v1_team_proto_msgTypes,
+	}.Build()
+	File_proto_v1_team_proto = out.File
+	file_proto_v1_team_proto_goTypes = nil
+	file_proto_v1_team_proto_depIdxs = nil
+}
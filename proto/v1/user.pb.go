@@ -0,0 +1,1185 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: proto/v1/user.proto
+
+package prreviewsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DigestSettings struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Empty when no address is set.
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DigestEnabled bool   `protobuf:"varint,3,opt,name=digest_enabled,json=digestEnabled,proto3" json:"digest_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigestSettings) Reset() {
+	*x = DigestSettings{}
+	mi := &file_proto_v1_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigestSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigestSettings) ProtoMessage() {}
+
+func (x *DigestSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigestSettings.ProtoReflect.Descriptor instead.
+func (*DigestSettings) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DigestSettings) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *DigestSettings) GetDigestEnabled() bool {
+	if x != nil {
+		return x.DigestEnabled
+	}
+	return false
+}
+
+type ReviewQueueItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviews.v1.PullRequestStatus" json:"status,omitempty"`
+	// Set with include_reviewers.
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Set with include_reviewers.
+	AssignedReviewers []string `protobuf:"bytes,6,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	// Set with include_age.
+	AgeSeconds    *int64 `protobuf:"varint,7,opt,name=age_seconds,json=ageSeconds,proto3,oneof" json:"age_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewQueueItem) Reset() {
+	*x = ReviewQueueItem{}
+	mi := &file_proto_v1_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewQueueItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewQueueItem) ProtoMessage() {}
+
+func (x *ReviewQueueItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewQueueItem.ProtoReflect.Descriptor instead.
+func (*ReviewQueueItem) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReviewQueueItem) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReviewQueueItem) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ReviewQueueItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReviewQueueItem) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *ReviewQueueItem) GetAgeSeconds() int64 {
+	if x != nil && x.AgeSeconds != nil {
+		return *x.AgeSeconds
+	}
+	return 0
+}
+
+type ReviewerAssignment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username   string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive   bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	AssignedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	// Unspecified until the reviewer has submitted a review on the code host.
+	ReviewOutcome ReviewOutcome          `protobuf:"varint,5,opt,name=review_outcome,json=reviewOutcome,proto3,enum=prreviews.v1.ReviewOutcome" json:"review_outcome,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerAssignment) Reset() {
+	*x = ReviewerAssignment{}
+	mi := &file_proto_v1_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerAssignment) ProtoMessage() {}
+
+func (x *ReviewerAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerAssignment.ProtoReflect.Descriptor instead.
+func (*ReviewerAssignment) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReviewerAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ReviewerAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *ReviewerAssignment) GetReviewOutcome() ReviewOutcome {
+	if x != nil {
+		return x.ReviewOutcome
+	}
+	return ReviewOutcome_REVIEW_OUTCOME_UNSPECIFIED
+}
+
+func (x *ReviewerAssignment) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+type AuthoredPullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prreviews.v1.PullRequestStatus" json:"status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	ReviewStatus    ReviewStatus           `protobuf:"varint,7,opt,name=review_status,json=reviewStatus,proto3,enum=prreviews.v1.ReviewStatus" json:"review_status,omitempty"`
+	Reviewers       []*ReviewerAssignment  `protobuf:"bytes,8,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuthoredPullRequest) Reset() {
+	*x = AuthoredPullRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthoredPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthoredPullRequest) ProtoMessage() {}
+
+func (x *AuthoredPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthoredPullRequest.ProtoReflect.Descriptor instead.
+func (*AuthoredPullRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthoredPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *AuthoredPullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuthoredPullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *AuthoredPullRequest) GetReviewStatus() ReviewStatus {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *AuthoredPullRequest) GetReviewers() []*ReviewerAssignment {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type SetIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetIsActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveResponse) Reset() {
+	*x = SetIsActiveResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveResponse) ProtoMessage() {}
+
+func (x *SetIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type SetDigestSettingsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// An empty address removes it.
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	DigestEnabled bool   `protobuf:"varint,3,opt,name=digest_enabled,json=digestEnabled,proto3" json:"digest_enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsRequest) Reset() {
+	*x = SetDigestSettingsRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsRequest) ProtoMessage() {}
+
+func (x *SetDigestSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsRequest.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetDigestSettingsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetDigestSettingsRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SetDigestSettingsRequest) GetDigestEnabled() bool {
+	if x != nil {
+		return x.DigestEnabled
+	}
+	return false
+}
+
+type SetDigestSettingsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DigestSettings *DigestSettings        `protobuf:"bytes,1,opt,name=digest_settings,json=digestSettings,proto3" json:"digest_settings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetDigestSettingsResponse) Reset() {
+	*x = SetDigestSettingsResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDigestSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDigestSettingsResponse) ProtoMessage() {}
+
+func (x *SetDigestSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDigestSettingsResponse.ProtoReflect.Descriptor instead.
+func (*SetDigestSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetDigestSettingsResponse) GetDigestSettings() *DigestSettings {
+	if x != nil {
+		return x.DigestSettings
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Unset lists users regardless of the flag.
+	IsActive *bool `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// 1-100, 50 when unset.
+	Limit         int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListUsersRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Empty on the last page.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Unspecified means OPEN.
+	Status PullRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=prreviews.v1.PullRequestStatus" json:"status,omitempty"`
+	// Returns pull requests in any status, ignoring status.
+	AllStatuses      bool      `protobuf:"varint,3,opt,name=all_statuses,json=allStatuses,proto3" json:"all_statuses,omitempty"`
+	IncludeReviewers bool      `protobuf:"varint,4,opt,name=include_reviewers,json=includeReviewers,proto3" json:"include_reviewers,omitempty"`
+	IncludeAge       bool      `protobuf:"varint,5,opt,name=include_age,json=includeAge,proto3" json:"include_age,omitempty"`
+	Order            SortOrder `protobuf:"varint,6,opt,name=order,proto3,enum=prreviews.v1.SortOrder" json:"order,omitempty"`
+	// 1-100, 50 when unset.
+	Limit         int32  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsRequest) Reset() {
+	*x = GetReviewsRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsRequest) ProtoMessage() {}
+
+func (x *GetReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetReviewsRequest) GetAllStatuses() bool {
+	if x != nil {
+		return x.AllStatuses
+	}
+	return false
+}
+
+func (x *GetReviewsRequest) GetIncludeReviewers() bool {
+	if x != nil {
+		return x.IncludeReviewers
+	}
+	return false
+}
+
+func (x *GetReviewsRequest) GetIncludeAge() bool {
+	if x != nil {
+		return x.IncludeAge
+	}
+	return false
+}
+
+func (x *GetReviewsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetReviewsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*ReviewQueueItem     `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Empty on the last page.
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewsResponse) Reset() {
+	*x = GetReviewsResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewsResponse) ProtoMessage() {}
+
+func (x *GetReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewsResponse) GetPullRequests() []*ReviewQueueItem {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetAuthoredRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Unspecified means any status.
+	Status PullRequestStatus `protobuf:"varint,2,opt,name=status,proto3,enum=prreviews.v1.PullRequestStatus" json:"status,omitempty"`
+	Order  SortOrder         `protobuf:"varint,3,opt,name=order,proto3,enum=prreviews.v1.SortOrder" json:"order,omitempty"`
+	// 1-100, 50 when unset.
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthoredRequest) Reset() {
+	*x = GetAuthoredRequest{}
+	mi := &file_proto_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthoredRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthoredRequest) ProtoMessage() {}
+
+func (x *GetAuthoredRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthoredRequest.ProtoReflect.Descriptor instead.
+func (*GetAuthoredRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAuthoredRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAuthoredRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetAuthoredRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetAuthoredRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAuthoredRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetAuthoredResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*AuthoredPullRequest `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Empty on the last page.
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuthoredResponse) Reset() {
+	*x = GetAuthoredResponse{}
+	mi := &file_proto_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuthoredResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuthoredResponse) ProtoMessage() {}
+
+func (x *GetAuthoredResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuthoredResponse.ProtoReflect.Descriptor instead.
+func (*GetAuthoredResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetAuthoredResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAuthoredResponse) GetPullRequests() []*AuthoredPullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetAuthoredResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_proto_v1_user_proto protoreflect.FileDescriptor
+
+const file_proto_v1_user_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/v1/user.proto\x12\fprreviews.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x14proto/v1/types.proto\"f\n" +
+	"\x0eDigestSettings\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x0edigest_enabled\x18\x03 \x01(\bR\rdigestEnabled\"\xdb\x02\n" +
+	"\x0fReviewQueueItem\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prreviews.v1.PullRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12-\n" +
+	"\x12assigned_reviewers\x18\x06 \x03(\tR\x11assignedReviewers\x12$\n" +
+	"\vage_seconds\x18\a \x01(\x03H\x00R\n" +
+	"ageSeconds\x88\x01\x01B\x0e\n" +
+	"\f_age_seconds\"\xa4\x02\n" +
+	"\x12ReviewerAssignment\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12;\n" +
+	"\vassigned_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x12B\n" +
+	"\x0ereview_outcome\x18\x05 \x01(\x0e2\x1b.prreviews.v1.ReviewOutcomeR\rreviewOutcome\x12;\n" +
+	"\vreviewed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\"\xb4\x03\n" +
+	"\x13AuthoredPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prreviews.v1.PullRequestStatusR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12?\n" +
+	"\rreview_status\x18\a \x01(\x0e2\x1a.prreviews.v1.ReviewStatusR\freviewStatus\x12>\n" +
+	"\treviewers\x18\b \x03(\v2 .prreviews.v1.ReviewerAssignmentR\treviewers\"J\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"=\n" +
+	"\x13SetIsActiveResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.prreviews.v1.UserR\x04user\"p\n" +
+	"\x18SetDigestSettingsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x0edigest_enabled\x18\x03 \x01(\bR\rdigestEnabled\"b\n" +
+	"\x19SetDigestSettingsResponse\x12E\n" +
+	"\x0fdigest_settings\x18\x01 \x01(\v2\x1c.prreviews.v1.DigestSettingsR\x0edigestSettings\"\x8d\x01\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12 \n" +
+	"\tis_active\x18\x02 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursorB\f\n" +
+	"\n" +
+	"_is_active\"^\n" +
+	"\x11ListUsersResponse\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.prreviews.v1.UserR\x05users\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xb3\x02\n" +
+	"\x11GetReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.prreviews.v1.PullRequestStatusR\x06status\x12!\n" +
+	"\fall_statuses\x18\x03 \x01(\bR\vallStatuses\x12+\n" +
+	"\x11include_reviewers\x18\x04 \x01(\bR\x10includeReviewers\x12\x1f\n" +
+	"\vinclude_age\x18\x05 \x01(\bR\n" +
+	"includeAge\x12-\n" +
+	"\x05order\x18\x06 \x01(\x0e2\x17.prreviews.v1.SortOrderR\x05order\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\"\x92\x01\n" +
+	"\x12GetReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.prreviews.v1.ReviewQueueItemR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\xc3\x01\n" +
+	"\x12GetAuthoredRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1f.prreviews.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x17.prreviews.v1.SortOrderR\x05order\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\"\x97\x01\n" +
+	"\x13GetAuthoredResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12F\n" +
+	"\rpull_requests\x18\x02 \x03(\v2!.prreviews.v1.AuthoredPullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor2\xba\x03\n" +
+	"\vUserService\x12R\n" +
+	"\vSetIsActive\x12 .prreviews.v1.SetIsActiveRequest\x1a!.prreviews.v1.SetIsActiveResponse\x12d\n" +
+	"\x11SetDigestSettings\x12&.prreviews.v1.SetDigestSettingsRequest\x1a'.prreviews.v1.SetDigestSettingsResponse\x12L\n" +
+	"\tListUsers\x12\x1e.prreviews.v1.ListUsersRequest\x1a\x1f.prreviews.v1.ListUsersResponse\x12O\n" +
+	"\n" +
+	"GetReviews\x12\x1f.prreviews.v1.GetReviewsRequest\x1a .prreviews.v1.GetReviewsResponse\x12R\n" +
+	"\vGetAuthored\x12 .prreviews.v1.GetAuthoredRequest\x1a!.prreviews.v1.GetAuthoredResponseB5Z3github.com/finstape/pr-reviews/proto/v1;prreviewsv1b\x06proto3"
+
+var (
+	file_proto_v1_user_proto_rawDescOnce sync.Once
+	file_proto_v1_user_proto_rawDescData []byte
+)
+
+func file_proto_v1_user_proto_rawDescGZIP() []byte {
+	file_proto_v1_user_proto_rawDescOnce.Do(func() {
+		file_proto_v1_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_user_proto_rawDesc), len(file_proto_v1_user_proto_rawDesc)))
+	})
+	return file_proto_v1_user_proto_rawDescData
+}
+
+var file_proto_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_proto_v1_user_proto_goTypes = []any{
+	(*DigestSettings)(nil),            // 0: prreviews.v1.DigestSettings
+	(*ReviewQueueItem)(nil),           // 1: prreviews.v1.ReviewQueueItem
+	(*ReviewerAssignment)(nil),        // 2: prreviews.v1.ReviewerAssignment
+	(*AuthoredPullRequest)(nil),       // 3: prreviews.v1.AuthoredPullRequest
+	(*SetIsActiveRequest)(nil),        // 4: prreviews.v1.SetIsActiveRequest
+	(*SetIsActiveResponse)(nil),       // 5: prreviews.v1.SetIsActiveResponse
+	(*SetDigestSettingsRequest)(nil),  // 6: prreviews.v1.SetDigestSettingsRequest
+	(*SetDigestSettingsResponse)(nil), // 7: prreviews.v1.SetDigestSettingsResponse
+	(*ListUsersRequest)(nil),          // 8: prreviews.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),         // 9: prreviews.v1.ListUsersResponse
+	(*GetReviewsRequest)(nil),         // 10: prreviews.v1.GetReviewsRequest
+	(*GetReviewsResponse)(nil),        // 11: prreviews.v1.GetReviewsResponse
+	(*GetAuthoredRequest)(nil),        // 12: prreviews.v1.GetAuthoredRequest
+	(*GetAuthoredResponse)(nil),       // 13: prreviews.v1.GetAuthoredResponse
+	(PullRequestStatus)(0),            // 14: prreviews.v1.PullRequestStatus
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(ReviewOutcome)(0),                // 16: prreviews.v1.ReviewOutcome
+	(ReviewStatus)(0),                 // 17: prreviews.v1.ReviewStatus
+	(*User)(nil),                      // 18: prreviews.v1.User
+	(SortOrder)(0),                    // 19: prreviews.v1.SortOrder
+}
+var file_proto_v1_user_proto_depIdxs = []int32{
+	14, // 0: prreviews.v1.ReviewQueueItem.status:type_name -> prreviews.v1.PullRequestStatus
+	15, // 1: prreviews.v1.ReviewQueueItem.created_at:type_name -> google.protobuf.Timestamp
+	15, // 2: prreviews.v1.ReviewerAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	16, // 3: prreviews.v1.ReviewerAssignment.review_outcome:type_name -> prreviews.v1.ReviewOutcome
+	15, // 4: prreviews.v1.ReviewerAssignment.reviewed_at:type_name -> google.protobuf.Timestamp
+	14, // 5: prreviews.v1.AuthoredPullRequest.status:type_name -> prreviews.v1.PullRequestStatus
+	15, // 6: prreviews.v1.AuthoredPullRequest.created_at:type_name -> google.protobuf.Timestamp
+	15, // 7: prreviews.v1.AuthoredPullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	17, // 8: prreviews.v1.AuthoredPullRequest.review_status:type_name -> prreviews.v1.ReviewStatus
+	2,  // 9: prreviews.v1.AuthoredPullRequest.reviewers:type_name -> prreviews.v1.ReviewerAssignment
+	18, // 10: prreviews.v1.SetIsActiveResponse.user:type_name -> prreviews.v1.User
+	0,  // 11: prreviews.v1.SetDigestSettingsResponse.digest_settings:type_name -> prreviews.v1.DigestSettings
+	18, // 12: prreviews.v1.ListUsersResponse.users:type_name -> prreviews.v1.User
+	14, // 13: prreviews.v1.GetReviewsRequest.status:type_name -> prreviews.v1.PullRequestStatus
+	19, // 14: prreviews.v1.GetReviewsRequest.order:type_name -> prreviews.v1.SortOrder
+	1,  // 15: prreviews.v1.GetReviewsResponse.pull_requests:type_name -> prreviews.v1.ReviewQueueItem
+	14, // 16: prreviews.v1.GetAuthoredRequest.status:type_name -> prreviews.v1.PullRequestStatus
+	19, // 17: prreviews.v1.GetAuthoredRequest.order:type_name -> prreviews.v1.SortOrder
+	3,  // 18: prreviews.v1.GetAuthoredResponse.pull_requests:type_name -> prreviews.v1.AuthoredPullRequest
+	4,  // 19: prreviews.v1.UserService.SetIsActive:input_type -> prreviews.v1.SetIsActiveRequest
+	6,  // 20: prreviews.v1.UserService.SetDigestSettings:input_type -> prreviews.v1.SetDigestSettingsRequest
+	8,  // 21: prreviews.v1.UserService.ListUsers:input_type -> prreviews.v1.ListUsersRequest
+	10, // 22: prreviews.v1.UserService.GetReviews:input_type -> prreviews.v1.GetReviewsRequest
+	12, // 23: prreviews.v1.UserService.GetAuthored:input_type -> prreviews.v1.GetAuthoredRequest
+	5,  // 24: prreviews.v1.UserService.SetIsActive:output_type -> prreviews.v1.SetIsActiveResponse
+	7,  // 25: prreviews.v1.UserService.SetDigestSettings:output_type -> prreviews.v1.SetDigestSettingsResponse
+	9,  // 26: prreviews.v1.UserService.ListUsers:output_type -> prreviews.v1.ListUsersResponse
+	11, // 27: prreviews.v1.UserService.GetReviews:output_type -> prreviews.v1.GetReviewsResponse
+	13, // 28: prreviews.v1.UserService.GetAuthored:output_type -> prreviews.v1.GetAuthoredResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_user_proto_init() }
+func file_proto_v1_user_proto_init() {
+	if File_proto_v1_user_proto != nil {
+		return
+	}
+	file_proto_v1_types_proto_init()
+	file_proto_v1_user_proto_msgTypes[1].OneofWrappers = []any{}
+	file_proto_v1_user_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_user_proto_rawDesc), len(file_proto_v1_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_user_proto_goTypes,
+		DependencyIndexes: file_proto_v1_user_proto_depIdxs,
+		MessageInfos:      file_proto_v1_user_proto_msgTypes,
+	}.Build()
+	File_proto_v1_user_proto = out.File
+	file_proto_v1_user_proto_goTypes = nil
+	file_proto_v1_user_proto_depIdxs = nil
+}